@@ -0,0 +1,193 @@
+package constraint
+
+import (
+	"fmt"
+	"sync"
+)
+
+// BlueprintMemoryHint is a blueprint that facilitates reading from a read-write
+// memory. It is essentially a hint to the solver which returns the currently
+// stored value and the timestamp of the last access, but enables storing the
+// initial memory content and the performed accesses only once.
+type BlueprintMemoryHint struct {
+	// EntriesCalldata stores the initial values of the memory.
+	EntriesCalldata []uint32
+	// OpsCalldata stores the performed accesses. Every access is stored as
+	// three linear expressions: the address, the value stored after the
+	// access and the timestamp of the previous access to the address. The
+	// last one is only used for ordering the instructions.
+	OpsCalldata []uint32
+
+	// stores the maxLevel of the entries and accesses computed by WireWalker
+	maxLevel         Level
+	maxLevelEntries  bool
+	maxLevelPosition int
+	maxLevelOffset   int
+
+	// cache the memory state by the solver
+	cachedEntries []Element
+	cachedState   map[uint64]memoryCell
+	cachedOps     int
+	cachedOffset  int
+	lock          sync.Mutex
+}
+
+type memoryCell struct {
+	value     Element
+	timestamp uint64
+}
+
+// ensures BlueprintMemoryHint implements the BlueprintStateful interface
+var _ BlueprintStateful = (*BlueprintMemoryHint)(nil)
+
+func (b *BlueprintMemoryHint) Solve(s Solver, inst Instruction) error {
+	nbOps := int(inst.Calldata[1])
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	// resolve the initial memory content
+	if b.cachedEntries == nil {
+		b.cachedEntries = make([]Element, 0)
+		for offset := 0; offset < len(b.EntriesCalldata); {
+			e, delta := s.Read(b.EntriesCalldata[offset:])
+			b.cachedEntries = append(b.cachedEntries, e)
+			offset += delta
+		}
+		b.cachedState = make(map[uint64]memoryCell)
+	}
+
+	// the instructions are ordered, we only need to replay the accesses
+	// performed since the last query.
+	if b.cachedOps > nbOps {
+		return fmt.Errorf("memory accesses solved out of order")
+	}
+	offset, delta := b.cachedOffset, 0
+	for i := b.cachedOps; i < nbOps; i++ {
+		var addr, val Element
+		addr, delta = s.Read(b.OpsCalldata[offset:])
+		offset += delta
+		val, delta = s.Read(b.OpsCalldata[offset:])
+		offset += delta
+		_, delta = s.Read(b.OpsCalldata[offset:])
+		offset += delta
+		idx, isUint64 := s.Uint64(addr)
+		if !isUint64 || idx >= uint64(len(b.cachedEntries)) {
+			return fmt.Errorf("memory address out of bounds")
+		}
+		b.cachedState[idx] = memoryCell{value: val, timestamp: uint64(i + 1)}
+	}
+	b.cachedOps, b.cachedOffset = nbOps, offset
+
+	nbInputs := int(inst.Calldata[2])
+	offset = 3
+	for i := 0; i < nbInputs; i++ {
+		var addr Element
+		addr, delta = s.Read(inst.Calldata[offset:])
+		offset += delta
+		idx, isUint64 := s.Uint64(addr)
+		if !isUint64 || idx >= uint64(len(b.cachedEntries)) {
+			return fmt.Errorf("memory address out of bounds")
+		}
+		cell, ok := b.cachedState[idx]
+		if !ok {
+			cell = memoryCell{value: b.cachedEntries[idx]}
+		}
+		// we set the output wires to the stored value and the timestamp
+		s.SetValue(uint32(2*i+int(inst.WireOffset)), cell.value)
+		s.SetValue(uint32(2*i+1+int(inst.WireOffset)), s.FromInterface(cell.timestamp))
+	}
+	return nil
+}
+
+func (b *BlueprintMemoryHint) Reset() {
+	b.cachedEntries = nil
+	b.cachedState = nil
+	b.cachedOps = 0
+	b.cachedOffset = 0
+}
+
+func (b *BlueprintMemoryHint) CalldataSize() int {
+	// variable size
+	return -1
+}
+
+func (b *BlueprintMemoryHint) NbConstraints() int {
+	return 0
+}
+
+// NbOutputs return the number of output wires this blueprint creates.
+func (b *BlueprintMemoryHint) NbOutputs(inst Instruction) int {
+	return 2 * int(inst.Calldata[2])
+}
+
+func (b *BlueprintMemoryHint) UpdateInstructionTree(inst Instruction, tree InstructionTree) Level {
+	updateLevel := func(calldata []uint32, j int) int {
+		// first we have the length of the linear expression
+		n := int(calldata[j])
+		j++
+		for k := 0; k < n; k++ {
+			wireID := calldata[j+1]
+			j += 2
+			if !tree.HasWire(wireID) {
+				continue
+			}
+			if level := tree.GetWireLevel(wireID); (level + 1) > b.maxLevel {
+				b.maxLevel = level + 1
+			}
+		}
+		return j
+	}
+
+	// depend on all the entries. The memory is initialized once, so we only
+	// need to do it once.
+	if !b.maxLevelEntries {
+		for j := 0; j < len(b.EntriesCalldata); {
+			j = updateLevel(b.EntriesCalldata, j)
+		}
+		b.maxLevelEntries = true
+	}
+
+	// depend on all the accesses up to the number of accesses at time of
+	// instruction creation. As the accesses store the outputs of the
+	// corresponding instructions, this also orders the instructions.
+	nbOps := int(inst.Calldata[1])
+	if b.maxLevelPosition < nbOps {
+		j := b.maxLevelOffset // skip the accesses we already processed
+		for i := b.maxLevelPosition; i < nbOps; i++ {
+			for k := 0; k < 3; k++ {
+				j = updateLevel(b.OpsCalldata, j)
+			}
+		}
+		b.maxLevelOffset = j
+		b.maxLevelPosition = nbOps
+	}
+
+	maxLevel := b.maxLevel - 1 // offset for default value.
+
+	// update the max level with the address inputs wires
+	nbInputs := int(inst.Calldata[2])
+	j := 3
+	for i := 0; i < nbInputs; i++ {
+		n := int(inst.Calldata[j])
+		j++
+		for k := 0; k < n; k++ {
+			wireID := inst.Calldata[j+1]
+			j += 2
+			if !tree.HasWire(wireID) {
+				continue
+			}
+			if level := tree.GetWireLevel(wireID); level > maxLevel {
+				maxLevel = level
+			}
+		}
+	}
+
+	// finally we have the outputs
+	maxLevel++
+	for i := 0; i < 2*nbInputs; i++ {
+		tree.InsertWire(uint32(i+int(inst.WireOffset)), maxLevel)
+	}
+
+	return maxLevel
+}
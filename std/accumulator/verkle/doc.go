@@ -0,0 +1,25 @@
+// Package verkle implements in-circuit verification of Verkle multiproofs.
+//
+// Verkle trees as specified for Ethereum commit to the children of every node
+// using a Pedersen vector commitment over the Banderwagon group (the quotient
+// of the Bandersnatch curve by its 2-torsion subgroup). The openings of several
+// commitments are aggregated into a single multiproof, which consists of a
+// commitment to the quotient polynomial and an inner product argument (IPA)
+// proof of the opening of the aggregated polynomial.
+//
+// The verifier follows the implementation in [go-ipa]: the challenges are
+// derived using a SHA256-based Fiat-Shamir transcript, the commitment basis is
+// derived from the seed "eth_verkle_oct_2021" and the polynomials are in
+// evaluation form over the domain {0, ..., 255}.
+//
+// Bandersnatch is defined over the scalar field of BLS12-381, so the point
+// arithmetic is native when the circuit is defined over BLS12-381. The scalar
+// field of Bandersnatch is emulated.
+//
+// The package only verifies the openings. For proving the access to the state
+// against the root commitment, the caller has to build the openings along the
+// paths from the root: the value of the parent node at the child index is
+// obtained from the commitment of the child using [Verifier.MapToScalarField].
+//
+// [go-ipa]: https://github.com/crate-crypto/go-ipa
+package verkle
@@ -0,0 +1,27 @@
+package verkle
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/constraint/solver"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hint functions used in the package.
+func GetHints() []solver.Hint {
+	return []solver.Hint{sqrtHint}
+}
+
+func sqrtHint(mod *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) != 1 || len(outputs) != 1 {
+		return fmt.Errorf("expecting single input and output")
+	}
+	if outputs[0].ModSqrt(inputs[0], mod) == nil {
+		return fmt.Errorf("input is not a square")
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package verkle
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+const (
+	// DomainSize is the number of evaluations of the committed polynomials
+	// (width of the Verkle tree nodes). The evaluation domain is {0, ..., 255}.
+	DomainSize = 256
+	// NbRounds is the number of folding rounds in the IPA proof (log2 of
+	// [DomainSize]).
+	NbRounds = 8
+
+	// srsSeed is the seed used for deriving the commitment basis.
+	srsSeed = "eth_verkle_oct_2021"
+)
+
+// params holds the precomputed parameters of the Pedersen commitment and the
+// barycentric formula used by the verifier.
+type params struct {
+	// srs is the Pedersen commitment basis.
+	srs [DomainSize]bandersnatch.PointAffine
+	// q is the point used for binding the inner product in the IPA.
+	q bandersnatch.PointAffine
+	// invWeights are the inverses of the barycentric weights 1/A'(i) where
+	// A(X) = ∏_{i∈[0,256)} (X-i).
+	invWeights [DomainSize]*big.Int
+}
+
+var (
+	cachedParams     *params
+	cachedParamsOnce sync.Once
+)
+
+// getParams returns the Ethereum Verkle tree commitment parameters. The
+// parameters are computed only once.
+func getParams() *params {
+	cachedParamsOnce.Do(func() {
+		cachedParams = newParams()
+	})
+	return cachedParams
+}
+
+func newParams() *params {
+	curve := bandersnatch.GetEdwardsCurve()
+	p := &params{q: curve.Base}
+
+	// derive the commitment basis by hashing the seed and a counter to a
+	// candidate x-coordinate and accepting it if it corresponds to a point in
+	// the prime order subgroup.
+	var x fr.Element
+	var buf [8]byte
+	for i, increment := 0, uint64(0); i < DomainSize; increment++ {
+		h := sha256.New()
+		h.Write([]byte(srsSeed))
+		binary.BigEndian.PutUint64(buf[:], increment)
+		h.Write(buf[:])
+		x.SetBytes(h.Sum(nil))
+		pt, ok := pointFromX(&x)
+		if !ok {
+			continue
+		}
+		p.srs[i] = pt
+		i++
+	}
+
+	// barycentric weights A'(i) = ∏_{j≠i} (i-j)
+	order := &curve.Order
+	for i := 0; i < DomainSize; i++ {
+		w := big.NewInt(1)
+		for j := 0; j < DomainSize; j++ {
+			if i == j {
+				continue
+			}
+			w.Mul(w, big.NewInt(int64(i-j)))
+			w.Mod(w, order)
+		}
+		p.invWeights[i] = w.ModInverse(w, order)
+	}
+	return p
+}
+
+// pointFromX returns a point on Bandersnatch with the given x-coordinate which
+// represents an element in the prime order group (Banderwagon). The
+// y-coordinate is chosen to be lexicographically largest. It returns false if
+// no such point exists.
+func pointFromX(x *fr.Element) (bandersnatch.PointAffine, bool) {
+	curve := bandersnatch.GetEdwardsCurve()
+	var one, xx, num, den, yy fr.Element
+	one.SetOne()
+	xx.Square(x)
+	// y² = (1-ax²) / (1-dx²)
+	num.Mul(&xx, &curve.A)
+	num.Sub(&one, &num)
+	den.Mul(&xx, &curve.D)
+	den.Sub(&one, &den)
+	// the point is in the prime order subgroup (modulo 2-torsion) only if
+	// 1-ax² is a square.
+	if num.Legendre() != 1 {
+		return bandersnatch.PointAffine{}, false
+	}
+	yy.Div(&num, &den)
+	var y fr.Element
+	if y.Sqrt(&yy) == nil {
+		return bandersnatch.PointAffine{}, false
+	}
+	if !y.LexicographicallyLargest() {
+		y.Neg(&y)
+	}
+	return bandersnatch.NewPointAffine(*x, y), true
+}
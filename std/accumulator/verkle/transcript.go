@@ -0,0 +1,79 @@
+package verkle
+
+import (
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/native/twistededwards"
+	"github.com/consensys/gnark/std/hash/sha2"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+// transcript is the in-circuit counterpart of the SHA256-based Fiat-Shamir
+// transcript used for Verkle proofs. The messages are buffered and hashed only
+// when a challenge is computed. After computing a challenge, the hasher state
+// is reset and the challenge is appended to the transcript.
+type transcript struct {
+	v   *Verifier
+	buf []uints.U8
+}
+
+func newTranscript(v *Verifier, label string) *transcript {
+	return &transcript{v: v, buf: uints.NewU8Array([]byte(label))}
+}
+
+func (t *transcript) domainSep(label string) {
+	t.buf = append(t.buf, uints.NewU8Array([]byte(label))...)
+}
+
+func (t *transcript) appendMessage(msg []uints.U8, label string) {
+	t.domainSep(label)
+	t.buf = append(t.buf, msg...)
+}
+
+// appendPoint appends the compressed serialization of p, which is the
+// x-coordinate multiplied by the sign of the y-coordinate in big-endian order.
+func (t *transcript) appendPoint(p twistededwards.Point, label string) {
+	api := t.v.api
+	// the y-coordinate is lexicographically largest iff y > (q-1)/2. This is
+	// equivalent to 2y mod q being odd.
+	y2 := bits.ToBinary(api, api.Mul(p.Y, 2))
+	x := api.Select(y2[0], p.X, api.Neg(p.X))
+	xb := bits.ToBinary(api, x)
+	xb = append(xb, make([]frontend.Variable, 8*32-len(xb))...)
+	for i := api.Compiler().FieldBitLen(); i < len(xb); i++ {
+		xb[i] = 0
+	}
+	msg := make([]uints.U8, 32)
+	for i := range msg {
+		msg[len(msg)-1-i] = uints.U8{Val: bits.FromBinary(api, xb[8*i:8*(i+1)], bits.WithUnconstrainedInputs())}
+	}
+	t.appendMessage(msg, label)
+}
+
+// appendScalar appends the canonical little-endian serialization of s.
+func (t *transcript) appendScalar(s *Scalar, label string) {
+	t.appendMessage(t.v.scalarBytes(s), label)
+}
+
+// challengeScalar hashes the transcript and returns the digest interpreted as
+// a little-endian integer reduced modulo the scalar field order.
+func (t *transcript) challengeScalar(label string) *Scalar {
+	api := t.v.api
+	t.domainSep(label)
+	h, err := sha2.New(api)
+	if err != nil {
+		panic(err)
+	}
+	h.Write(t.buf)
+	digest := h.Sum()
+	t.buf = nil
+
+	dbits := make([]frontend.Variable, 0, 8*len(digest))
+	for i := range digest {
+		dbits = append(dbits, bits.ToBinary(api, digest[i].Val, bits.WithNbDigits(8))...)
+	}
+	// the digest may be larger than the modulus. Multiplying by one reduces it.
+	res := t.v.fr.MulMod(t.v.fr.FromBits(dbits...), t.v.fr.One())
+	t.appendScalar(res, label)
+	return res
+}
@@ -0,0 +1,259 @@
+package verkle
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch"
+	tedwards "github.com/consensys/gnark-crypto/ecc/twistededwards"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/native/twistededwards"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/emulated/emparams"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+// Scalar is an element of the scalar field of Bandersnatch. It is emulated as
+// the scalar field is smaller than the native field.
+type Scalar = emulated.Element[emparams.BandersnatchFr]
+
+// IPAProof is an inner product argument proof that a polynomial in evaluation
+// form committed with the Pedersen commitment evaluates to a value at a point.
+type IPAProof struct {
+	// L and R are the cross-commitments of each folding round.
+	L, R [NbRounds]twistededwards.Point
+	// A is the folded polynomial.
+	A Scalar
+}
+
+// MultiProof is a proof of several polynomial openings at points of the
+// evaluation domain.
+type MultiProof struct {
+	// D is the commitment to the quotient polynomial.
+	D twistededwards.Point
+	// IPA is the proof of the opening of the aggregated polynomial.
+	IPA IPAProof
+}
+
+// Opening is a claimed evaluation of a committed polynomial.
+type Opening struct {
+	// C is the commitment to the polynomial.
+	C twistededwards.Point
+	// Z is the evaluation point in the evaluation domain [0, 256).
+	Z frontend.Variable
+	// Y is the claimed evaluation of the polynomial at Z.
+	Y Scalar
+}
+
+// ValueOfPoint returns the in-circuit point for the native Bandersnatch point.
+// It can be used for assigning the witness.
+func ValueOfPoint(p bandersnatch.PointAffine) twistededwards.Point {
+	var x, y big.Int
+	p.X.BigInt(&x)
+	p.Y.BigInt(&y)
+	return twistededwards.Point{X: &x, Y: &y}
+}
+
+// Verifier verifies Verkle multiproofs in-circuit.
+type Verifier struct {
+	api    frontend.API
+	curve  twistededwards.Curve
+	fr     *emulated.Field[emparams.BandersnatchFr]
+	bytes  *uints.BinaryField[uints.U32]
+	params *params
+}
+
+// NewVerifier returns a new [Verifier]. The native field must be the scalar
+// field of BLS12-381.
+func NewVerifier(api frontend.API) (*Verifier, error) {
+	curve, err := twistededwards.NewEdCurve(api, tedwards.BLS12_381_BANDERSNATCH)
+	if err != nil {
+		return nil, fmt.Errorf("new curve: %w", err)
+	}
+	f, err := emulated.NewField[emparams.BandersnatchFr](api)
+	if err != nil {
+		return nil, fmt.Errorf("new scalar field: %w", err)
+	}
+	bf, err := uints.New[uints.U32](api)
+	if err != nil {
+		return nil, fmt.Errorf("new binary field: %w", err)
+	}
+	return &Verifier{
+		api:    api,
+		curve:  curve,
+		fr:     f,
+		bytes:  bf,
+		params: getParams(),
+	}, nil
+}
+
+// AssertIsElement asserts that p is on the curve and represents an element of
+// the prime order group, i.e. that 1-ax² is a square.
+func (v *Verifier) AssertIsElement(p twistededwards.Point) {
+	v.curve.AssertIsOnCurve(p)
+	a := v.curve.Params().A
+	s := v.api.Sub(1, v.api.Mul(a, p.X, p.X))
+	res, err := v.api.Compiler().NewHint(sqrtHint, 1, s)
+	if err != nil {
+		panic(err)
+	}
+	v.api.AssertIsEqual(v.api.Mul(res[0], res[0]), s)
+}
+
+// AssertIsEqual asserts that p and q represent the same group element. Points
+// P and P+(0,-1) are considered equal.
+func (v *Verifier) AssertIsEqual(p, q twistededwards.Point) {
+	v.api.AssertIsEqual(v.api.Mul(p.X, q.Y), v.api.Mul(q.X, p.Y))
+}
+
+// MapToScalarField maps the commitment p to the scalar field by computing x/y
+// and reducing its little-endian representation. This is used to derive the
+// values stored in the parent node from the commitments of the children.
+func (v *Verifier) MapToScalarField(p twistededwards.Point) *Scalar {
+	xy := v.api.Div(p.X, p.Y)
+	b := bits.ToBinary(v.api, xy)
+	return v.fr.MulMod(v.fr.FromBits(b...), v.fr.One())
+}
+
+// AssertMultiProof asserts that proof is a valid multiproof for the openings.
+// The transcript is initialised with the label "vt" as used for Verkle trees.
+func (v *Verifier) AssertMultiProof(openings []Opening, proof MultiProof) error {
+	return v.assertMultiProof("vt", openings, proof)
+}
+
+func (v *Verifier) assertMultiProof(label string, openings []Opening, proof MultiProof) error {
+	if len(openings) == 0 {
+		return fmt.Errorf("no openings")
+	}
+	tr := newTranscript(v, label)
+	tr.domainSep("multiproof")
+	zs := make([]*Scalar, len(openings))
+	for i := range openings {
+		v.AssertIsElement(openings[i].C)
+		tr.appendPoint(openings[i].C, "C")
+		z := v.bytes.ByteValueOf(openings[i].Z)
+		zs[i] = v.fr.NewElement([]frontend.Variable{z.Val, 0, 0, 0})
+		tr.appendScalar(zs[i], "z")
+		tr.appendScalar(&openings[i].Y, "y")
+	}
+	r := tr.challengeScalar("r")
+	v.AssertIsElement(proof.D)
+	tr.appendPoint(proof.D, "D")
+	t := tr.challengeScalar("t")
+
+	// g₂(t) = ∑ rⁱ yᵢ / (t - zᵢ) and E = ∑ rⁱ / (t - zᵢ) Cᵢ
+	g2t := v.fr.Zero()
+	var E twistededwards.Point
+	ri := v.fr.One()
+	for i := range openings {
+		if i > 0 {
+			ri = v.fr.Mul(ri, r)
+		}
+		coef := v.fr.Div(ri, v.fr.Sub(t, zs[i]))
+		g2t = v.fr.Add(g2t, v.fr.Mul(coef, &openings[i].Y))
+		ci := v.scalarMul(openings[i].C, coef)
+		if i == 0 {
+			E = ci
+		} else {
+			E = v.curve.Add(E, ci)
+		}
+	}
+	tr.appendPoint(E, "E")
+	C := v.curve.Add(E, v.curve.Neg(proof.D))
+	v.assertIPAProof(tr, C, t, g2t, proof.IPA)
+	return nil
+}
+
+// assertIPAProof asserts that proof is a valid IPA proof that the polynomial
+// committed in C evaluates to y at the point z.
+func (v *Verifier) assertIPAProof(tr *transcript, C twistededwards.Point, z, y *Scalar, proof IPAProof) {
+	tr.domainSep("ipa")
+	tr.appendPoint(C, "C")
+	tr.appendScalar(z, "input point")
+	tr.appendScalar(y, "output point")
+	w := tr.challengeScalar("w")
+
+	var xs, xinvs [NbRounds]*Scalar
+	for i := 0; i < NbRounds; i++ {
+		v.AssertIsElement(proof.L[i])
+		v.AssertIsElement(proof.R[i])
+		tr.appendPoint(proof.L[i], "L")
+		tr.appendPoint(proof.R[i], "R")
+		xs[i] = tr.challengeScalar("x")
+		xinvs[i] = v.fr.Inverse(xs[i])
+	}
+
+	// folding scalars sᵢ = ∏_j x_j⁻¹ where j runs over the set bits of i
+	// (starting from the most significant bit).
+	var s [DomainSize]*Scalar
+	s[0] = v.fr.One()
+	for i := 1; i < DomainSize; i++ {
+		msb := 0
+		for (i >> (msb + 1)) > 0 {
+			msb++
+		}
+		s[i] = v.fr.Mul(s[i^(1<<msb)], xinvs[NbRounds-1-msb])
+	}
+
+	// folded evaluation vector b₀ = ∑ sᵢ bᵢ where bᵢ = A(z) / (A'(i) (z-i))
+	// are the barycentric coefficients.
+	az := v.fr.One()
+	b0 := v.fr.Zero()
+	for i := 0; i < DomainSize; i++ {
+		zi := v.fr.Sub(z, v.fr.NewElement(i))
+		az = v.fr.Mul(az, zi)
+		bi := v.fr.Div(v.fr.NewElement(v.params.invWeights[i]), zi)
+		b0 = v.fr.Add(b0, v.fr.Mul(bi, s[i]))
+	}
+	b0 = v.fr.Mul(b0, az)
+
+	// C + w y Q + ∑ (x_j L_j + x_j⁻¹ R_j) == a G₀ + w a b₀ Q,
+	// where G₀ = ∑ sᵢ Gᵢ is the folded basis.
+	lhs := v.scalarMul(ValueOfPoint(v.params.q), v.fr.Mul(w, v.fr.Sub(y, v.fr.Mul(&proof.A, b0))))
+	lhs = v.curve.Add(lhs, C)
+	for i := 0; i < NbRounds; i++ {
+		lhs = v.curve.Add(lhs, v.scalarMul(proof.L[i], xs[i]))
+		lhs = v.curve.Add(lhs, v.scalarMul(proof.R[i], xinvs[i]))
+	}
+	var rhs twistededwards.Point
+	for i := 0; i < DomainSize; i++ {
+		gi := v.scalarMul(ValueOfPoint(v.params.srs[i]), v.fr.Mul(&proof.A, s[i]))
+		if i == 0 {
+			rhs = gi
+		} else {
+			rhs = v.curve.Add(rhs, gi)
+		}
+	}
+	v.AssertIsEqual(lhs, rhs)
+}
+
+// scalarMul computes [s]p. The scalar is reduced and represented as a native
+// variable which fits as the scalar field is smaller than the native field.
+func (v *Verifier) scalarMul(p twistededwards.Point, s *Scalar) twistededwards.Point {
+	return v.curve.ScalarMul(p, v.scalarToNative(s))
+}
+
+// scalarToNative returns the native variable representing the value of s. The
+// value is not necessarily canonical, but it is less than 2^253.
+func (v *Verifier) scalarToNative(s *Scalar) frontend.Variable {
+	r := v.fr.Reduce(s)
+	nbBits := emparams.BandersnatchFr{}.BitsPerLimb()
+	var res frontend.Variable = 0
+	for i := range r.Limbs {
+		res = v.api.Add(res, v.api.Mul(r.Limbs[i], new(big.Int).Lsh(big.NewInt(1), uint(i)*nbBits)))
+	}
+	return res
+}
+
+// scalarBytes returns the canonical little-endian serialization of s.
+func (v *Verifier) scalarBytes(s *Scalar) []uints.U8 {
+	r := v.fr.Reduce(s)
+	v.fr.AssertIsInRange(r)
+	sbits := v.fr.ToBits(r)
+	res := make([]uints.U8, 32)
+	for i := range res {
+		res[i] = uints.U8{Val: bits.FromBinary(v.api, sbits[8*i:8*(i+1)], bits.WithUnconstrainedInputs())}
+	}
+	return res
+}
@@ -0,0 +1,119 @@
+package verkle
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/native/twistededwards"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/emulated/emparams"
+	"github.com/consensys/gnark/test"
+)
+
+// test vector generated using github.com/crate-crypto/go-ipa. Polynomials are
+// fₖ[i] = (i+1)(k+3)7919+k for k ∈ {0,1}, commitments are (C₀, C₁, C₀) opened
+// at (1, 5, 200).
+var (
+	tvCommitments = []string{
+		"540d898c2d31e98746b91b809a01748ed2c7de4e49bd232aa70be238ce233ec3",
+		"5d362079b0626c6e899dd241e5a9ba8f9db1e6aecdc5dfd3a2d58c5efbbfbb18",
+		"540d898c2d31e98746b91b809a01748ed2c7de4e49bd232aa70be238ce233ec3",
+	}
+	tvEvaluations = []int64{0xb99a, 0x2e669, 0x48dcf5}
+	tvPoints      = []int{1, 5, 200}
+	tvProof       = "508d13a1311545e2525edbff8a4080fde4b0a6e7f4b1cdfc0c65d8c62afc798721bb92fda02ff5eaa4aa05c65c7eecfdff34911588f3e5793047a3a52bb369272d1c23ea39a98d37c0590ee9751ace568ec35eae906b63156b73a28c594b2f090a83864b4e1d456ab7c8cf2df9e8812ebc619bf0665a0fe9113ff9484bf084d2467d31ebd23405e830fb21d0af8d6e8f561808972034cfc256cf1ac8045b21fb68c91d309cd2cb25a3932443567440cfae612daef7155c119f83b67fcfe29871065e1676556cb021af8723a03ed45b6a7a0af41bda01e17210c569d615d44cb9449d1f9288e4c8fb3af10627a9a81a6282d54eafe8ac9b99be7b019d6facaab52841421f5076dc21bacd1070f8604a66d5025d01717f1f1a771a01093b23c00107ceec25d9934bfe79cfb482228976e6e6566080a3f5a3ade6ff55b96a08d27e737a7f6597c61deac42ca665069668f0ebcdb82bb28b621a2f414ae63055483b664a5865b87dd733c91cd610ac9a4e31ef0ba96ed886c06862c247b39650634f493e8400ecae60887bbbafe2c503bc7133999a7217b020937b6fd69d228df6b654557e942850131c746986bc6606a55549e7bfbeeef728ff2e8b5a04a7d37fb96a1e4b5a0dab562bdcc8a564fb0370babf8925da5ad836bd09c2e761d43bd303377a3bf0c2c9105bf81b78aa6452ede8364683d259fa5a270999a3b3c2de983a055349457cc4afc710bbc9573fe6a1b96ed5ddfde1db90f4f6a55e5d2bbefd5519b63478d4901ec18282b022c68f2a6baf6eed087e655850bee2c8c76c0cb111"
+)
+
+func decodePoint(t *testing.T, b []byte) twistededwards.Point {
+	var x fr.Element
+	if err := x.SetBytesCanonical(b); err != nil {
+		t.Fatal(err)
+	}
+	p, ok := pointFromX(&x)
+	if !ok {
+		t.Fatal("invalid point")
+	}
+	return ValueOfPoint(p)
+}
+
+func decodeScalarLE(b []byte) *big.Int {
+	be := make([]byte, len(b))
+	for i := range b {
+		be[len(b)-1-i] = b[i]
+	}
+	return new(big.Int).SetBytes(be)
+}
+
+type multiProofCircuit struct {
+	Openings [3]Opening
+	Proof    MultiProof
+}
+
+func (c *multiProofCircuit) Define(api frontend.API) error {
+	v, err := NewVerifier(api)
+	if err != nil {
+		return err
+	}
+	return v.AssertMultiProof(c.Openings[:], c.Proof)
+}
+
+func multiProofAssignment(t *testing.T) *multiProofCircuit {
+	var assignment multiProofCircuit
+	for i := range tvCommitments {
+		b, err := hex.DecodeString(tvCommitments[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		assignment.Openings[i] = Opening{
+			C: decodePoint(t, b),
+			Z: tvPoints[i],
+			Y: emulated.ValueOf[emparams.BandersnatchFr](tvEvaluations[i]),
+		}
+	}
+	proof, err := hex.DecodeString(tvProof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assignment.Proof.D = decodePoint(t, proof[:32])
+	proof = proof[32:]
+	for i := 0; i < NbRounds; i++ {
+		assignment.Proof.IPA.L[i] = decodePoint(t, proof[32*i:32*(i+1)])
+		assignment.Proof.IPA.R[i] = decodePoint(t, proof[32*(NbRounds+i):32*(NbRounds+i+1)])
+	}
+	assignment.Proof.IPA.A = emulated.ValueOf[emparams.BandersnatchFr](decodeScalarLE(proof[32*2*NbRounds:]))
+	return &assignment
+}
+
+func TestParams(t *testing.T) {
+	assert := test.NewAssert(t)
+	// compare the first elements of the basis against go-ipa
+	expected := []string{
+		"01587ad1336675eb912550ec2a28eb8923b824b490dd2ba82e48f14590a298a0",
+		"6c6e607df0723edfff382fa914bfc38136f3300ab2e06fb97007b559fd323b82",
+		"326be3bebfd97ed9d0d4ca1b8bc47e036a24b129f1488110b71c2cae1463db8f",
+	}
+	params := getParams()
+	for i := range expected {
+		got := params.srs[i].X.Bytes()
+		assert.Equal(expected[i], hex.EncodeToString(got[:]))
+	}
+}
+
+func TestMultiProof(t *testing.T) {
+	assert := test.NewAssert(t)
+	assignment := multiProofAssignment(t)
+	err := test.IsSolved(&multiProofCircuit{}, assignment, ecc.BLS12_381.ScalarField())
+	assert.NoError(err)
+}
+
+func TestMultiProofInvalid(t *testing.T) {
+	assert := test.NewAssert(t)
+	assignment := multiProofAssignment(t)
+	assignment.Openings[1].Y = emulated.ValueOf[emparams.BandersnatchFr](tvEvaluations[1] + 1)
+	err := test.IsSolved(&multiProofCircuit{}, assignment, ecc.BLS12_381.ScalarField())
+	assert.Error(err)
+}
@@ -8,8 +8,11 @@ package algopts
 import "fmt"
 
 type algebraCfg struct {
-	NbScalarBits int
-	FoldMulti    bool
+	NbScalarBits       int
+	FoldMulti          bool
+	CompleteArithmetic bool
+	UseGLV             bool
+	WindowedMSM        bool
 }
 
 // AlgebraOption allows modifying algebraic operation behaviour.
@@ -44,6 +47,54 @@ func WithFoldingScalarMul() AlgebraOption {
 	}
 }
 
+// WithCompleteArithmetic forces the use of complete formulas for group
+// operations. The operations then also handle the edge cases (points at
+// infinity, equal points and zero scalars) which the default incomplete
+// formulas do not. Increases the number of constraints. The implementations
+// without complete variants, e.g. the scalar multiplications in emulated G2,
+// reject the option.
+func WithCompleteArithmetic() AlgebraOption {
+	return func(ac *algebraCfg) error {
+		if ac.CompleteArithmetic {
+			return fmt.Errorf("WithCompleteArithmetic already set")
+		}
+		ac.CompleteArithmetic = true
+		return nil
+	}
+}
+
+// WithGLV enables the scalar multiplication using the GLV decomposition of the
+// scalar when the curve has an efficient endomorphism. It halves the number of
+// iterations, but the formulas are incomplete and the circuit is not
+// satisfiable for a negligible set of scalars and points, which a malicious
+// input may target. Use only when the inputs are not adversarial. The
+// implementations without such variant use their default algorithm.
+func WithGLV() AlgebraOption {
+	return func(ac *algebraCfg) error {
+		if ac.UseGLV {
+			return fmt.Errorf("WithGLV already set")
+		}
+		ac.UseGLV = true
+		return nil
+	}
+}
+
+// WithWindowedMultiScalarMul enables the multi-scalar multiplication using
+// windowed tables of the multiples of the points, where the doublings are
+// shared between all the points. It is cheaper for several points, but the
+// additions are incomplete and the circuit is not satisfiable for a negligible
+// set of inputs. Use only when the inputs are not adversarial. The
+// implementations without such variant use their default algorithm.
+func WithWindowedMultiScalarMul() AlgebraOption {
+	return func(ac *algebraCfg) error {
+		if ac.WindowedMSM {
+			return fmt.Errorf("WithWindowedMultiScalarMul already set")
+		}
+		ac.WindowedMSM = true
+		return nil
+	}
+}
+
 // NewConfig applies all given options and returns a configuration to be used.
 func NewConfig(opts ...AlgebraOption) (*algebraCfg, error) {
 	ret := new(algebraCfg)
@@ -4,12 +4,14 @@ import (
 	"fmt"
 
 	"github.com/consensys/gnark/frontend"
+	emulated_bls12377 "github.com/consensys/gnark/std/algebra/emulated/sw_bls12377"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bw6761"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
 	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
 	"github.com/consensys/gnark/std/algebra/native/sw_bls24315"
+	"github.com/consensys/gnark/std/algebra/native/sw_grumpkin"
 	"github.com/consensys/gnark/std/math/emulated"
 	"github.com/consensys/gnark/std/math/emulated/emparams"
 )
@@ -39,6 +41,12 @@ func GetCurve[FR emulated.FieldParams, G1El G1ElementT](api frontend.API) (Curve
 			return ret, fmt.Errorf("new curve: %w", err)
 		}
 		*s = c
+	case *Curve[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine]:
+		c, err := sw_emulated.New[emparams.BLS12377Fp, emparams.BLS12377Fr](api, sw_emulated.GetBLS12377Params())
+		if err != nil {
+			return ret, fmt.Errorf("new curve: %w", err)
+		}
+		*s = c
 	case *Curve[sw_bls12377.ScalarField, sw_bls12377.G1Affine]:
 		c, err := sw_bls12377.NewCurve(api)
 		if err != nil {
@@ -51,6 +59,30 @@ func GetCurve[FR emulated.FieldParams, G1El G1ElementT](api frontend.API) (Curve
 			return ret, fmt.Errorf("new curve: %w", err)
 		}
 		*s = c
+	case *Curve[sw_grumpkin.ScalarField, sw_grumpkin.G1Affine]:
+		c, err := sw_grumpkin.NewCurve(api)
+		if err != nil {
+			return ret, fmt.Errorf("new curve: %w", err)
+		}
+		*s = c
+	default:
+		return ret, fmt.Errorf("unknown type parametrisation")
+	}
+	return ret, nil
+}
+
+// GetG2Curve returns the [G2Curve] implementation corresponding to the scalar
+// and G2 type parameters. Currently only the emulated BN254, BLS12-381 and
+// BLS12-377 curves are supported.
+func GetG2Curve[FR emulated.FieldParams, G2El G2ElementT](api frontend.API) (G2Curve[FR, G2El], error) {
+	var ret G2Curve[FR, G2El]
+	switch s := any(&ret).(type) {
+	case *G2Curve[sw_bn254.ScalarField, sw_bn254.G2Affine]:
+		*s = sw_bn254.NewG2(api)
+	case *G2Curve[sw_bls12381.ScalarField, sw_bls12381.G2Affine]:
+		*s = sw_bls12381.NewG2(api)
+	case *G2Curve[emulated_bls12377.ScalarField, emulated_bls12377.G2Affine]:
+		*s = emulated_bls12377.NewG2(api)
 	default:
 		return ret, fmt.Errorf("unknown type parametrisation")
 	}
@@ -81,6 +113,12 @@ func GetPairing[G1El G1ElementT, G2El G2ElementT, GtEl GtElementT](api frontend.
 			return ret, fmt.Errorf("new pairing: %w", err)
 		}
 		*s = p
+	case *Pairing[emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]:
+		p, err := emulated_bls12377.NewPairing(api)
+		if err != nil {
+			return ret, fmt.Errorf("new pairing: %w", err)
+		}
+		*s = p
 	case *Pairing[sw_bls12377.G1Affine, sw_bls12377.G2Affine, sw_bls12377.GT]:
 		p := sw_bls12377.NewPairing(api)
 		*s = p
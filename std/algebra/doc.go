@@ -6,12 +6,16 @@
 //
 // These arithmetic operations are implemented
 //   - using native field via the 2-chains BLS12-377/BW6-761 and BLS24-315/BW-633
-//     (`native/`) or associated twisted Edwards (e.g. Jubjub/BLS12-381) and
+//     (`native/`), the 2-cycle BN254/Grumpkin or associated twisted Edwards
+//     (e.g. Jubjub/BLS12-381) and
 //   - using nonnative field via field emulation (`emulated/`). This allows to
 //     use any curve over any (SNARK) field (e.g. secp256k1 curve arithmetic over
 //     BN254 SNARK field or BN254 pairing over BN254 SNARK field).  The drawback
 //     of this approach is the additional cost (~15x) of the operations.
 //
+// The emulated pairings are available for BN254, BLS12-381, BLS12-377 and
+// BW6-761.
+//
 // This package also defines the generic interfaces [Curve] and [Pairing] for
 // downstream curve-agnostic usage.
 package algebra
@@ -312,6 +312,28 @@ func (e Ext2) Inverse(x *E2) *E2 {
 
 }
 
+// Sqrt returns a square root of x. The square root is computed in a hint and
+// the circuit asserts that its square is x, so the circuit is not satisfiable
+// when x is not a square.
+func (e Ext2) Sqrt(x *E2) *E2 {
+	res, err := e.fp.NewHint(sqrtE2Hint, 2, &x.A0, &x.A1)
+	if err != nil {
+		// err is non-nil only for invalid number of inputs
+		panic(err)
+	}
+
+	sqrt := E2{
+		A0: *res[0],
+		A1: *res[1],
+	}
+
+	// x == sqrt * sqrt
+	_x := e.Square(&sqrt)
+	e.AssertIsEqual(x, _x)
+
+	return &sqrt
+}
+
 func (e Ext2) DivUnchecked(x, y *E2) *E2 {
 	res, err := e.fp.NewHint(divE2Hint, 2, &x.A0, &x.A1, &y.A0, &y.A1)
 	if err != nil {
@@ -1,6 +1,7 @@
 package fields_bls12381
 
 import (
+	"fmt"
 	"math/big"
 
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
@@ -18,6 +19,7 @@ func GetHints() []solver.Hint {
 		// E2
 		divE2Hint,
 		inverseE2Hint,
+		sqrtE2Hint,
 		// E6
 		divE6Hint,
 		inverseE6Hint,
@@ -45,6 +47,26 @@ func inverseE2Hint(nativeMod *big.Int, nativeInputs, nativeOutputs []*big.Int) e
 		})
 }
 
+func sqrtE2Hint(nativeMod *big.Int, nativeInputs, nativeOutputs []*big.Int) error {
+	return emulated.UnwrapHint(nativeInputs, nativeOutputs,
+		func(mod *big.Int, inputs, outputs []*big.Int) error {
+			var a, c bls12381.E2
+
+			a.A0.SetBigInt(inputs[0])
+			a.A1.SetBigInt(inputs[1])
+
+			if a.Legendre() == -1 {
+				return fmt.Errorf("no square root")
+			}
+			c.Sqrt(&a)
+
+			c.A0.BigInt(outputs[0])
+			c.A1.BigInt(outputs[1])
+
+			return nil
+		})
+}
+
 func divE2Hint(nativeMod *big.Int, nativeInputs, nativeOutputs []*big.Int) error {
 	return emulated.UnwrapHint(nativeInputs, nativeOutputs,
 		func(mod *big.Int, inputs, outputs []*big.Int) error {
@@ -3,6 +3,7 @@ package fields_bn254
 import (
 	"github.com/consensys/gnark-crypto/ecc/bn254"
 	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
 )
 
 type E12 struct {
@@ -11,10 +12,15 @@ type E12 struct {
 
 type Ext12 struct {
 	*Ext6
+	fr *emulated.Field[emulated.BN254Fr]
 }
 
 func NewExt12(api frontend.API) *Ext12 {
-	return &Ext12{Ext6: NewExt6(api)}
+	fr, err := emulated.NewField[emulated.BN254Fr](api)
+	if err != nil {
+		panic(err)
+	}
+	return &Ext12{Ext6: NewExt6(api), fr: fr}
 }
 
 func (e Ext12) Add(x, y *E12) *E12 {
@@ -115,6 +121,133 @@ func (e Ext12) Square(x *E12) *E12 {
 	}
 }
 
+// CyclotomicSquare computes the square of x in the cyclotomic subgroup of E12.
+// It follows Granger-Scott ([GS09], Section 3.2) and is cheaper than
+// [Ext12.Square].
+//
+// ⚠️  x must be in the cyclotomic subgroup, e.g. be an element of GT.
+//
+// [GS09]: https://eprint.iacr.org/2009/565.pdf
+func (e Ext12) CyclotomicSquare(x *E12) *E12 {
+	// x=(x0,x1,x2,x3,x4,x5,x6,x7) in E2⁶
+	// cyclosquare(x)=(3*x4²*u + 3*x0² - 2*x0,
+	//					3*x2²*u + 3*x3² - 2*x1,
+	//					3*x5²*u + 3*x1² - 2*x2,
+	//					6*x1*x5*u + 2*x3,
+	//					6*x0*x4 + 2*x4,
+	//					6*x2*x3 + 2*x5)
+
+	var t [9]*E2
+
+	t[0] = e.Ext2.Square(&x.C1.B1)
+	t[1] = e.Ext2.Square(&x.C0.B0)
+	t[6] = e.Ext2.Add(&x.C1.B1, &x.C0.B0)
+	t[6] = e.Ext2.Square(t[6])
+	t[6] = e.Ext2.Sub(t[6], t[0])
+	t[6] = e.Ext2.Sub(t[6], t[1]) // 2*x4*x0
+	t[2] = e.Ext2.Square(&x.C0.B2)
+	t[3] = e.Ext2.Square(&x.C1.B0)
+	t[7] = e.Ext2.Add(&x.C0.B2, &x.C1.B0)
+	t[7] = e.Ext2.Square(t[7])
+	t[7] = e.Ext2.Sub(t[7], t[2])
+	t[7] = e.Ext2.Sub(t[7], t[3]) // 2*x2*x3
+	t[4] = e.Ext2.Square(&x.C1.B2)
+	t[5] = e.Ext2.Square(&x.C0.B1)
+	t[8] = e.Ext2.Add(&x.C1.B2, &x.C0.B1)
+	t[8] = e.Ext2.Square(t[8])
+	t[8] = e.Ext2.Sub(t[8], t[4])
+	t[8] = e.Ext2.Sub(t[8], t[5])
+	t[8] = e.Ext2.MulByNonResidue(t[8]) // 2*x5*x1*u
+
+	t[0] = e.Ext2.MulByNonResidue(t[0])
+	t[0] = e.Ext2.Add(t[0], t[1]) // x4²*u + x0²
+	t[2] = e.Ext2.MulByNonResidue(t[2])
+	t[2] = e.Ext2.Add(t[2], t[3]) // x2²*u + x3²
+	t[4] = e.Ext2.MulByNonResidue(t[4])
+	t[4] = e.Ext2.Add(t[4], t[5]) // x5²*u + x1²
+
+	// we use fresh variables for the intermediate results, as the operations
+	// may keep the references to their inputs for the deferred checks.
+	z00 := e.Ext2.Sub(t[0], &x.C0.B0)
+	z00 = e.Ext2.Double(z00)
+	z00 = e.Ext2.Add(z00, t[0])
+	z01 := e.Ext2.Sub(t[2], &x.C0.B1)
+	z01 = e.Ext2.Double(z01)
+	z01 = e.Ext2.Add(z01, t[2])
+	z02 := e.Ext2.Sub(t[4], &x.C0.B2)
+	z02 = e.Ext2.Double(z02)
+	z02 = e.Ext2.Add(z02, t[4])
+
+	z10 := e.Ext2.Add(t[8], &x.C1.B0)
+	z10 = e.Ext2.Double(z10)
+	z10 = e.Ext2.Add(z10, t[8])
+	z11 := e.Ext2.Add(t[6], &x.C1.B1)
+	z11 = e.Ext2.Double(z11)
+	z11 = e.Ext2.Add(z11, t[6])
+	z12 := e.Ext2.Add(t[7], &x.C1.B2)
+	z12 = e.Ext2.Double(z12)
+	z12 = e.Ext2.Add(z12, t[7])
+
+	return &E12{
+		C0: E6{B0: *z00, B1: *z01, B2: *z02},
+		C1: E6{B0: *z10, B1: *z11, B2: *z12},
+	}
+}
+
+// Exp computes x^k, where k is a scalar in the scalar field of the curve, and
+// returns it. It uses the left-to-right square-and-multiply algorithm with
+// [Ext12.CyclotomicSquare] on the canonical bits of k. If k is a constant, then
+// we only multiply for the set bits. Otherwise we process the bits in windows
+// of two and multiply once per window.
+//
+// ⚠️  x must be in the cyclotomic subgroup, e.g. be an element of GT.
+func (e Ext12) Exp(x *E12, k *emulated.Element[emulated.BN254Fr]) *E12 {
+	kBits := e.fr.ToBitsCanonical(k)
+	if e.isConstant(kBits) {
+		var res *E12
+		for i := len(kBits) - 1; i >= 0; i-- {
+			if res != nil {
+				res = e.CyclotomicSquare(res)
+			}
+			if c, _ := e.api.Compiler().ConstantValue(kBits[i]); c.Sign() != 0 {
+				if res == nil {
+					res = x
+				} else {
+					res = e.Mul(res, x)
+				}
+			}
+		}
+		if res == nil {
+			return e.One()
+		}
+		return res
+	}
+	one := e.One()
+	x2 := e.CyclotomicSquare(x)
+	x3 := e.Mul(x2, x)
+	if len(kBits)%2 == 1 {
+		kBits = append(kBits[:len(kBits):len(kBits)], 0)
+	}
+	n := len(kBits)
+	res := e.Lookup2(kBits[n-2], kBits[n-1], one, x, x2, x3)
+	for i := n - 4; i >= 0; i -= 2 {
+		res = e.CyclotomicSquare(res)
+		res = e.CyclotomicSquare(res)
+		res = e.Mul(res, e.Lookup2(kBits[i], kBits[i+1], one, x, x2, x3))
+	}
+	return res
+}
+
+// isConstant returns true if all the bits are constant.
+func (e Ext12) isConstant(bits []frontend.Variable) bool {
+	for i := range bits {
+		if _, ok := e.api.Compiler().ConstantValue(bits[i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 func (e Ext12) AssertIsEqual(x, y *E12) {
 	e.Ext6.AssertIsEqual(&x.C0, &y.C0)
 	e.Ext6.AssertIsEqual(&x.C1, &y.C1)
@@ -1,11 +1,14 @@
 package fields_bn254
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
 	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
 	"github.com/consensys/gnark/test"
 )
 
@@ -618,3 +621,106 @@ func TestTorusSquare(t *testing.T) {
 	err := test.IsSolved(&torusSquare{}, &witness, ecc.BN254.ScalarField())
 	assert.NoError(err)
 }
+
+type e12CyclotomicSquare struct {
+	A E12
+	C E12 `gnark:",public"`
+}
+
+func (circuit *e12CyclotomicSquare) Define(api frontend.API) error {
+	e := NewExt12(api)
+	expected := e.CyclotomicSquare(&circuit.A)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestFp12CyclotomicSquare(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, c bn254.E12
+	_, _ = a.SetRandom()
+
+	// put a in the cyclotomic subgroup
+	var tmp bn254.E12
+	tmp.Conjugate(&a)
+	a.Inverse(&a)
+	tmp.Mul(&tmp, &a)
+	a.FrobeniusSquare(&tmp).Mul(&a, &tmp)
+
+	c.CyclotomicSquare(&a)
+	witness := e12CyclotomicSquare{
+		A: FromE12(&a),
+		C: FromE12(&c),
+	}
+
+	err := test.IsSolved(&e12CyclotomicSquare{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type e12Exp struct {
+	A E12
+	K emulated.Element[emulated.BN254Fr]
+	C E12 `gnark:",public"`
+}
+
+func (circuit *e12Exp) Define(api frontend.API) error {
+	e := NewExt12(api)
+	expected := e.Exp(&circuit.A, &circuit.K)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+type e12ExpConstant struct {
+	A E12
+	C E12 `gnark:",public"`
+	k *big.Int
+}
+
+func (circuit *e12ExpConstant) Define(api frontend.API) error {
+	e := NewExt12(api)
+	k := emulated.ValueOf[emulated.BN254Fr](circuit.k)
+	expected := e.Exp(&circuit.A, &k)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestFp12Exp(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, c bn254.E12
+	_, _ = a.SetRandom()
+
+	// put a in the cyclotomic subgroup
+	var tmp bn254.E12
+	tmp.Conjugate(&a)
+	a.Inverse(&a)
+	tmp.Mul(&tmp, &a)
+	a.FrobeniusSquare(&tmp).Mul(&a, &tmp)
+
+	var k fr.Element
+	_, _ = k.SetRandom()
+	c.CyclotomicExp(a, k.BigInt(new(big.Int)))
+	witness := e12Exp{
+		A: FromE12(&a),
+		K: emulated.ValueOf[emulated.BN254Fr](k),
+		C: FromE12(&c),
+	}
+
+	err := test.IsSolved(&e12Exp{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// zero exponent
+	witness = e12Exp{
+		A: FromE12(&a),
+		K: emulated.ValueOf[emulated.BN254Fr](0),
+		C: FromE12(new(bn254.E12).SetOne()),
+	}
+	err = test.IsSolved(&e12Exp{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// constant exponent
+	err = test.IsSolved(&e12ExpConstant{k: k.BigInt(new(big.Int))}, &e12ExpConstant{A: FromE12(&a), C: FromE12(&c)}, ecc.BN254.ScalarField(), test.SetAllVariablesAsConstants())
+	assert.NoError(err)
+}
@@ -14,6 +14,7 @@ type E6 struct {
 
 type Ext6 struct {
 	*Ext3
+	fr *emulated.Field[emulated.BW6761Fr]
 }
 
 func (e Ext6) Reduce(x *E6) *E6 {
@@ -24,7 +25,11 @@ func (e Ext6) Reduce(x *E6) *E6 {
 }
 
 func NewExt6(api frontend.API) *Ext6 {
-	return &Ext6{Ext3: NewExt3(api)}
+	fr, err := emulated.NewField[emulated.BW6761Fr](api)
+	if err != nil {
+		panic(err)
+	}
+	return &Ext6{Ext3: NewExt3(api), fr: fr}
 }
 
 func (e Ext6) Zero() *E6 {
@@ -417,6 +422,60 @@ func (e Ext6) CyclotomicSquare(x *E6) *E6 {
 	return &z
 }
 
+// Exp computes x^k, where k is a scalar in the scalar field of the curve, and
+// returns it. It uses the left-to-right square-and-multiply algorithm with
+// [Ext6.CyclotomicSquare] on the canonical bits of k. If k is a constant, then
+// we only multiply for the set bits. Otherwise we process the bits in windows
+// of two and multiply once per window.
+//
+// ⚠️  x must be in the cyclotomic subgroup, e.g. be an element of GT.
+func (e Ext6) Exp(x *E6, k *emulated.Element[emulated.BW6761Fr]) *E6 {
+	kBits := e.fr.ToBitsCanonical(k)
+	if e.isConstant(kBits) {
+		var res *E6
+		for i := len(kBits) - 1; i >= 0; i-- {
+			if res != nil {
+				res = e.CyclotomicSquare(res)
+			}
+			if c, _ := e.api.Compiler().ConstantValue(kBits[i]); c.Sign() != 0 {
+				if res == nil {
+					res = x
+				} else {
+					res = e.Mul(res, x)
+				}
+			}
+		}
+		if res == nil {
+			return e.One()
+		}
+		return res
+	}
+	one := e.One()
+	x2 := e.CyclotomicSquare(x)
+	x3 := e.Mul(x2, x)
+	if len(kBits)%2 == 1 {
+		kBits = append(kBits[:len(kBits):len(kBits)], 0)
+	}
+	n := len(kBits)
+	res := e.Select(kBits[n-1], e.Select(kBits[n-2], x3, x2), e.Select(kBits[n-2], x, one))
+	for i := n - 4; i >= 0; i -= 2 {
+		res = e.CyclotomicSquare(res)
+		res = e.CyclotomicSquare(res)
+		res = e.Mul(res, e.Select(kBits[i+1], e.Select(kBits[i], x3, x2), e.Select(kBits[i], x, one)))
+	}
+	return res
+}
+
+// isConstant returns true if all the bits are constant.
+func (e Ext6) isConstant(bits []frontend.Variable) bool {
+	for i := range bits {
+		if _, ok := e.api.Compiler().ConstantValue(bits[i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 func (e Ext6) Inverse(x *E6) *E6 {
 	res, err := e.fp.NewHint(inverseE6Hint, 6, &x.B0.A0, &x.B0.A1, &x.B0.A2, &x.B1.A0, &x.B1.A1, &x.B1.A2)
 	if err != nil {
@@ -263,3 +263,24 @@ func (e *Ext6) Mul01245By014(x [5]*baseEl, d0, d1 *baseEl) *E6 {
 		B1: *z1,
 	}
 }
+
+// CompressTorus compresses x ∈ E6 to (x.B0 + 1)/x.B1 ∈ E3
+func (e Ext6) CompressTorus(x *E6) *E3 {
+	// x ∈ G_{q,2} \ {-1,1}
+	y := e.Ext3.Add(&x.B0, e.Ext3.One())
+	y = e.Ext3.DivUnchecked(y, &x.B1)
+	return y
+}
+
+// DecompressTorus decompresses y ∈ E3 to (y+w)/(y-w) ∈ E6
+func (e Ext6) DecompressTorus(y *E3) *E6 {
+	var n, d E6
+	one := e.Ext3.One()
+	n.B0 = *y
+	n.B1 = *one
+	d.B0 = *y
+	d.B1 = *e.Ext3.Neg(one)
+
+	x := e.DivUnchecked(&n, &d)
+	return x
+}
@@ -1,11 +1,13 @@
 package fields_bw6761
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
 	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
 	"github.com/consensys/gnark-crypto/ecc/bw6-761/fp"
+	"github.com/consensys/gnark-crypto/ecc/bw6-761/fr"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/std/math/emulated"
 	"github.com/consensys/gnark/test"
@@ -402,3 +404,99 @@ func TestFp6MulBy014(t *testing.T) {
 	assert.NoError(err)
 
 }
+
+type e6Exp struct {
+	A E6
+	K emulated.Element[emulated.BW6761Fr]
+	B E6
+}
+
+func (circuit *e6Exp) Define(api frontend.API) error {
+	e := NewExt6(api)
+	expected := e.Exp(&circuit.A, &circuit.K)
+	e.AssertIsEqual(expected, &circuit.B)
+	return nil
+}
+
+type e6ExpConstant struct {
+	A E6
+	B E6 `gnark:",public"`
+	k *big.Int
+}
+
+func (circuit *e6ExpConstant) Define(api frontend.API) error {
+	e := NewExt6(api)
+	k := emulated.ValueOf[emulated.BW6761Fr](circuit.k)
+	expected := e.Exp(&circuit.A, &k)
+	e.AssertIsEqual(expected, &circuit.B)
+	return nil
+}
+
+func TestExpFp6(t *testing.T) {
+	assert := test.NewAssert(t)
+	// witness values
+	var a, b bw6761.E6
+	_, _ = a.SetRandom()
+
+	// put a in the cyclotomic subgroup
+	var tmp bw6761.E6
+	tmp.Conjugate(&a)
+	a.Inverse(&a)
+	tmp.Mul(&tmp, &a)
+	a.Frobenius(&tmp).Mul(&a, &tmp)
+
+	var k fr.Element
+	_, _ = k.SetRandom()
+	b.CyclotomicExp(a, k.BigInt(new(big.Int)))
+
+	witness := e6Exp{
+		A: FromE6(&a),
+		K: emulated.ValueOf[emulated.BW6761Fr](k),
+		B: FromE6(&b),
+	}
+
+	err := test.IsSolved(&e6Exp{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// constant exponent
+	err = test.IsSolved(&e6ExpConstant{k: k.BigInt(new(big.Int))}, &e6ExpConstant{A: FromE6(&a), B: FromE6(&b)}, ecc.BN254.ScalarField(), test.SetAllVariablesAsConstants())
+	assert.NoError(err)
+}
+
+type torusDecompress struct {
+	A E6
+	C E3 `gnark:",public"`
+}
+
+func (circuit *torusDecompress) Define(api frontend.API) error {
+	e := NewExt6(api)
+	compressed := e.CompressTorus(&circuit.A)
+	e.Ext3.AssertIsEqual(compressed, &circuit.C)
+	decompressed := e.DecompressTorus(compressed)
+	e.AssertIsEqual(decompressed, &circuit.A)
+	return nil
+}
+
+func TestTorusDecompress(t *testing.T) {
+	assert := test.NewAssert(t)
+	// witness values
+	var a bw6761.E6
+	_, _ = a.SetRandom()
+
+	// put a in the cyclotomic subgroup
+	var tmp bw6761.E6
+	tmp.Conjugate(&a)
+	a.Inverse(&a)
+	tmp.Mul(&tmp, &a)
+	a.Frobenius(&tmp).Mul(&a, &tmp)
+
+	c, _ := a.CompressTorus()
+
+	witness := torusDecompress{
+		A: FromE6(&a),
+		C: FromE3(&c),
+	}
+
+	err := test.IsSolved(&torusDecompress{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
@@ -0,0 +1,108 @@
+package montgomery
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// New returns a new [Curve] instance over the base field Base with the
+// parameters params. It returns an error if initialising the field emulation
+// fails (for example, when the native field is too small) or when the curve
+// parameters are not compatible with the base field.
+func New[Base emulated.FieldParams](api frontend.API, params CurveParams) (*Curve[Base], error) {
+	ba, err := emulated.NewField[Base](api)
+	if err != nil {
+		return nil, fmt.Errorf("new base api: %w", err)
+	}
+	var fp Base
+	four := big.NewInt(4)
+	if four.ModInverse(four, fp.Modulus()) == nil {
+		return nil, fmt.Errorf("4 is not invertible in the base field")
+	}
+	// a24 = (A-2)/4
+	a24 := new(big.Int).Sub(params.A, big.NewInt(2))
+	a24.Mul(a24, four).Mod(a24, fp.Modulus())
+	return &Curve[Base]{
+		params:  params,
+		api:     api,
+		baseApi: ba,
+		gx:      emulated.ValueOf[Base](params.Gx),
+		a24:     emulated.ValueOf[Base](a24),
+	}, nil
+}
+
+// Curve is an initialised curve which allows performing x-only group
+// operations.
+type Curve[Base emulated.FieldParams] struct {
+	// params is the parameters of the curve
+	params CurveParams
+	// api is the native api, we construct it ourselves to be sure
+	api frontend.API
+	// baseApi is the api for point operations
+	baseApi *emulated.Field[Base]
+
+	// gx is the X-coordinate of the base point of the curve.
+	gx emulated.Element[Base]
+	// a24 is the constant (A-2)/4 used in the Montgomery ladder.
+	a24 emulated.Element[Base]
+}
+
+// Generator returns the X-coordinate of the base point of the curve.
+func (c *Curve[B]) Generator() *emulated.Element[B] {
+	return &c.gx
+}
+
+// ScalarMulX returns the X-coordinate of [s]P, where x is the X-coordinate of
+// P and s = ∑ bits[i] 2^i. The bits are given least significant first and must
+// be boolean. If the result is the point at infinity, then it returns 0.
+//
+// ✅ x can be the X-coordinate of any point on the curve or on its quadratic
+// twist, including 0. x does not need to be reduced.
+//
+// The ladder follows RFC 7748, Section 5. It maintains the projective
+// X-coordinates (X2:Z2) and (X3:Z3) of [k]P and [k+1]P for the prefix k of
+// the scalar and swaps them conditionally depending on the current bit.
+func (c *Curve[B]) ScalarMulX(x *emulated.Element[B], bits []frontend.Variable) *emulated.Element[B] {
+	f := c.baseApi
+	x2, z2 := f.One(), f.Zero()
+	x3, z3 := x, f.One()
+	var swap frontend.Variable = 0
+	for i := len(bits) - 1; i >= 0; i-- {
+		s := c.api.Xor(swap, bits[i])
+		x2, x3 = f.Select(s, x3, x2), f.Select(s, x2, x3)
+		z2, z3 = f.Select(s, z3, z2), f.Select(s, z2, z3)
+		swap = bits[i]
+
+		a := f.Add(x2, z2)
+		aa := f.Mul(a, a)
+		b := f.Sub(x2, z2)
+		bb := f.Mul(b, b)
+		e := f.Sub(aa, bb)
+		cc := f.Add(x3, z3)
+		d := f.Sub(x3, z3)
+		da := f.Mul(d, a)
+		cb := f.Mul(cc, b)
+		t := f.Add(da, cb)
+		x3 = f.Mul(t, t)
+		t = f.Sub(da, cb)
+		z3 = f.Mul(x, f.Mul(t, t))
+		x2 = f.Mul(aa, bb)
+		z2 = f.Mul(e, f.Add(aa, f.Mul(&c.a24, e)))
+	}
+	x2 = f.Select(swap, x3, x2)
+	z2 = f.Select(swap, z3, z2)
+	// if Z2 = 0 then the result is the point at infinity and we return 0.
+	isInf := f.IsZero(z2)
+	z2 = f.Select(isInf, f.One(), z2)
+	return f.Select(isInf, f.Zero(), f.Mul(x2, f.Inverse(z2)))
+}
+
+// ScalarMulBaseX returns the X-coordinate of [s]G, where G is the base point
+// of the curve and s = ∑ bits[i] 2^i. The bits are given least significant
+// first and must be boolean.
+func (c *Curve[B]) ScalarMulBaseX(bits []frontend.Variable) *emulated.Element[B] {
+	return c.ScalarMulX(&c.gx, bits)
+}
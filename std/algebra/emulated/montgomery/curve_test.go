@@ -0,0 +1,140 @@
+package montgomery
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+	"golang.org/x/crypto/curve25519"
+)
+
+const nbScalarBits = 255
+
+type scalarMulXCircuit struct {
+	X    emulated.Element[emulated.Curve25519Fp]
+	Bits [nbScalarBits]frontend.Variable
+	Res  emulated.Element[emulated.Curve25519Fp]
+}
+
+func (circuit *scalarMulXCircuit) Define(api frontend.API) error {
+	cr, err := New[emulated.Curve25519Fp](api, GetCurveParams[emulated.Curve25519Fp]())
+	if err != nil {
+		return err
+	}
+	res := cr.ScalarMulX(&circuit.X, circuit.Bits[:])
+	f, err := emulated.NewField[emulated.Curve25519Fp](api)
+	if err != nil {
+		return err
+	}
+	f.AssertIsEqual(res, &circuit.Res)
+	return nil
+}
+
+type scalarMulBaseXCircuit struct {
+	Bits [nbScalarBits]frontend.Variable
+	Res  emulated.Element[emulated.Curve25519Fp]
+}
+
+func (circuit *scalarMulBaseXCircuit) Define(api frontend.API) error {
+	cr, err := New[emulated.Curve25519Fp](api, GetCurveParams[emulated.Curve25519Fp]())
+	if err != nil {
+		return err
+	}
+	res := cr.ScalarMulBaseX(circuit.Bits[:])
+	f, err := emulated.NewField[emulated.Curve25519Fp](api)
+	if err != nil {
+		return err
+	}
+	f.AssertIsEqual(res, &circuit.Res)
+	return nil
+}
+
+// randomClampedScalar returns a random scalar clamped as in RFC 7748 in little
+// endian encoding and its bits.
+func randomClampedScalar(t *testing.T) ([]byte, [nbScalarBits]frontend.Variable) {
+	s := make([]byte, curve25519.ScalarSize)
+	if _, err := rand.Read(s); err != nil {
+		t.Fatal(err)
+	}
+	s[0] &= 248
+	s[31] &= 127
+	s[31] |= 64
+	var bits [nbScalarBits]frontend.Variable
+	for i := range bits {
+		bits[i] = (s[i/8] >> (i % 8)) & 1
+	}
+	return s, bits
+}
+
+// leToBigInt decodes the little endian encoding b.
+func leToBigInt(b []byte) *big.Int {
+	be := make([]byte, len(b))
+	for i := range b {
+		be[len(b)-1-i] = b[i]
+	}
+	return new(big.Int).SetBytes(be)
+}
+
+func TestScalarMulX(t *testing.T) {
+	assert := test.NewAssert(t)
+	k, _ := randomClampedScalar(t)
+	u, err := curve25519.X25519(k, curve25519.Basepoint)
+	assert.NoError(err)
+	s, bits := randomClampedScalar(t)
+	res, err := curve25519.X25519(s, u)
+	assert.NoError(err)
+	witness := scalarMulXCircuit{
+		X:    emulated.ValueOf[emulated.Curve25519Fp](leToBigInt(u)),
+		Bits: bits,
+		Res:  emulated.ValueOf[emulated.Curve25519Fp](leToBigInt(res)),
+	}
+	err = test.IsSolved(&scalarMulXCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+func TestScalarMulXEdgeCases(t *testing.T) {
+	assert := test.NewAssert(t)
+	k, _ := randomClampedScalar(t)
+	u, err := curve25519.X25519(k, curve25519.Basepoint)
+	assert.NoError(err)
+	_, bits := randomClampedScalar(t)
+	var zero [nbScalarBits]frontend.Variable
+	for i := range zero {
+		zero[i] = 0
+	}
+	for _, tc := range []struct {
+		name string
+		x    *big.Int
+		bits [nbScalarBits]frontend.Variable
+		res  *big.Int
+	}{
+		{"zero scalar", leToBigInt(u), zero, big.NewInt(0)},
+		// (0,0) is of order 2 and the clamped scalars are multiples of 8
+		{"order two", big.NewInt(0), bits, big.NewInt(0)},
+	} {
+		witness := scalarMulXCircuit{
+			X:    emulated.ValueOf[emulated.Curve25519Fp](tc.x),
+			Bits: tc.bits,
+			Res:  emulated.ValueOf[emulated.Curve25519Fp](tc.res),
+		}
+		err = test.IsSolved(&scalarMulXCircuit{}, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, tc.name)
+	}
+}
+
+func TestScalarMulBaseX(t *testing.T) {
+	assert := test.NewAssert(t)
+	s, bits := randomClampedScalar(t)
+	res, err := curve25519.X25519(s, curve25519.Basepoint)
+	assert.NoError(err)
+	witness := scalarMulBaseXCircuit{
+		Bits: bits,
+		Res:  emulated.ValueOf[emulated.Curve25519Fp](leToBigInt(res)),
+	}
+	err = test.IsSolved(&scalarMulBaseXCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
@@ -0,0 +1,23 @@
+/*
+Package montgomery implements the x-only arithmetic of elliptic curves in
+Montgomery form over emulated fields.
+
+The elliptic curve is the set of points (X,Y) satisfying the equation:
+
+	B*Y² = X³ + A*X² + X
+
+over some base field 𝐅p for some constants A, B ∈ 𝐅p. Additionally, for every
+curve we also define its base point G. All these parameters are stored in the
+variable of type [CurveParams].
+
+The package implements the Montgomery ladder which computes the X-coordinate
+of a scalar multiple of a point only from its X-coordinate, see
+[Curve.ScalarMulX]. This is the primitive used for the Diffie-Hellman key
+exchange over Curve25519 (X25519), see [GetCurve25519Params].
+
+Similarly to the package [github.com/consensys/gnark/std/algebra/emulated/sw_emulated],
+the base field is defined by a type parameter and the coefficients of the curve
+by variables. The method [GetCurveParams] allows to resolve the parameters
+from the type parameter.
+*/
+package montgomery
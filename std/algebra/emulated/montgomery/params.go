@@ -0,0 +1,52 @@
+package montgomery
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// CurveParams defines the parameters of an elliptic curve in Montgomery form
+// given by the equation
+//
+//	B*Y² = X³ + A*X² + X
+//
+// The base point is defined by (Gx, Gy).
+type CurveParams struct {
+	A  *big.Int // A in curve equation
+	B  *big.Int // B in curve equation
+	Gx *big.Int // base point x
+	Gy *big.Int // base point y
+}
+
+// GetCurve25519Params returns the curve parameters for the curve Curve25519
+// as defined in RFC 7748. When initialising new curve, use the base field
+// [emulated.Curve25519Fp].
+func GetCurve25519Params() CurveParams {
+	gy, _ := new(big.Int).SetString("14781619447589544791020593568409986887264606134616475288964881837755586237401", 10)
+	return CurveParams{
+		A:  big.NewInt(486662),
+		B:  big.NewInt(1),
+		Gx: big.NewInt(9),
+		Gy: gy,
+	}
+}
+
+// GetCurveParams returns suitable curve parameters given the parametric type
+// Base as base field. It caches the parameters and modifying the values in the
+// parameters struct leads to undefined behaviour.
+func GetCurveParams[Base emulated.FieldParams]() CurveParams {
+	var t Base
+	switch t.Modulus().String() {
+	case emulated.Curve25519Fp{}.Modulus().String():
+		return curve25519Params
+	default:
+		panic("no stored parameters")
+	}
+}
+
+var curve25519Params CurveParams
+
+func init() {
+	curve25519Params = GetCurve25519Params()
+}
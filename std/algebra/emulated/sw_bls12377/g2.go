@@ -0,0 +1,542 @@
+package sw_bls12377
+
+import (
+	"fmt"
+	"math/big"
+
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/algopts"
+	"github.com/consensys/gnark/std/algebra/emulated/fields_bls12377"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// G2 implements the group operations on the twist E'(Fp²) of BLS12-377. It
+// implements the [algebra.G2Curve] interface.
+type G2 struct {
+	api frontend.API
+	fp  *emulated.Field[BaseField]
+	fr  *emulated.Field[ScalarField]
+	*fields_bls12377.Ext2
+	u, v   *emulated.Element[BaseField]
+	bTwist *fields_bls12377.E2
+}
+
+// G2Affine represents G2 element with optional embedded line precomputations.
+type G2Affine struct {
+	X, Y  fields_bls12377.E2
+	Lines *lineEvaluations
+}
+
+func NewG2(api frontend.API) *G2 {
+	u := emulated.ValueOf[BaseField]("80949648264912719408558363140637477264845294720710499478137287262712535938301461879813459410946")
+	v := emulated.ValueOf[BaseField]("216465761340224619389371505802605247630151569547285782856803747159100223055385581585702401816380679166954762214499")
+	// bTwist = 1/u
+	bTwist := fields_bls12377.E2{
+		A0: emulated.ValueOf[BaseField]("0"),
+		A1: emulated.ValueOf[BaseField]("155198655607781456406391640216936120121836107652948796323930557600032281009004493664981332883744016074664192874906"),
+	}
+	fp, err := emulated.NewField[BaseField](api)
+	if err != nil {
+		panic(fmt.Sprintf("new base field: %v", err))
+	}
+	fr, err := emulated.NewField[ScalarField](api)
+	if err != nil {
+		panic(fmt.Sprintf("new scalar field: %v", err))
+	}
+	return &G2{
+		api:    api,
+		fp:     fp,
+		fr:     fr,
+		Ext2:   fields_bls12377.NewExt2(api),
+		u:      &u,
+		v:      &v,
+		bTwist: &bTwist,
+	}
+}
+
+// NewG2Affine allocates a witness from the native G2 element and returns it.
+// The witness has no precomputations. In case of pairing the lines will be
+// computed in-circuit.
+func NewG2Affine(v bls12377.G2Affine) G2Affine {
+	return G2Affine{
+		X: fields_bls12377.E2{
+			A0: emulated.ValueOf[BaseField](v.X.A0),
+			A1: emulated.ValueOf[BaseField](v.X.A1),
+		},
+		Y: fields_bls12377.E2{
+			A0: emulated.ValueOf[BaseField](v.Y.A0),
+			A1: emulated.ValueOf[BaseField](v.Y.A1),
+		},
+	}
+}
+
+// NewG2AffineFixed returns witness of v with precomputations for efficient
+// pairing computation.
+func NewG2AffineFixed(v bls12377.G2Affine) G2Affine {
+	lines := precomputeLines(v)
+	ret := NewG2Affine(v)
+	ret.Lines = &lines
+	return ret
+}
+
+// NewG2AffineFixedPlaceholder returns a placeholder for the circuit compilation
+// when witness will be given with line precomputations using
+// [NewG2AffineFixed].
+func NewG2AffineFixedPlaceholder() G2Affine {
+	var lines lineEvaluations
+	for i := 0; i < len(bls12377.LoopCounter)-1; i++ {
+		lines[0][i] = &lineEvaluation{}
+		lines[1][i] = &lineEvaluation{}
+	}
+	return G2Affine{
+		Lines: &lines,
+	}
+}
+
+func (g2 *G2) psi(q *G2Affine) *G2Affine {
+	x := g2.Ext2.Conjugate(&q.X)
+	x = g2.Ext2.MulByElement(x, g2.u)
+	y := g2.Ext2.Conjugate(&q.Y)
+	y = g2.Ext2.MulByElement(y, g2.v)
+
+	return &G2Affine{
+		X: *x,
+		Y: *y,
+	}
+}
+
+// scalarMulBySeed computes [x₀]q where x₀=9586122913090633729 is the seed of
+// the curve.
+func (g2 *G2) scalarMulBySeed(q *G2Affine) *G2Affine {
+	// x₀ = 136227 << 46 + 1 and we use the shortest addition chain for 136227:
+	// 1 → 33 → 4257 → 68113 → 136227
+	z := g2.doubleN(q, 4)
+	z = g2.doubleAndAdd(z, q)
+	x33 := z
+	z = g2.doubleN(z, 6)
+	z = g2.doubleAndAdd(z, x33)
+	z = g2.doubleN(z, 3)
+	z = g2.doubleAndAdd(z, q)
+	z = g2.doubleAndAdd(z, q)
+	z = g2.doubleN(z, 45)
+	z = g2.doubleAndAdd(z, q)
+
+	return z
+}
+
+func (g2 G2) add(p, q *G2Affine) *G2Affine {
+	// compute λ = (q.y-p.y)/(q.x-p.x)
+	qypy := g2.Ext2.Sub(&q.Y, &p.Y)
+	qxpx := g2.Ext2.Sub(&q.X, &p.X)
+	λ := g2.Ext2.DivUnchecked(qypy, qxpx)
+
+	// xr = λ²-p.x-q.x
+	λλ := g2.Ext2.Square(λ)
+	qxpx = g2.Ext2.Add(&p.X, &q.X)
+	xr := g2.Ext2.Sub(λλ, qxpx)
+
+	// p.y = λ(p.x-r.x) - p.y
+	pxrx := g2.Ext2.Sub(&p.X, xr)
+	λpxrx := g2.Ext2.Mul(λ, pxrx)
+	yr := g2.Ext2.Sub(λpxrx, &p.Y)
+
+	return &G2Affine{
+		X: *xr,
+		Y: *yr,
+	}
+}
+
+func (g2 G2) neg(p *G2Affine) *G2Affine {
+	xr := &p.X
+	yr := g2.Ext2.Neg(&p.Y)
+	return &G2Affine{
+		X: *xr,
+		Y: *yr,
+	}
+}
+
+func (g2 G2) sub(p, q *G2Affine) *G2Affine {
+	qNeg := g2.neg(q)
+	return g2.add(p, qNeg)
+}
+
+func (g2 *G2) double(p *G2Affine) *G2Affine {
+	// compute λ = (3p.x²)/2*p.y
+	xx3a := g2.Square(&p.X)
+	xx3a = g2.MulByConstElement(xx3a, big.NewInt(3))
+	y2 := g2.Double(&p.Y)
+	λ := g2.DivUnchecked(xx3a, y2)
+
+	// xr = λ²-2p.x
+	x2 := g2.Double(&p.X)
+	λλ := g2.Square(λ)
+	xr := g2.Sub(λλ, x2)
+
+	// yr = λ(p-xr) - p.y
+	pxrx := g2.Sub(&p.X, xr)
+	λpxrx := g2.Mul(λ, pxrx)
+	yr := g2.Sub(λpxrx, &p.Y)
+
+	return &G2Affine{
+		X: *xr,
+		Y: *yr,
+	}
+}
+
+func (g2 *G2) doubleN(p *G2Affine, n int) *G2Affine {
+	pn := p
+	for s := 0; s < n; s++ {
+		pn = g2.double(pn)
+	}
+	return pn
+}
+
+func (g2 G2) triple(p *G2Affine) *G2Affine {
+
+	// compute λ1 = (3p.x²)/2p.y
+	xx := g2.Square(&p.X)
+	xx = g2.MulByConstElement(xx, big.NewInt(3))
+	y2 := g2.Double(&p.Y)
+	λ1 := g2.DivUnchecked(xx, y2)
+
+	// xr = λ1²-2p.x
+	x2 := g2.MulByConstElement(&p.X, big.NewInt(2))
+	λ1λ1 := g2.Square(λ1)
+	x2 = g2.Sub(λ1λ1, x2)
+
+	// ommit y2 computation, and
+	// compute λ2 = 2p.y/(x2 − p.x) − λ1.
+	x1x2 := g2.Sub(&p.X, x2)
+	λ2 := g2.DivUnchecked(y2, x1x2)
+	λ2 = g2.Sub(λ2, λ1)
+
+	// xr = λ²-p.x-x2
+	λ2λ2 := g2.Square(λ2)
+	qxrx := g2.Ext2.Add(x2, &p.X)
+	xr := g2.Sub(λ2λ2, qxrx)
+
+	// yr = λ(p.x-xr) - p.y
+	pxrx := g2.Sub(&p.X, xr)
+	λ2pxrx := g2.Mul(λ2, pxrx)
+	yr := g2.Sub(λ2pxrx, &p.Y)
+
+	return &G2Affine{
+		X: *xr,
+		Y: *yr,
+	}
+}
+
+func (g2 G2) doubleAndAdd(p, q *G2Affine) *G2Affine {
+
+	// compute λ1 = (q.y-p.y)/(q.x-p.x)
+	yqyp := g2.Ext2.Sub(&q.Y, &p.Y)
+	xqxp := g2.Ext2.Sub(&q.X, &p.X)
+	λ1 := g2.Ext2.DivUnchecked(yqyp, xqxp)
+
+	// compute x2 = λ1²-p.x-q.x
+	λ1λ1 := g2.Ext2.Square(λ1)
+	xqxp = g2.Ext2.Add(&p.X, &q.X)
+	x2 := g2.Ext2.Sub(λ1λ1, xqxp)
+
+	// ommit y2 computation
+	// compute λ2 = -λ1-2*p.y/(x2-p.x)
+	ypyp := g2.Ext2.Add(&p.Y, &p.Y)
+	x2xp := g2.Ext2.Sub(x2, &p.X)
+	λ2 := g2.Ext2.DivUnchecked(ypyp, x2xp)
+	λ2 = g2.Ext2.Add(λ1, λ2)
+	λ2 = g2.Ext2.Neg(λ2)
+
+	// compute x3 =λ2²-p.x-x3
+	λ2λ2 := g2.Ext2.Square(λ2)
+	x3 := g2.Ext2.Sub(λ2λ2, &p.X)
+	x3 = g2.Ext2.Sub(x3, x2)
+
+	// compute y3 = λ2*(p.x - x3)-p.y
+	y3 := g2.Ext2.Sub(&p.X, x3)
+	y3 = g2.Ext2.Mul(λ2, y3)
+	y3 = g2.Ext2.Sub(y3, &p.Y)
+
+	return &G2Affine{
+		X: *x3,
+		Y: *y3,
+	}
+}
+
+// AssertIsEqual asserts that p and q are the same point.
+func (g2 *G2) AssertIsEqual(p, q *G2Affine) {
+	g2.Ext2.AssertIsEqual(&p.X, &q.X)
+	g2.Ext2.AssertIsEqual(&p.Y, &q.Y)
+}
+
+// doubleAndAddSelect is the same as doubleAndAdd but computes either:
+//
+//	2p+q is b=1 or
+//	2q+p is b=0
+//
+// It first computes the x-coordinate of p+q via the slope(p,q)
+// and then based on a Select adds either p or q.
+func (g2 G2) doubleAndAddSelect(b frontend.Variable, p, q *G2Affine) *G2Affine {
+
+	// compute λ1 = (q.y-p.y)/(q.x-p.x)
+	yqyp := g2.Ext2.Sub(&q.Y, &p.Y)
+	xqxp := g2.Ext2.Sub(&q.X, &p.X)
+	λ1 := g2.Ext2.DivUnchecked(yqyp, xqxp)
+
+	// compute x2 = λ1²-p.x-q.x
+	λ1λ1 := g2.Ext2.Square(λ1)
+	xqxp = g2.Ext2.Add(&p.X, &q.X)
+	x2 := g2.Ext2.Sub(λ1λ1, xqxp)
+
+	// ommit y2 computation
+
+	// conditional second addition
+	t := g2.selectPoint(b, p, q)
+
+	// compute λ2 = -λ1-2*t.y/(x2-t.x)
+	ypyp := g2.Ext2.Add(&t.Y, &t.Y)
+	x2xp := g2.Ext2.Sub(x2, &t.X)
+	λ2 := g2.Ext2.DivUnchecked(ypyp, x2xp)
+	λ2 = g2.Ext2.Add(λ1, λ2)
+	λ2 = g2.Ext2.Neg(λ2)
+
+	// compute x3 =λ2²-t.x-x3
+	λ2λ2 := g2.Ext2.Square(λ2)
+	x3 := g2.Ext2.Sub(λ2λ2, &t.X)
+	x3 = g2.Ext2.Sub(x3, x2)
+
+	// compute y3 = λ2*(t.x - x3)-t.y
+	y3 := g2.Ext2.Sub(&t.X, x3)
+	y3 = g2.Ext2.Mul(λ2, y3)
+	y3 = g2.Ext2.Sub(y3, &t.Y)
+
+	return g2.reduce(&G2Affine{
+		X: *x3,
+		Y: *y3,
+	})
+}
+
+// reduce reduces the coordinates of p modulo the base field modulus. It is
+// used in the loops of the scalar multiplication where the unreduced
+// coordinates would otherwise accumulate overflow over the iterations.
+func (g2 G2) reduce(p *G2Affine) *G2Affine {
+	return &G2Affine{
+		X: fields_bls12377.E2{A0: *g2.fp.Reduce(&p.X.A0), A1: *g2.fp.Reduce(&p.X.A1)},
+		Y: fields_bls12377.E2{A0: *g2.fp.Reduce(&p.Y.A0), A1: *g2.fp.Reduce(&p.Y.A1)},
+	}
+}
+
+// selectPoint selects between p and q given the selector b. If b == 1, then
+// returns p and q otherwise.
+func (g2 G2) selectPoint(b frontend.Variable, p, q *G2Affine) *G2Affine {
+	return &G2Affine{
+		X: *g2.Ext2.Select(b, &p.X, &q.X),
+		Y: *g2.Ext2.Select(b, &p.Y, &q.Y),
+	}
+}
+
+// Neg returns an inverse of p. It doesn't modify p.
+func (g2 *G2) Neg(p *G2Affine) *G2Affine {
+	return g2.neg(p)
+}
+
+// AddUnified adds p and q and returns it. It doesn't modify p nor q.
+//
+// ✅ p can be equal to q, and either or both can be (0,0).
+// (0,0) is not on the twist but we conventionally take it as the
+// neutral/infinity point.
+//
+// It uses the unified formulas of Brier and Joye ([[BriJoy02]] (Corollary 1)).
+//
+// [BriJoy02]: https://link.springer.com/content/pdf/10.1007/3-540-45664-3_24.pdf
+func (g2 *G2) AddUnified(p, q *G2Affine) *G2Affine {
+
+	// selector1 = 1 when p is (0,0) and 0 otherwise
+	selector1 := g2.api.And(g2.Ext2.IsZero(&p.X), g2.Ext2.IsZero(&p.Y))
+	// selector2 = 1 when q is (0,0) and 0 otherwise
+	selector2 := g2.api.And(g2.Ext2.IsZero(&q.X), g2.Ext2.IsZero(&q.Y))
+
+	// λ = ((p.x+q.x)² - p.x*q.x)/(p.y + q.y)
+	pxqx := g2.Ext2.Mul(&p.X, &q.X)
+	pxplusqx := g2.Ext2.Add(&p.X, &q.X)
+	num := g2.Ext2.Square(pxplusqx)
+	num = g2.Ext2.Sub(num, pxqx)
+	denum := g2.Ext2.Add(&p.Y, &q.Y)
+	// if p.y + q.y = 0, assign dummy 1 to denum and continue
+	selector3 := g2.Ext2.IsZero(denum)
+	denum = g2.Ext2.Select(selector3, g2.Ext2.One(), denum)
+	λ := g2.Ext2.DivUnchecked(num, denum)
+
+	// x = λ^2 - p.x - q.x
+	xr := g2.Ext2.Square(λ)
+	xr = g2.Ext2.Sub(xr, pxplusqx)
+
+	// y = λ(p.x - xr) - p.y
+	yr := g2.Ext2.Sub(&p.X, xr)
+	yr = g2.Ext2.Mul(yr, λ)
+	yr = g2.Ext2.Sub(yr, &p.Y)
+	result := g2.reduce(&G2Affine{
+		X: *xr,
+		Y: *yr,
+	})
+
+	zero := g2.Ext2.Zero()
+	infinity := &G2Affine{X: *zero, Y: *zero}
+	// if p=(0,0) return q
+	result = g2.selectPoint(selector1, q, result)
+	// if q=(0,0) return p
+	result = g2.selectPoint(selector2, p, result)
+	// if p.y + q.y = 0, return (0, 0)
+	result = g2.selectPoint(selector3, infinity, result)
+
+	return result
+}
+
+// Add calls [G2.AddUnified]. It is defined for implementing the generic G2
+// curve interface.
+func (g2 *G2) Add(p, q *G2Affine) *G2Affine {
+	return g2.AddUnified(p, q)
+}
+
+// AssertIsOnTwist asserts that Q is on the twist E'(Fp²) or is the point
+// (0,0).
+func (g2 *G2) AssertIsOnTwist(Q *G2Affine) {
+	// Twist: Y² == X³ + aX + b, where a=0 and b=1/u
+	// (X,Y) ∈ {Y² == X³ + aX + b} U (0,0)
+
+	// if Q=(0,0) we assign b=0 otherwise 1/u, and continue
+	selector := g2.api.And(g2.Ext2.IsZero(&Q.X), g2.Ext2.IsZero(&Q.Y))
+	b := g2.Ext2.Select(selector, g2.Ext2.Zero(), g2.bTwist)
+
+	left := g2.Ext2.Square(&Q.Y)
+	right := g2.Ext2.Square(&Q.X)
+	right = g2.Ext2.Mul(right, &Q.X)
+	right = g2.Ext2.Add(right, b)
+	g2.Ext2.AssertIsEqual(left, right)
+}
+
+// AssertIsOnG2 asserts that Q is on the twist and in the prime-order subgroup
+// G2.
+func (g2 *G2) AssertIsOnG2(Q *G2Affine) {
+	// 1- Check Q is on the curve
+	g2.AssertIsOnTwist(Q)
+
+	// 2- Check Q has the right subgroup order
+	// [x₀]Q
+	xQ := g2.scalarMulBySeed(Q)
+	// ψ(Q)
+	psiQ := g2.psi(Q)
+
+	// [r]Q == 0 <==>  ψ(Q) == [x₀]Q
+	g2.AssertIsEqual(xQ, psiQ)
+}
+
+// ScalarMul computes s * p and returns it. It doesn't modify p nor s.
+// This function doesn't check that the p is on the twist. See AssertIsOnTwist.
+//
+// ✅ p can can be (0,0) and s can be 0.
+// (0,0) is not on the twist but we conventionally take it as the
+// neutral/infinity point.
+//
+// It computes the right-to-left variable-base double-and-add algorithm
+// ([Joye07], Alg.1) in the same way as [sw_emulated.Curve.ScalarMul].
+//
+// The option [algopts.WithCompleteArithmetic] is not supported and the method
+// panics if it is given.
+//
+// [Joye07]: https://www.iacr.org/archive/ches2007/47270135/47270135.pdf
+func (g2 *G2) ScalarMul(p *G2Affine, s *Scalar, opts ...algopts.AlgebraOption) *G2Affine {
+	cfg, err := algopts.NewConfig(opts...)
+	if err != nil {
+		panic(fmt.Sprintf("parse opts: %v", err))
+	}
+	if cfg.CompleteArithmetic {
+		panic("complete arithmetic is not supported for G2")
+	}
+
+	// if p=(0,0) we assign a dummy (1,1) to p and continue
+	selector := g2.api.And(g2.Ext2.IsZero(&p.X), g2.Ext2.IsZero(&p.Y))
+	one := g2.Ext2.One()
+	p = g2.selectPoint(selector, &G2Affine{X: *one, Y: *one}, p)
+
+	var st ScalarField
+	sr := g2.fr.Reduce(s)
+	sBits := g2.fr.ToBits(sr)
+	n := st.Modulus().BitLen()
+	if cfg.NbScalarBits > 2 && cfg.NbScalarBits < n {
+		n = cfg.NbScalarBits
+	}
+
+	// i = 1
+	Rb := g2.triple(p)
+	R0 := g2.selectPoint(sBits[1], Rb, p)
+	R1 := g2.selectPoint(sBits[1], p, Rb)
+
+	for i := 2; i < n-1; i++ {
+		Rb = g2.doubleAndAddSelect(sBits[i], R0, R1)
+		R0 = g2.selectPoint(sBits[i], Rb, R0)
+		R1 = g2.selectPoint(sBits[i], R1, Rb)
+	}
+
+	// i = n-1
+	Rb = g2.doubleAndAddSelect(sBits[n-1], R0, R1)
+	R0 = g2.selectPoint(sBits[n-1], Rb, R0)
+
+	// i = 0
+	// we use AddUnified here instead of add so that when s=0, res=(0,0)
+	// because AddUnified(p, -p) = (0,0)
+	R0 = g2.selectPoint(sBits[0], R0, g2.AddUnified(R0, g2.neg(p)))
+
+	// if p=(0,0), return (0,0)
+	zero := g2.Ext2.Zero()
+	R0 = g2.selectPoint(selector, &G2Affine{X: *zero, Y: *zero}, R0)
+
+	return R0
+}
+
+// MultiScalarMul computes the multi scalar multiplication of the points P and
+// scalars s. It returns an error if the length of the slices mismatch. If the
+// input slices are empty, then returns point at infinity.
+//
+// For the points and scalars the same edge cases apply as for [G2.ScalarMul].
+// It returns an error if the option [algopts.WithCompleteArithmetic] is given.
+func (g2 *G2) MultiScalarMul(p []*G2Affine, s []*Scalar, opts ...algopts.AlgebraOption) (*G2Affine, error) {
+	if len(p) == 0 {
+		return &G2Affine{
+			X: *g2.Ext2.Zero(),
+			Y: *g2.Ext2.Zero(),
+		}, nil
+	}
+	cfg, err := algopts.NewConfig(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("new config: %w", err)
+	}
+	if cfg.CompleteArithmetic {
+		return nil, fmt.Errorf("complete arithmetic is not supported for G2")
+	}
+	if !cfg.FoldMulti {
+		// the scalars are unique
+		if len(p) != len(s) {
+			return nil, fmt.Errorf("mismatching points and scalars slice lengths")
+		}
+		res := g2.ScalarMul(p[0], s[0], opts...)
+		for i := 1; i < len(p); i++ {
+			q := g2.ScalarMul(p[i], s[i], opts...)
+			res = g2.AddUnified(res, q)
+		}
+		return res, nil
+	} else {
+		// scalars are powers
+		if len(s) == 0 {
+			return nil, fmt.Errorf("need scalar for folding")
+		}
+		gamma := s[0]
+		res := g2.ScalarMul(p[len(p)-1], gamma, opts...)
+		for i := len(p) - 2; i > 0; i-- {
+			res = g2.AddUnified(p[i], res)
+			res = g2.ScalarMul(res, gamma, opts...)
+		}
+		res = g2.AddUnified(p[0], res)
+		return res, nil
+	}
+}
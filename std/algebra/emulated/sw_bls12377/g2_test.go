@@ -0,0 +1,275 @@
+package sw_bls12377
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	fr_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/algopts"
+	"github.com/consensys/gnark/test"
+)
+
+type addG2Circuit struct {
+	In1, In2 G2Affine
+	Res      G2Affine
+}
+
+func (c *addG2Circuit) Define(api frontend.API) error {
+	g2 := NewG2(api)
+	res := g2.add(&c.In1, &c.In2)
+	g2.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestAddG2TestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, in1 := randomG1G2Affines()
+	_, in2 := randomG1G2Affines()
+	var res bls12377.G2Affine
+	res.Add(&in1, &in2)
+	witness := addG2Circuit{
+		In1: NewG2Affine(in1),
+		In2: NewG2Affine(in2),
+		Res: NewG2Affine(res),
+	}
+	err := test.IsSolved(&addG2Circuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type doubleG2Circuit struct {
+	In1 G2Affine
+	Res G2Affine
+}
+
+func (c *doubleG2Circuit) Define(api frontend.API) error {
+	g2 := NewG2(api)
+	res := g2.double(&c.In1)
+	g2.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestDoubleG2TestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, in1 := randomG1G2Affines()
+	var res bls12377.G2Affine
+	var in1Jac, resJac bls12377.G2Jac
+	in1Jac.FromAffine(&in1)
+	resJac.Double(&in1Jac)
+	res.FromJacobian(&resJac)
+	witness := doubleG2Circuit{
+		In1: NewG2Affine(in1),
+		Res: NewG2Affine(res),
+	}
+	err := test.IsSolved(&doubleG2Circuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type doubleAndAddG2Circuit struct {
+	In1, In2 G2Affine
+	Res      G2Affine
+}
+
+func (c *doubleAndAddG2Circuit) Define(api frontend.API) error {
+	g2 := NewG2(api)
+	res := g2.doubleAndAdd(&c.In1, &c.In2)
+	g2.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestDoubleAndAddG2TestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, in1 := randomG1G2Affines()
+	_, in2 := randomG1G2Affines()
+	var res bls12377.G2Affine
+	res.Double(&in1).
+		Add(&res, &in2)
+	witness := doubleAndAddG2Circuit{
+		In1: NewG2Affine(in1),
+		In2: NewG2Affine(in2),
+		Res: NewG2Affine(res),
+	}
+	err := test.IsSolved(&doubleAndAddG2Circuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type scalarMulG2BySeedCircuit struct {
+	In1 G2Affine
+	Res G2Affine
+}
+
+func (c *scalarMulG2BySeedCircuit) Define(api frontend.API) error {
+	g2 := NewG2(api)
+	res := g2.scalarMulBySeed(&c.In1)
+	g2.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestScalarMulG2BySeedTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, in1 := randomG1G2Affines()
+	var res bls12377.G2Affine
+	x0, _ := new(big.Int).SetString("9586122913090633729", 10)
+	res.ScalarMultiplication(&in1, x0)
+	witness := scalarMulG2BySeedCircuit{
+		In1: NewG2Affine(in1),
+		Res: NewG2Affine(res),
+	}
+	err := test.IsSolved(&scalarMulG2BySeedCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type addUnifiedG2Circuit struct {
+	In1, In2 G2Affine
+	Res      G2Affine
+}
+
+func (c *addUnifiedG2Circuit) Define(api frontend.API) error {
+	g2 := NewG2(api)
+	res := g2.AddUnified(&c.In1, &c.In2)
+	g2.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestAddUnifiedG2TestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, in1 := randomG1G2Affines()
+	_, in2 := randomG1G2Affines()
+	var neg, infinity bls12377.G2Affine
+	neg.Neg(&in1)
+	testCases := []struct {
+		in1, in2 bls12377.G2Affine
+	}{
+		{in1, in2},
+		{in1, in1},
+		{in1, neg},
+		{in1, infinity},
+		{infinity, in2},
+		{infinity, infinity},
+	}
+	for _, tc := range testCases {
+		var res bls12377.G2Affine
+		res.Add(&tc.in1, &tc.in2)
+		witness := addUnifiedG2Circuit{
+			In1: NewG2Affine(tc.in1),
+			In2: NewG2Affine(tc.in2),
+			Res: NewG2Affine(res),
+		}
+		err := test.IsSolved(&addUnifiedG2Circuit{}, &witness, ecc.BLS12_381.ScalarField())
+		assert.NoError(err)
+	}
+}
+
+type scalarMulG2Circuit struct {
+	In  G2Affine
+	S   Scalar
+	Res G2Affine
+}
+
+func (c *scalarMulG2Circuit) Define(api frontend.API) error {
+	g2 := NewG2(api)
+	g2.AssertIsOnG2(&c.In)
+	res := g2.ScalarMul(&c.In, &c.S)
+	g2.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestScalarMulG2TestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, in := randomG1G2Affines()
+	var s fr_bls12377.Element
+	s.SetRandom()
+	var res bls12377.G2Affine
+	res.ScalarMultiplication(&in, s.BigInt(new(big.Int)))
+	witness := scalarMulG2Circuit{
+		In:  NewG2Affine(in),
+		S:   NewScalar(s),
+		Res: NewG2Affine(res),
+	}
+	err := test.IsSolved(&scalarMulG2Circuit{}, &witness, ecc.BLS12_381.ScalarField())
+	assert.NoError(err)
+
+	// zero scalar
+	var infinity bls12377.G2Affine
+	witness = scalarMulG2Circuit{
+		In:  NewG2Affine(in),
+		S:   NewScalar(fr_bls12377.NewElement(0)),
+		Res: NewG2Affine(infinity),
+	}
+	err = test.IsSolved(&scalarMulG2Circuit{}, &witness, ecc.BLS12_381.ScalarField())
+	assert.NoError(err)
+
+	// wrong result
+	witness = scalarMulG2Circuit{
+		In:  NewG2Affine(in),
+		S:   NewScalar(s),
+		Res: NewG2Affine(in),
+	}
+	err = test.IsSolved(&scalarMulG2Circuit{}, &witness, ecc.BLS12_381.ScalarField())
+	assert.Error(err)
+}
+
+type multiScalarMulG2Circuit struct {
+	Points   [3]G2Affine
+	Scalars  [3]Scalar
+	Res      G2Affine
+	complete bool
+}
+
+func (c *multiScalarMulG2Circuit) Define(api frontend.API) error {
+	g2 := NewG2(api)
+	ps := make([]*G2Affine, len(c.Points))
+	ss := make([]*Scalar, len(c.Scalars))
+	for i := range c.Points {
+		ps[i] = &c.Points[i]
+		ss[i] = &c.Scalars[i]
+	}
+	var opts []algopts.AlgebraOption
+	if c.complete {
+		opts = append(opts, algopts.WithCompleteArithmetic())
+	}
+	res, err := g2.MultiScalarMul(ps, ss, opts...)
+	if err != nil {
+		return err
+	}
+	g2.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestMultiScalarMulG2TestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	var witness multiScalarMulG2Circuit
+	var res, tmp bls12377.G2Affine
+	for i := range witness.Points {
+		_, p := randomG1G2Affines()
+		var s fr_bls12377.Element
+		s.SetRandom()
+		tmp.ScalarMultiplication(&p, s.BigInt(new(big.Int)))
+		res.Add(&res, &tmp)
+		witness.Points[i] = NewG2Affine(p)
+		witness.Scalars[i] = NewScalar(s)
+	}
+	witness.Res = NewG2Affine(res)
+	err := test.IsSolved(&multiScalarMulG2Circuit{}, &witness, ecc.BLS12_381.ScalarField())
+	assert.NoError(err)
+}
+
+func TestMultiScalarMulG2CompleteUnsupported(t *testing.T) {
+	assert := test.NewAssert(t)
+	var witness multiScalarMulG2Circuit
+	var res, tmp bls12377.G2Affine
+	for i := range witness.Points {
+		_, p := randomG1G2Affines()
+		var s fr_bls12377.Element
+		s.SetRandom()
+		tmp.ScalarMultiplication(&p, s.BigInt(new(big.Int)))
+		res.Add(&res, &tmp)
+		witness.Points[i] = NewG2Affine(p)
+		witness.Scalars[i] = NewScalar(s)
+	}
+	witness.Res = NewG2Affine(res)
+	err := test.IsSolved(&multiScalarMulG2Circuit{complete: true}, &witness, ecc.BLS12_381.ScalarField())
+	assert.Error(err)
+}
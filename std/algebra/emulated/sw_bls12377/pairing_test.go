@@ -0,0 +1,319 @@
+package sw_bls12377
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+func randomG1G2Affines() (bls12377.G1Affine, bls12377.G2Affine) {
+	_, _, G1AffGen, G2AffGen := bls12377.Generators()
+	mod := bls12377.ID.ScalarField()
+	s1, err := rand.Int(rand.Reader, mod)
+	if err != nil {
+		panic(err)
+	}
+	s2, err := rand.Int(rand.Reader, mod)
+	if err != nil {
+		panic(err)
+	}
+	var p bls12377.G1Affine
+	p.ScalarMultiplication(&G1AffGen, s1)
+	var q bls12377.G2Affine
+	q.ScalarMultiplication(&G2AffGen, s2)
+	return p, q
+}
+
+type FinalExponentiationCircuit struct {
+	InGt GTEl
+	Res  GTEl
+}
+
+func (c *FinalExponentiationCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	res1 := pairing.FinalExponentiation(&c.InGt)
+	pairing.AssertIsEqual(res1, &c.Res)
+	res2 := pairing.FinalExponentiationUnsafe(&c.InGt)
+	pairing.AssertIsEqual(res2, &c.Res)
+	return nil
+}
+
+func TestFinalExponentiationTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	var gt bls12377.GT
+	gt.SetRandom()
+	res := bls12377.FinalExponentiation(&gt)
+	witness := FinalExponentiationCircuit{
+		InGt: NewGTEl(gt),
+		Res:  NewGTEl(res),
+	}
+	err := test.IsSolved(&FinalExponentiationCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type PairCircuit struct {
+	InG1 G1Affine
+	InG2 G2Affine
+	Res  GTEl
+}
+
+func (c *PairCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	pairing.AssertIsOnG1(&c.InG1)
+	pairing.AssertIsOnG2(&c.InG2)
+	res, err := pairing.Pair([]*G1Affine{&c.InG1}, []*G2Affine{&c.InG2})
+	if err != nil {
+		return fmt.Errorf("pair: %w", err)
+	}
+	pairing.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestPairTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p, q := randomG1G2Affines()
+	res, err := bls12377.Pair([]bls12377.G1Affine{p}, []bls12377.G2Affine{q})
+	assert.NoError(err)
+	witness := PairCircuit{
+		InG1: NewG1Affine(p),
+		InG2: NewG2Affine(q),
+		Res:  NewGTEl(res),
+	}
+	err = test.IsSolved(&PairCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type MultiPairCircuit struct {
+	In1G1 G1Affine
+	In2G1 G1Affine
+	In1G2 G2Affine
+	In2G2 G2Affine
+	Res   GTEl
+}
+
+func (c *MultiPairCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	pairing.AssertIsOnG1(&c.In1G1)
+	pairing.AssertIsOnG1(&c.In2G1)
+	pairing.AssertIsOnG2(&c.In1G2)
+	pairing.AssertIsOnG2(&c.In2G2)
+	res, err := pairing.Pair([]*G1Affine{&c.In1G1, &c.In1G1, &c.In2G1, &c.In2G1}, []*G2Affine{&c.In1G2, &c.In2G2, &c.In1G2, &c.In2G2})
+	if err != nil {
+		return fmt.Errorf("pair: %w", err)
+	}
+	pairing.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestMultiPairTestSolve(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+	assert := test.NewAssert(t)
+	p1, q1 := randomG1G2Affines()
+	p2, q2 := randomG1G2Affines()
+	res, err := bls12377.Pair([]bls12377.G1Affine{p1, p1, p2, p2}, []bls12377.G2Affine{q1, q2, q1, q2})
+	assert.NoError(err)
+	witness := MultiPairCircuit{
+		In1G1: NewG1Affine(p1),
+		In1G2: NewG2Affine(q1),
+		In2G1: NewG1Affine(p2),
+		In2G2: NewG2Affine(q2),
+		Res:   NewGTEl(res),
+	}
+	err = test.IsSolved(&MultiPairCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type PairingCheckCircuit struct {
+	In1G1 G1Affine
+	In2G1 G1Affine
+	In1G2 G2Affine
+	In2G2 G2Affine
+}
+
+func (c *PairingCheckCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	err = pairing.PairingCheck([]*G1Affine{&c.In1G1, &c.In1G1, &c.In2G1, &c.In2G1}, []*G2Affine{&c.In1G2, &c.In2G2, &c.In1G2, &c.In2G2})
+	if err != nil {
+		return fmt.Errorf("pair: %w", err)
+	}
+	return nil
+}
+
+func TestPairingCheckTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p1, q1 := randomG1G2Affines()
+	_, q2 := randomG1G2Affines()
+	var p2 bls12377.G1Affine
+	p2.Neg(&p1)
+	witness := PairingCheckCircuit{
+		In1G1: NewG1Affine(p1),
+		In1G2: NewG2Affine(q1),
+		In2G1: NewG1Affine(p2),
+		In2G2: NewG2Affine(q2),
+	}
+	err := test.IsSolved(&PairingCheckCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type PairingCheckFixedQCircuit struct {
+	In1G1 G1Affine
+	In2G1 G1Affine
+	q1    bls12377.G2Affine `gnark:"-"`
+	q2    bls12377.G2Affine `gnark:"-"`
+}
+
+func (c *PairingCheckFixedQCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	err = pairing.PairingCheckFixedQ([]*G1Affine{&c.In1G1, &c.In1G1, &c.In2G1, &c.In2G1}, []bls12377.G2Affine{c.q1, c.q2, c.q1, c.q2})
+	if err != nil {
+		return fmt.Errorf("pair: %w", err)
+	}
+	return nil
+}
+
+func TestPairingCheckFixedQTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p1, q1 := randomG1G2Affines()
+	p2, q2 := randomG1G2Affines()
+	var p3 bls12377.G1Affine
+	p3.Neg(&p1)
+	witness := PairingCheckFixedQCircuit{
+		In1G1: NewG1Affine(p1),
+		In2G1: NewG1Affine(p3),
+	}
+	err := test.IsSolved(&PairingCheckFixedQCircuit{q1: q1, q2: q2}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	// the check should fail for a non-trivial product
+	witness.In2G1 = NewG1Affine(p2)
+	err = test.IsSolved(&PairingCheckFixedQCircuit{q1: q1, q2: q2}, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+type PairPrecomputedCircuit struct {
+	InG1      G1Affine
+	InG2Fixed G2Affine
+	InG2      G2Affine
+	Res1      GTEl
+	Res       GTEl
+}
+
+func (c *PairPrecomputedCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	res1, err := pairing.Pair([]*G1Affine{&c.InG1}, []*G2Affine{&c.InG2Fixed})
+	if err != nil {
+		return fmt.Errorf("pair: %w", err)
+	}
+	pairing.AssertIsEqual(res1, &c.Res1)
+	res, err := pairing.Pair([]*G1Affine{&c.InG1, &c.InG1}, []*G2Affine{&c.InG2Fixed, &c.InG2})
+	if err != nil {
+		return fmt.Errorf("pair: %w", err)
+	}
+	pairing.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestPairPrecomputedTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p, q1 := randomG1G2Affines()
+	_, q2 := randomG1G2Affines()
+	res1, err := bls12377.Pair([]bls12377.G1Affine{p}, []bls12377.G2Affine{q1})
+	assert.NoError(err)
+	res, err := bls12377.Pair([]bls12377.G1Affine{p, p}, []bls12377.G2Affine{q1, q2})
+	assert.NoError(err)
+	witness := PairPrecomputedCircuit{
+		InG1:      NewG1Affine(p),
+		InG2Fixed: NewG2AffineFixed(q1),
+		InG2:      NewG2Affine(q2),
+		Res1:      NewGTEl(res1),
+		Res:       NewGTEl(res),
+	}
+	err = test.IsSolved(&PairPrecomputedCircuit{InG2Fixed: NewG2AffineFixedPlaceholder()}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type FinalExponentiationSafeCircuit struct {
+	P1, P2 G1Affine
+	Q1, Q2 G2Affine
+}
+
+func (c *FinalExponentiationSafeCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return err
+	}
+	res, err := pairing.MillerLoop([]*G1Affine{&c.P1, &c.P2}, []*G2Affine{&c.Q1, &c.Q2})
+	if err != nil {
+		return err
+	}
+	res2 := pairing.FinalExponentiation(res)
+	one := pairing.Ext12.One()
+	pairing.AssertIsEqual(one, res2)
+	return nil
+}
+
+func TestFinalExponentiationSafeCircuit(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, _, p1, q1 := bls12377.Generators()
+	var p2 bls12377.G1Affine
+	var q2 bls12377.G2Affine
+	p2.Neg(&p1)
+	q2.Set(&q1)
+	err := test.IsSolved(&FinalExponentiationSafeCircuit{}, &FinalExponentiationSafeCircuit{
+		P1: NewG1Affine(p1),
+		P2: NewG1Affine(p2),
+		Q1: NewG2Affine(q1),
+		Q2: NewG2Affine(q2),
+	}, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type GroupMembershipCircuit struct {
+	InG1 G1Affine
+	InG2 G2Affine
+}
+
+func (c *GroupMembershipCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	pairing.AssertIsOnG1(&c.InG1)
+	pairing.AssertIsOnG2(&c.InG2)
+	return nil
+}
+
+func TestGroupMembershipSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p, q := randomG1G2Affines()
+	witness := GroupMembershipCircuit{
+		InG1: NewG1Affine(p),
+		InG2: NewG2Affine(q),
+	}
+	err := test.IsSolved(&GroupMembershipCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
@@ -0,0 +1,29 @@
+package sw_bls12377
+
+import (
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark/std/algebra/emulated/fields_bls12377"
+)
+
+// lineEvaluations are the lines of the Miller loop for a fixed G2 point Q. The
+// first row holds the tangent lines and the second row the lines through Q
+// when the loop counter is non-zero.
+type lineEvaluations [2][len(bls12377.LoopCounter) - 1]*lineEvaluation
+
+// precomputeLines computes natively the lines of the Miller loop for Q and
+// returns them as constants which can be used in-circuit.
+func precomputeLines(Q bls12377.G2Affine) lineEvaluations {
+	var cLines lineEvaluations
+	nLines := bls12377.PrecomputeLines(Q)
+	for j := range cLines[0] {
+		cLines[0][j] = &lineEvaluation{
+			R0: fields_bls12377.FromE2(&nLines[0][j].R0),
+			R1: fields_bls12377.FromE2(&nLines[0][j].R1),
+		}
+		cLines[1][j] = &lineEvaluation{
+			R0: fields_bls12377.FromE2(&nLines[1][j].R0),
+			R1: fields_bls12377.FromE2(&nLines[1][j].R1),
+		}
+	}
+	return cLines
+}
@@ -6,7 +6,9 @@ import (
 
 	"github.com/consensys/gnark-crypto/ecc"
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	fr_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/algopts"
 	"github.com/consensys/gnark/test"
 )
 
@@ -118,3 +120,156 @@ func TestScalarMulG2BySeedTestSolve(t *testing.T) {
 	err := test.IsSolved(&scalarMulG2BySeedCircuit{}, &witness, ecc.BN254.ScalarField())
 	assert.NoError(err)
 }
+
+type addUnifiedG2Circuit struct {
+	In1, In2 G2Affine
+	Res      G2Affine
+}
+
+func (c *addUnifiedG2Circuit) Define(api frontend.API) error {
+	g2 := NewG2(api)
+	res := g2.AddUnified(&c.In1, &c.In2)
+	g2.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestAddUnifiedG2TestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, in1 := randomG1G2Affines()
+	_, in2 := randomG1G2Affines()
+	var neg, infinity bls12381.G2Affine
+	neg.Neg(&in1)
+	testCases := []struct {
+		in1, in2 bls12381.G2Affine
+	}{
+		{in1, in2},
+		{in1, in1},
+		{in1, neg},
+		{in1, infinity},
+		{infinity, in2},
+		{infinity, infinity},
+	}
+	for _, tc := range testCases {
+		var res bls12381.G2Affine
+		res.Add(&tc.in1, &tc.in2)
+		witness := addUnifiedG2Circuit{
+			In1: NewG2Affine(tc.in1),
+			In2: NewG2Affine(tc.in2),
+			Res: NewG2Affine(res),
+		}
+		err := test.IsSolved(&addUnifiedG2Circuit{}, &witness, ecc.BLS12_381.ScalarField())
+		assert.NoError(err)
+	}
+}
+
+type scalarMulG2Circuit struct {
+	In  G2Affine
+	S   Scalar
+	Res G2Affine
+}
+
+func (c *scalarMulG2Circuit) Define(api frontend.API) error {
+	g2 := NewG2(api)
+	g2.AssertIsOnG2(&c.In)
+	res := g2.ScalarMul(&c.In, &c.S)
+	g2.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestScalarMulG2TestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, in := randomG1G2Affines()
+	var s fr_bls12381.Element
+	s.SetRandom()
+	var res bls12381.G2Affine
+	res.ScalarMultiplication(&in, s.BigInt(new(big.Int)))
+	witness := scalarMulG2Circuit{
+		In:  NewG2Affine(in),
+		S:   NewScalar(s),
+		Res: NewG2Affine(res),
+	}
+	err := test.IsSolved(&scalarMulG2Circuit{}, &witness, ecc.BLS12_381.ScalarField())
+	assert.NoError(err)
+
+	// zero scalar
+	var infinity bls12381.G2Affine
+	witness = scalarMulG2Circuit{
+		In:  NewG2Affine(in),
+		S:   NewScalar(fr_bls12381.NewElement(0)),
+		Res: NewG2Affine(infinity),
+	}
+	err = test.IsSolved(&scalarMulG2Circuit{}, &witness, ecc.BLS12_381.ScalarField())
+	assert.NoError(err)
+
+	// wrong result
+	witness = scalarMulG2Circuit{
+		In:  NewG2Affine(in),
+		S:   NewScalar(s),
+		Res: NewG2Affine(in),
+	}
+	err = test.IsSolved(&scalarMulG2Circuit{}, &witness, ecc.BLS12_381.ScalarField())
+	assert.Error(err)
+}
+
+type multiScalarMulG2Circuit struct {
+	Points   [3]G2Affine
+	Scalars  [3]Scalar
+	Res      G2Affine
+	complete bool
+}
+
+func (c *multiScalarMulG2Circuit) Define(api frontend.API) error {
+	g2 := NewG2(api)
+	ps := make([]*G2Affine, len(c.Points))
+	ss := make([]*Scalar, len(c.Scalars))
+	for i := range c.Points {
+		ps[i] = &c.Points[i]
+		ss[i] = &c.Scalars[i]
+	}
+	var opts []algopts.AlgebraOption
+	if c.complete {
+		opts = append(opts, algopts.WithCompleteArithmetic())
+	}
+	res, err := g2.MultiScalarMul(ps, ss, opts...)
+	if err != nil {
+		return err
+	}
+	g2.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestMultiScalarMulG2TestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	var witness multiScalarMulG2Circuit
+	var res, tmp bls12381.G2Affine
+	for i := range witness.Points {
+		_, p := randomG1G2Affines()
+		var s fr_bls12381.Element
+		s.SetRandom()
+		tmp.ScalarMultiplication(&p, s.BigInt(new(big.Int)))
+		res.Add(&res, &tmp)
+		witness.Points[i] = NewG2Affine(p)
+		witness.Scalars[i] = NewScalar(s)
+	}
+	witness.Res = NewG2Affine(res)
+	err := test.IsSolved(&multiScalarMulG2Circuit{}, &witness, ecc.BLS12_381.ScalarField())
+	assert.NoError(err)
+}
+
+func TestMultiScalarMulG2CompleteUnsupported(t *testing.T) {
+	assert := test.NewAssert(t)
+	var witness multiScalarMulG2Circuit
+	var res, tmp bls12381.G2Affine
+	for i := range witness.Points {
+		_, p := randomG1G2Affines()
+		var s fr_bls12381.Element
+		s.SetRandom()
+		tmp.ScalarMultiplication(&p, s.BigInt(new(big.Int)))
+		res.Add(&res, &tmp)
+		witness.Points[i] = NewG2Affine(p)
+		witness.Scalars[i] = NewScalar(s)
+	}
+	witness.Res = NewG2Affine(res)
+	err := test.IsSolved(&multiScalarMulG2Circuit{complete: true}, &witness, ecc.BLS12_381.ScalarField())
+	assert.Error(err)
+}
@@ -0,0 +1,152 @@
+package sw_bls12381
+
+import (
+	"fmt"
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/fields_bls12381"
+	"github.com/consensys/gnark/std/math/emulated"
+	"golang.org/x/exp/slices"
+)
+
+// UnmarshalG1Compressed unmarshals the point from its compressed encoding in
+// bits, most significant bit first. The encoding is the zcash serialization,
+// also used by [bls12381.G1Affine.Bytes] in gnark-crypto: the big-endian
+// encoding of X on 48 bytes. As the base field modulus has 381 bits, the three
+// most significant bits are free and store the flags
+//
+//	bit 0: the compression flag, must be set
+//	bit 1: the infinity flag
+//	bit 2: the sort flag, set if Y is the lexicographically largest square
+//	       root of Y²
+//
+// The accepted metadata are thus
+//
+//	100 -> use the lexicographically smallest square root of Y²
+//	101 -> use the lexicographically largest square root of Y²
+//	110 -> the point at infinity, all the other bits are zero
+//
+// and in particular the sort flag must not be set for the point at infinity.
+// It returns an error if the number of bits does not match the encoding
+// length.
+//
+// The method asserts that the metadata is valid, that X is canonical (less
+// than the modulus) and that the point is in G1. As G1 has a non-trivial
+// cofactor, the subgroup membership is asserted in addition to the curve
+// equation. The Y-coordinate is recovered using a square root hint. The point
+// at infinity is returned as (0,0).
+func (pr Pairing) UnmarshalG1Compressed(bits []frontend.Variable) (*G1Affine, error) {
+	if len(bits) != 8*bls12381.SizeOfG1AffineCompressed {
+		return nil, fmt.Errorf("invalid number of bits: %d, expected %d", len(bits), 8*bls12381.SizeOfG1AffineCompressed)
+	}
+	isInfinity, largest := pr.unmarshalMetadata(bits)
+	x := pr.unmarshalFp(bits[3:])
+
+	// if the point is at infinity, we continue with the generator as a dummy
+	// point and return (0,0) at the end.
+	_, _, g1, _ := bls12381.Generators()
+	gx := emulated.ValueOf[BaseField](g1.X)
+	x = pr.curveF.Select(isInfinity, &gx, x)
+
+	// Y² = X³ + 4
+	four := emulated.ValueOf[BaseField](4)
+	y2 := pr.curveF.Mul(x, pr.curveF.Mul(x, x))
+	y2 = pr.curveF.Add(y2, &four)
+	y := pr.curveF.Sqrt(y2)
+	neg := pr.api.Xor(pr.isLargest(y), largest)
+	y = pr.curveF.Select(neg, pr.curveF.Neg(y), y)
+	pr.AssertIsOnG1(&G1Affine{X: *x, Y: *y})
+
+	zero := pr.curveF.Zero()
+	return &G1Affine{
+		X: *pr.curveF.Select(isInfinity, zero, x),
+		Y: *pr.curveF.Select(isInfinity, zero, y),
+	}, nil
+}
+
+// UnmarshalG2Compressed unmarshals the point from its compressed encoding in
+// bits, most significant bit first. The encoding is the zcash serialization,
+// also used by [bls12381.G2Affine.Bytes] in gnark-crypto: the big-endian
+// encodings of X.A1 and X.A0 on 48 bytes each, where the three most
+// significant bits of X.A1 store the flags as in
+// [Pairing.UnmarshalG1Compressed]. The lexicographic order of Y is given by
+// Y.A1, or by Y.A0 when Y.A1 is zero. It returns an error if the number of
+// bits does not match the encoding length.
+//
+// The method asserts that the metadata is valid, that the coordinates of X are
+// canonical and that the point is in G2. The point at infinity is returned as
+// (0,0).
+func (pr Pairing) UnmarshalG2Compressed(bits []frontend.Variable) (*G2Affine, error) {
+	if len(bits) != 8*bls12381.SizeOfG2AffineCompressed {
+		return nil, fmt.Errorf("invalid number of bits: %d, expected %d", len(bits), 8*bls12381.SizeOfG2AffineCompressed)
+	}
+	isInfinity, largest := pr.unmarshalMetadata(bits)
+	nbBits := 8 * bls12381.SizeOfG1AffineCompressed
+	x := &fields_bls12381.E2{
+		A0: *pr.unmarshalFp(bits[nbBits:]),
+		A1: *pr.unmarshalFp(bits[3:nbBits]),
+	}
+
+	// if the point is at infinity, we continue with the generator as a dummy
+	// point and return (0,0) at the end.
+	_, _, _, g2 := bls12381.Generators()
+	gx := fields_bls12381.FromE2(&g2.X)
+	x = pr.g2.Ext2.Select(isInfinity, &gx, x)
+
+	// Y² = X³ + b'
+	y2 := pr.g2.Ext2.Mul(x, pr.g2.Ext2.Square(x))
+	y2 = pr.g2.Ext2.Add(y2, pr.g2.bTwist)
+	y := pr.g2.Ext2.Sqrt(y2)
+	// the sign is defined by the lexicographic order of A1, or of A0 when A1
+	// is zero.
+	yLargest := pr.api.Select(pr.curveF.IsZero(&y.A1), pr.isLargest(&y.A0), pr.isLargest(&y.A1))
+	neg := pr.api.Xor(yLargest, largest)
+	y = pr.g2.Ext2.Select(neg, pr.g2.Ext2.Neg(y), y)
+	pr.AssertIsOnG2(&G2Affine{X: *x, Y: *y})
+
+	zero := pr.g2.Ext2.Zero()
+	return &G2Affine{
+		X: *pr.g2.Ext2.Select(isInfinity, zero, x),
+		Y: *pr.g2.Ext2.Select(isInfinity, zero, y),
+	}, nil
+}
+
+// unmarshalMetadata asserts that the three flags of the compressed encoding
+// are valid and returns the infinity and sort flags. For the point at infinity
+// it also asserts that the sort flag and all the other bits are zero.
+func (pr Pairing) unmarshalMetadata(bits []frontend.Variable) (isInfinity, largest frontend.Variable) {
+	// the compression flag is set
+	pr.api.AssertIsEqual(bits[0], 1)
+	pr.api.AssertIsBoolean(bits[1])
+	pr.api.AssertIsBoolean(bits[2])
+	isInfinity, largest = bits[1], bits[2]
+	// the point at infinity doesn't have the sort flag set
+	var sum frontend.Variable = largest
+	for i := 3; i < len(bits); i++ {
+		sum = pr.api.Add(sum, bits[i])
+	}
+	pr.api.AssertIsEqual(pr.api.Mul(isInfinity, sum), 0)
+	return isInfinity, largest
+}
+
+// unmarshalFp returns the element given by its big-endian encoding in bits
+// and asserts that it is canonical.
+func (pr Pairing) unmarshalFp(bits []frontend.Variable) *emulated.Element[BaseField] {
+	bx := make([]frontend.Variable, len(bits))
+	copy(bx, bits)
+	slices.Reverse(bx)
+	x := pr.curveF.FromBits(bx...)
+	pr.curveF.AssertIsInRange(x)
+	return x
+}
+
+// isLargest returns 1 if y is lexicographically larger than -y, i.e. if the
+// canonical representation of y is larger than (p-1)/2, and 0 otherwise.
+func (pr Pairing) isLargest(y *emulated.Element[BaseField]) frontend.Variable {
+	var fp BaseField
+	half := new(big.Int).Rsh(fp.Modulus(), 1)
+	h := emulated.ValueOf[BaseField](half)
+	return pr.curveF.IsLess(&h, y)
+}
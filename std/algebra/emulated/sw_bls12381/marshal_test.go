@@ -0,0 +1,256 @@
+package sw_bls12381
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+// the flags in the three most significant bits of the zcash compressed
+// encoding
+const (
+	flagCompressed byte = 0b100
+	flagInfinity   byte = 0b010
+	flagSort       byte = 0b001
+)
+
+// bytesToBits returns the bits of b, most significant bit first.
+func bytesToBits(b []byte) []frontend.Variable {
+	res := make([]frontend.Variable, 8*len(b))
+	for i := range b {
+		for j := 0; j < 8; j++ {
+			res[i*8+j] = (b[i] >> (7 - j)) & 1
+		}
+	}
+	return res
+}
+
+// flags returns the three most significant bits of the encoding b.
+func flags(b []byte) byte {
+	return b[0] >> 5
+}
+
+// setFlags sets the three most significant bits of the encoding b to f.
+func setFlags(b []byte, f byte) {
+	b[0] = b[0]&0b00011111 | f<<5
+}
+
+type unmarshalG1CompressedCircuit struct {
+	In  [8 * bls12381.SizeOfG1AffineCompressed]frontend.Variable
+	Res G1Affine
+}
+
+func (c *unmarshalG1CompressedCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return err
+	}
+	res, err := pairing.UnmarshalG1Compressed(c.In[:])
+	if err != nil {
+		return err
+	}
+	pairing.curve.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+// unmarshalG1CompressedOnlyCircuit only decodes the input, so that the
+// invalid encodings are not rejected because of a mismatching result.
+type unmarshalG1CompressedOnlyCircuit struct {
+	In [8 * bls12381.SizeOfG1AffineCompressed]frontend.Variable
+}
+
+func (c *unmarshalG1CompressedOnlyCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return err
+	}
+	_, err = pairing.UnmarshalG1Compressed(c.In[:])
+	return err
+}
+
+func TestUnmarshalG1CompressedTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p, _ := randomG1G2Affines()
+	var smallest, largest, inf bls12381.G1Affine
+	smallest.Set(&p)
+	if smallest.Y.LexicographicallyLargest() {
+		smallest.Neg(&smallest)
+	}
+	largest.Neg(&smallest)
+	for _, tc := range []struct {
+		name string
+		p    bls12381.G1Affine
+		f    byte
+	}{
+		{"smallest", smallest, flagCompressed},
+		{"largest", largest, flagCompressed | flagSort},
+		{"infinity", inf, flagCompressed | flagInfinity},
+	} {
+		b := tc.p.Bytes()
+		assert.Equal(tc.f, flags(b[:]), tc.name)
+		var witness unmarshalG1CompressedCircuit
+		copy(witness.In[:], bytesToBits(b[:]))
+		witness.Res = NewG1Affine(tc.p)
+		err := test.IsSolved(&unmarshalG1CompressedCircuit{}, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, tc.name)
+	}
+}
+
+func TestUnmarshalG1CompressedInvalid(t *testing.T) {
+	assert := test.NewAssert(t)
+	p, _ := randomG1G2Affines()
+	// a point on the curve, but not in G1
+	var q bls12381.G1Affine
+	four := fp.NewElement(4)
+	for {
+		q.X.SetRandom()
+		var y2 fp.Element
+		y2.Square(&q.X).Mul(&y2, &q.X).Add(&y2, &four)
+		if q.Y.Sqrt(&y2) != nil && !q.IsInSubGroup() {
+			break
+		}
+	}
+	// a point in G1 such that X + p fits in the 381 bits
+	_, _, g, _ := bls12381.Generators()
+	r := g
+	bound := new(big.Int).Lsh(big.NewInt(1), 381)
+	bound.Sub(bound, fp.Modulus())
+	for r.X.BigInt(new(big.Int)).Cmp(bound) >= 0 {
+		r.Add(&r, &g)
+	}
+	// the unmodified encoding is valid
+	b := p.Bytes()
+	var witness unmarshalG1CompressedOnlyCircuit
+	copy(witness.In[:], bytesToBits(b[:]))
+	err := test.IsSolved(&unmarshalG1CompressedOnlyCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	for _, tc := range []struct {
+		name   string
+		modify func(b []byte)
+	}{
+		{"compression flag unset", func(b []byte) { setFlags(b, flags(b)&^flagCompressed) }},
+		{"infinity with non-zero X", func(b []byte) { setFlags(b, flagCompressed|flagInfinity) }},
+		{"infinity with sort flag", func(b []byte) {
+			for i := range b {
+				b[i] = 0
+			}
+			setFlags(b, flagCompressed|flagInfinity|flagSort)
+		}},
+		{"non-canonical", func(b []byte) {
+			rb := r.Bytes()
+			v := r.X.BigInt(new(big.Int))
+			v.Add(v, fp.Modulus()).FillBytes(b)
+			setFlags(b, flags(rb[:]))
+		}},
+		{"not in G1", func(b []byte) {
+			qb := q.Bytes()
+			copy(b, qb[:])
+		}},
+	} {
+		b := p.Bytes()
+		tc.modify(b[:])
+		var witness unmarshalG1CompressedOnlyCircuit
+		copy(witness.In[:], bytesToBits(b[:]))
+		err := test.IsSolved(&unmarshalG1CompressedOnlyCircuit{}, &witness, ecc.BN254.ScalarField())
+		assert.Error(err, tc.name)
+	}
+}
+
+type unmarshalG2CompressedCircuit struct {
+	In  [8 * bls12381.SizeOfG2AffineCompressed]frontend.Variable
+	Res G2Affine
+}
+
+func (c *unmarshalG2CompressedCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return err
+	}
+	res, err := pairing.UnmarshalG2Compressed(c.In[:])
+	if err != nil {
+		return err
+	}
+	pairing.g2.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+// unmarshalG2CompressedOnlyCircuit only decodes the input, so that the
+// invalid encodings are not rejected because of a mismatching result.
+type unmarshalG2CompressedOnlyCircuit struct {
+	In [8 * bls12381.SizeOfG2AffineCompressed]frontend.Variable
+}
+
+func (c *unmarshalG2CompressedOnlyCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return err
+	}
+	_, err = pairing.UnmarshalG2Compressed(c.In[:])
+	return err
+}
+
+func TestUnmarshalG2CompressedTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, q := randomG1G2Affines()
+	var smallest, largest, inf bls12381.G2Affine
+	smallest.Set(&q)
+	if smallest.Y.LexicographicallyLargest() {
+		smallest.Neg(&smallest)
+	}
+	largest.Neg(&smallest)
+	for _, tc := range []struct {
+		name string
+		q    bls12381.G2Affine
+		f    byte
+	}{
+		{"smallest", smallest, flagCompressed},
+		{"largest", largest, flagCompressed | flagSort},
+		{"infinity", inf, flagCompressed | flagInfinity},
+	} {
+		b := tc.q.Bytes()
+		assert.Equal(tc.f, flags(b[:]), tc.name)
+		var witness unmarshalG2CompressedCircuit
+		copy(witness.In[:], bytesToBits(b[:]))
+		witness.Res = NewG2Affine(tc.q)
+		err := test.IsSolved(&unmarshalG2CompressedCircuit{}, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, tc.name)
+	}
+}
+
+func TestUnmarshalG2CompressedInvalid(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, q := randomG1G2Affines()
+	// the unmodified encoding is valid
+	b := q.Bytes()
+	var witness unmarshalG2CompressedOnlyCircuit
+	copy(witness.In[:], bytesToBits(b[:]))
+	err := test.IsSolved(&unmarshalG2CompressedOnlyCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	for _, tc := range []struct {
+		name   string
+		modify func(b []byte)
+	}{
+		// the flags are stored in X.A1
+		{"compression flag unset", func(b []byte) { setFlags(b, flags(b)&^flagCompressed) }},
+		{"infinity with non-zero X", func(b []byte) { setFlags(b, flagCompressed|flagInfinity) }},
+		// X.A0 + p fits in the 48 bytes of X.A0
+		{"non-canonical", func(b []byte) {
+			v := q.X.A0.BigInt(new(big.Int))
+			v.Add(v, fp.Modulus()).FillBytes(b[bls12381.SizeOfG1AffineCompressed:])
+		}},
+	} {
+		b := q.Bytes()
+		tc.modify(b[:])
+		var witness unmarshalG2CompressedOnlyCircuit
+		copy(witness.In[:], bytesToBits(b[:]))
+		err := test.IsSolved(&unmarshalG2CompressedOnlyCircuit{}, &witness, ecc.BN254.ScalarField())
+		assert.Error(err, tc.name)
+	}
+}
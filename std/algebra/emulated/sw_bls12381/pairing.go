@@ -19,7 +19,6 @@ type Pairing struct {
 	g2     *G2
 	g1     *G1
 	curve  *sw_emulated.Curve[BaseField, ScalarField]
-	bTwist *fields_bls12381.E2
 	lines  [4][63]fields_bls12381.E2
 }
 
@@ -67,10 +66,6 @@ func NewPairing(api frontend.API) (*Pairing, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new curve: %w", err)
 	}
-	bTwist := fields_bls12381.E2{
-		A0: emulated.ValueOf[BaseField]("4"),
-		A1: emulated.ValueOf[BaseField]("4"),
-	}
 	g1, err := NewG1(api)
 	if err != nil {
 		return nil, fmt.Errorf("new G1 struct: %w", err)
@@ -82,7 +77,6 @@ func NewPairing(api frontend.API) (*Pairing, error) {
 		curve:  curve,
 		g1:     g1,
 		g2:     NewG2(api),
-		bTwist: &bTwist,
 		lines:  getPrecomputedLines(),
 	}, nil
 }
@@ -249,28 +243,50 @@ func (pr Pairing) PairingCheck(P []*G1Affine, Q []*G2Affine) error {
 	return nil
 }
 
+// PairingCheckFixedQ calculates the reduced pairing for a set of points and
+// asserts if the result is One
+// ∏ᵢ e(Pᵢ, Qᵢ) =? 1
+// where Qᵢ are constant points in G2. The lines of the Miller loop are
+// precomputed natively at circuit compile time and only evaluated at Pᵢ
+// in-circuit.
+//
+// This function doesn't check that the inputs are in the correct subgroups. See AssertIsOnG1.
+func (pr Pairing) PairingCheckFixedQ(P []*G1Affine, Q []bls12381.G2Affine) error {
+	lines := make([]lineEvaluations, len(Q))
+	for k := range Q {
+		lines[k] = precomputeLines(Q[k])
+	}
+	res, err := pr.millerLoopLines(P, lines)
+	if err != nil {
+		return fmt.Errorf("miller loop: %w", err)
+	}
+	res = pr.finalExponentiation(res, len(P) == 1)
+	pr.AssertIsEqual(res, pr.One())
+	return nil
+}
+
 func (pr Pairing) AssertIsEqual(x, y *GTEl) {
 	pr.Ext12.AssertIsEqual(x, y)
 }
 
+// MulGT computes the product of the target group elements and returns it. It
+// doesn't modify the inputs.
+func (pr Pairing) MulGT(x, y *GTEl) *GTEl {
+	return pr.Ext12.Mul(x, y)
+}
+
+// ExpGT computes x^k for the target group element x and returns it. It doesn't
+// modify the inputs.
+func (pr Pairing) ExpGT(x *GTEl, k *Scalar) *GTEl {
+	return pr.Ext12.Exp(x, k)
+}
+
 func (pr Pairing) AssertIsOnCurve(P *G1Affine) {
 	pr.curve.AssertIsOnCurve(P)
 }
 
 func (pr Pairing) AssertIsOnTwist(Q *G2Affine) {
-	// Twist: Y² == X³ + aX + b, where a=0 and b=4(1+u)
-	// (X,Y) ∈ {Y² == X³ + aX + b} U (0,0)
-
-	// if Q=(0,0) we assign b=0 otherwise 3/(9+u), and continue
-	selector := pr.api.And(pr.Ext2.IsZero(&Q.X), pr.Ext2.IsZero(&Q.Y))
-
-	b := pr.Ext2.Select(selector, pr.Ext2.Zero(), pr.bTwist)
-
-	left := pr.Ext2.Square(&Q.Y)
-	right := pr.Ext2.Square(&Q.X)
-	right = pr.Ext2.Mul(right, &Q.X)
-	right = pr.Ext2.Add(right, b)
-	pr.Ext2.AssertIsEqual(left, right)
+	pr.g2.AssertIsOnTwist(Q)
 }
 
 func (pr Pairing) AssertIsOnG1(P *G1Affine) {
@@ -291,17 +307,7 @@ func (pr Pairing) AssertIsOnG1(P *G1Affine) {
 }
 
 func (pr Pairing) AssertIsOnG2(Q *G2Affine) {
-	// 1- Check Q is on the curve
-	pr.AssertIsOnTwist(Q)
-
-	// 2- Check Q has the right subgroup order
-	// [x₀]Q
-	xQ := pr.g2.scalarMulBySeed(Q)
-	// ψ(Q)
-	psiQ := pr.g2.psi(Q)
-
-	// [r]Q == 0 <==>  ψ(Q) == [x₀]Q
-	pr.g2.AssertIsEqual(xQ, psiQ)
+	pr.g2.AssertIsOnG2(Q)
 }
 
 // loopCounter = seed in binary
@@ -318,6 +324,9 @@ var loopCounter = [64]int8{
 
 // MillerLoop computes the multi-Miller loop
 // ∏ᵢ { fᵢ_{u,Q}(P) }
+//
+// If some Qᵢ have embedded line precomputations (see [NewG2AffineFixed]), then
+// the lines are only evaluated at Pᵢ for these points.
 func (pr Pairing) MillerLoop(P []*G1Affine, Q []*G2Affine) (*GTEl, error) {
 	// check input size match
 	n := len(P)
@@ -325,6 +334,44 @@ func (pr Pairing) MillerLoop(P []*G1Affine, Q []*G2Affine) (*GTEl, error) {
 		return nil, errors.New("invalid inputs sizes")
 	}
 
+	// split the inputs depending on whether the lines are precomputed
+	var PVar, PFixed []*G1Affine
+	var QVar []*G2Affine
+	var lines []lineEvaluations
+	for k := 0; k < n; k++ {
+		if Q[k].Lines != nil {
+			PFixed = append(PFixed, P[k])
+			lines = append(lines, *Q[k].Lines)
+		} else {
+			PVar = append(PVar, P[k])
+			QVar = append(QVar, Q[k])
+		}
+	}
+	if len(lines) == 0 {
+		return pr.millerLoop(PVar, QVar)
+	}
+	if len(QVar) == 0 {
+		return pr.millerLoopLines(PFixed, lines)
+	}
+	resVar, err := pr.millerLoop(PVar, QVar)
+	if err != nil {
+		return nil, err
+	}
+	resFixed, err := pr.millerLoopLines(PFixed, lines)
+	if err != nil {
+		return nil, err
+	}
+	return pr.Mul(resVar, resFixed), nil
+}
+
+// millerLoop computes the multi-Miller loop with the lines computed in-circuit.
+func (pr Pairing) millerLoop(P []*G1Affine, Q []*G2Affine) (*GTEl, error) {
+	// check input size match
+	n := len(P)
+	if n == 0 || n != len(Q) {
+		return nil, errors.New("invalid inputs sizes")
+	}
+
 	res := pr.Ext12.One()
 
 	var l1, l2 *lineEvaluation
@@ -464,6 +511,92 @@ func (pr Pairing) MillerLoop(P []*G1Affine, Q []*G2Affine) (*GTEl, error) {
 	return res, nil
 }
 
+// millerLoopLines computes the multi-Miller loop as in MillerLoop but using the
+// precomputed lines of the fixed points Qᵢ. The lines are only evaluated at Pᵢ.
+func (pr Pairing) millerLoopLines(P []*G1Affine, lines []lineEvaluations) (*GTEl, error) {
+	// check input size match
+	n := len(P)
+	if n == 0 || n != len(lines) {
+		return nil, errors.New("invalid inputs sizes")
+	}
+
+	res := pr.Ext12.One()
+
+	yInv := make([]*emulated.Element[BaseField], n)
+	xNegOverY := make([]*emulated.Element[BaseField], n)
+	for k := 0; k < n; k++ {
+		// 1/y is well defined for all points P's (see MillerLoop).
+		yInv[k] = pr.curveF.Inverse(&P[k].Y)
+		xNegOverY[k] = pr.curveF.MulMod(&P[k].X, yInv[k])
+		xNegOverY[k] = pr.curveF.Neg(xNegOverY[k])
+	}
+
+	// lineEval evaluates the line at P[k]
+	lineEval := func(l *lineEvaluation, k int) *lineEvaluation {
+		return &lineEvaluation{
+			R0: *pr.MulByElement(&l.R0, xNegOverY[k]),
+			R1: *pr.MulByElement(&l.R1, yInv[k]),
+		}
+	}
+
+	// Compute ∏ᵢ { fᵢ_{x₀,Q}(P) }
+
+	// i = 62, separately to avoid an E12 Square
+	// (Square(res) = 1² = 1)
+
+	// k = 0, separately to avoid MulBy014 (res × ℓ)
+	l1 := lineEval(lines[0][0][62], 0)
+	l2 := lineEval(lines[0][1][62], 0)
+	// res = ℓ × ℓ
+	prodLines := pr.Mul014By014(&l2.R1, &l2.R0, &l1.R1, &l1.R0)
+	res = &fields_bls12381.E12{
+		C0: fields_bls12381.E6{
+			B0: *prodLines[0],
+			B1: *prodLines[1],
+			B2: *prodLines[2],
+		},
+		C1: fields_bls12381.E6{
+			B0: res.C1.B0,
+			B1: *prodLines[3],
+			B2: *prodLines[4],
+		},
+	}
+
+	for k := 1; k < n; k++ {
+		l1 = lineEval(lines[k][0][62], k)
+		l2 = lineEval(lines[k][1][62], k)
+		// ℓ × ℓ
+		prodLines = pr.Mul014By014(&l1.R1, &l1.R0, &l2.R1, &l2.R0)
+		// (ℓ × ℓ) × res
+		res = pr.MulBy01245(res, prodLines)
+	}
+
+	for i := 61; i >= 0; i-- {
+		// mutualize the square among n Miller loops
+		// (∏ᵢfᵢ)²
+		res = pr.Square(res)
+
+		for k := 0; k < n; k++ {
+			l1 = lineEval(lines[k][0][i], k)
+			if loopCounter[i] == 0 {
+				// ℓ × res
+				res = pr.MulBy014(res, &l1.R1, &l1.R0)
+			} else {
+				l2 = lineEval(lines[k][1][i], k)
+				// ℓ × ℓ
+				prodLines = pr.Mul014By014(&l1.R1, &l1.R0, &l2.R1, &l2.R0)
+				// (ℓ × ℓ) × res
+				res = pr.MulBy01245(res, prodLines)
+			}
+		}
+	}
+
+	// negative x₀
+	res = pr.Ext12.Conjugate(res)
+
+	return res, nil
+}
+
 // doubleAndAddStep doubles p1 and adds p2 to the result in affine coordinates, and evaluates the line in Miller loop
 // https://eprint.iacr.org/2022/1162 (Section 6.1)
 func (pr Pairing) doubleAndAddStep(p1, p2 *G2Affine) (*G2Affine, *lineEvaluation, *lineEvaluation) {
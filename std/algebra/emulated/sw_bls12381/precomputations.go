@@ -3,10 +3,34 @@ package sw_bls12381
 import (
 	"sync"
 
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	"github.com/consensys/gnark/std/algebra/emulated/fields_bls12381"
 	"github.com/consensys/gnark/std/math/emulated"
 )
 
+// lineEvaluations are the lines of the Miller loop for a fixed G2 point Q. The
+// first row holds the tangent lines and the second row the lines through Q
+// when the loop counter is non-zero.
+type lineEvaluations [2][len(bls12381.LoopCounter) - 1]*lineEvaluation
+
+// precomputeLines computes natively the lines of the Miller loop for Q and
+// returns them as constants which can be used in-circuit.
+func precomputeLines(Q bls12381.G2Affine) lineEvaluations {
+	var cLines lineEvaluations
+	nLines := bls12381.PrecomputeLines(Q)
+	for j := range cLines[0] {
+		cLines[0][j] = &lineEvaluation{
+			R0: fields_bls12381.FromE2(&nLines[0][j].R0),
+			R1: fields_bls12381.FromE2(&nLines[0][j].R1),
+		}
+		cLines[1][j] = &lineEvaluation{
+			R0: fields_bls12381.FromE2(&nLines[1][j].R0),
+			R1: fields_bls12381.FromE2(&nLines[1][j].R1),
+		}
+	}
+	return cLines
+}
+
 // precomputed lines going through Q and multiples of Q
 // where Q is the fixed canonical generator of G2
 //
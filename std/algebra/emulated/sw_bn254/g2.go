@@ -1,22 +1,32 @@
 package sw_bn254
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/consensys/gnark-crypto/ecc/bn254"
 	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/algopts"
 	"github.com/consensys/gnark/std/algebra/emulated/fields_bn254"
 	"github.com/consensys/gnark/std/math/emulated"
 )
 
+// G2 implements the group operations on the twist E'(Fp²) of BN254. It
+// implements the [algebra.G2Curve] interface.
 type G2 struct {
+	api frontend.API
+	fp  *emulated.Field[BaseField]
+	fr  *emulated.Field[ScalarField]
 	*fields_bn254.Ext2
-	w    *emulated.Element[BaseField]
-	u, v *fields_bn254.E2
+	w      *emulated.Element[BaseField]
+	u, v   *fields_bn254.E2
+	bTwist *fields_bn254.E2
 }
 
+// G2Affine represents G2 element with optional embedded line precomputations.
 type G2Affine struct {
-	X, Y fields_bn254.E2
+	X, Y  fields_bn254.E2
+	Lines *lineEvaluations
 }
 
 func NewG2(api frontend.API) *G2 {
@@ -29,14 +39,32 @@ func NewG2(api frontend.API) *G2 {
 		A0: emulated.ValueOf[BaseField]("2821565182194536844548159561693502659359617185244120367078079554186484126554"),
 		A1: emulated.ValueOf[BaseField]("3505843767911556378687030309984248845540243509899259641013678093033130930403"),
 	}
+	bTwist := fields_bn254.E2{
+		A0: emulated.ValueOf[BaseField]("19485874751759354771024239261021720505790618469301721065564631296452457478373"),
+		A1: emulated.ValueOf[BaseField]("266929791119991161246907387137283842545076965332900288569378510910307636690"),
+	}
+	fp, err := emulated.NewField[BaseField](api)
+	if err != nil {
+		panic(fmt.Sprintf("new base field: %v", err))
+	}
+	fr, err := emulated.NewField[ScalarField](api)
+	if err != nil {
+		panic(fmt.Sprintf("new scalar field: %v", err))
+	}
 	return &G2{
-		Ext2: fields_bn254.NewExt2(api),
-		w:    &w,
-		u:    &u,
-		v:    &v,
+		api:    api,
+		fp:     fp,
+		fr:     fr,
+		Ext2:   fields_bn254.NewExt2(api),
+		w:      &w,
+		u:      &u,
+		v:      &v,
+		bTwist: &bTwist,
 	}
 }
 
+// NewG2Affine returns the witness of v without precomputations. In case of
+// pairing the lines will be computed in-circuit.
 func NewG2Affine(v bn254.G2Affine) G2Affine {
 	return G2Affine{
 		X: fields_bn254.E2{
@@ -50,6 +78,29 @@ func NewG2Affine(v bn254.G2Affine) G2Affine {
 	}
 }
 
+// NewG2AffineFixed returns witness of v with precomputations for efficient
+// pairing computation.
+func NewG2AffineFixed(v bn254.G2Affine) G2Affine {
+	lines := precomputeLines(v)
+	ret := NewG2Affine(v)
+	ret.Lines = &lines
+	return ret
+}
+
+// NewG2AffineFixedPlaceholder returns a placeholder for the circuit compilation
+// when witness will be given with line precomputations using
+// [NewG2AffineFixed].
+func NewG2AffineFixedPlaceholder() G2Affine {
+	var lines lineEvaluations
+	for i := 0; i < len(bn254.LoopCounter); i++ {
+		lines[0][i] = &lineEvaluation{}
+		lines[1][i] = &lineEvaluation{}
+	}
+	return G2Affine{
+		Lines: &lines,
+	}
+}
+
 func (g2 *G2) phi(q *G2Affine) *G2Affine {
 	x := g2.Ext2.MulByElement(&q.X, g2.w)
 
@@ -214,3 +265,327 @@ func (g2 *G2) AssertIsEqual(p, q *G2Affine) {
 	g2.Ext2.AssertIsEqual(&p.X, &q.X)
 	g2.Ext2.AssertIsEqual(&p.Y, &q.Y)
 }
+
+// triple triples p and return it. It follows [ELM03] (Section 3.1). It doesn't
+// modify p.
+//
+// ⚠️  p.Y must be nonzero.
+//
+// [ELM03]: https://arxiv.org/pdf/math/0208038.pdf
+func (g2 G2) triple(p *G2Affine) *G2Affine {
+
+	// compute λ1 = (3p.x²)/2p.y
+	xx := g2.Ext2.Square(&p.X)
+	xx = g2.Ext2.MulByConstElement(xx, big.NewInt(3))
+	y2 := g2.Ext2.Double(&p.Y)
+	λ1 := g2.Ext2.DivUnchecked(xx, y2)
+
+	// xr = λ1²-2p.x
+	x2 := g2.Ext2.Double(&p.X)
+	λ1λ1 := g2.Ext2.Square(λ1)
+	x2 = g2.Ext2.Sub(λ1λ1, x2)
+
+	// ommit y2 computation, and
+	// compute λ2 = 2p.y/(x2 − p.x) − λ1.
+	x1x2 := g2.Ext2.Sub(&p.X, x2)
+	λ2 := g2.Ext2.DivUnchecked(y2, x1x2)
+	λ2 = g2.Ext2.Sub(λ2, λ1)
+
+	// xr = λ²-p.x-x2
+	λ2λ2 := g2.Ext2.Square(λ2)
+	qxrx := g2.Ext2.Add(x2, &p.X)
+	xr := g2.Ext2.Sub(λ2λ2, qxrx)
+
+	// yr = λ(p.x-xr) - p.y
+	pxrx := g2.Ext2.Sub(&p.X, xr)
+	λ2pxrx := g2.Ext2.Mul(λ2, pxrx)
+	yr := g2.Ext2.Sub(λ2pxrx, &p.Y)
+
+	return &G2Affine{
+		X: *xr,
+		Y: *yr,
+	}
+}
+
+// doubleAndAddSelect is the same as doubleAndAdd but computes either:
+//
+//	2p+q is b=1 or
+//	2q+p is b=0
+//
+// It first computes the x-coordinate of p+q via the slope(p,q)
+// and then based on a Select adds either p or q.
+func (g2 G2) doubleAndAddSelect(b frontend.Variable, p, q *G2Affine) *G2Affine {
+
+	// compute λ1 = (q.y-p.y)/(q.x-p.x)
+	yqyp := g2.Ext2.Sub(&q.Y, &p.Y)
+	xqxp := g2.Ext2.Sub(&q.X, &p.X)
+	λ1 := g2.Ext2.DivUnchecked(yqyp, xqxp)
+
+	// compute x2 = λ1²-p.x-q.x
+	λ1λ1 := g2.Ext2.Square(λ1)
+	xqxp = g2.Ext2.Add(&p.X, &q.X)
+	x2 := g2.Ext2.Sub(λ1λ1, xqxp)
+
+	// ommit y2 computation
+
+	// conditional second addition
+	t := g2.selectPoint(b, p, q)
+
+	// compute λ2 = -λ1-2*t.y/(x2-t.x)
+	ypyp := g2.Ext2.Add(&t.Y, &t.Y)
+	x2xp := g2.Ext2.Sub(x2, &t.X)
+	λ2 := g2.Ext2.DivUnchecked(ypyp, x2xp)
+	λ2 = g2.Ext2.Add(λ1, λ2)
+	λ2 = g2.Ext2.Neg(λ2)
+
+	// compute x3 =λ2²-t.x-x3
+	λ2λ2 := g2.Ext2.Square(λ2)
+	x3 := g2.Ext2.Sub(λ2λ2, &t.X)
+	x3 = g2.Ext2.Sub(x3, x2)
+
+	// compute y3 = λ2*(t.x - x3)-t.y
+	y3 := g2.Ext2.Sub(&t.X, x3)
+	y3 = g2.Ext2.Mul(λ2, y3)
+	y3 = g2.Ext2.Sub(y3, &t.Y)
+
+	return g2.reduce(&G2Affine{
+		X: *x3,
+		Y: *y3,
+	})
+}
+
+// reduce reduces the coordinates of p modulo the base field modulus. It is
+// used in the loops of the scalar multiplication where the unreduced
+// coordinates would otherwise accumulate overflow over the iterations.
+func (g2 G2) reduce(p *G2Affine) *G2Affine {
+	return &G2Affine{
+		X: fields_bn254.E2{A0: *g2.fp.Reduce(&p.X.A0), A1: *g2.fp.Reduce(&p.X.A1)},
+		Y: fields_bn254.E2{A0: *g2.fp.Reduce(&p.Y.A0), A1: *g2.fp.Reduce(&p.Y.A1)},
+	}
+}
+
+// selectPoint selects between p and q given the selector b. If b == 1, then
+// returns p and q otherwise.
+func (g2 G2) selectPoint(b frontend.Variable, p, q *G2Affine) *G2Affine {
+	return &G2Affine{
+		X: *g2.Ext2.Select(b, &p.X, &q.X),
+		Y: *g2.Ext2.Select(b, &p.Y, &q.Y),
+	}
+}
+
+// Neg returns an inverse of p. It doesn't modify p.
+func (g2 *G2) Neg(p *G2Affine) *G2Affine {
+	return g2.neg(p)
+}
+
+// AddUnified adds p and q and returns it. It doesn't modify p nor q.
+//
+// ✅ p can be equal to q, and either or both can be (0,0).
+// (0,0) is not on the twist but we conventionally take it as the
+// neutral/infinity point.
+//
+// It uses the unified formulas of Brier and Joye ([[BriJoy02]] (Corollary 1)).
+//
+// [BriJoy02]: https://link.springer.com/content/pdf/10.1007/3-540-45664-3_24.pdf
+func (g2 *G2) AddUnified(p, q *G2Affine) *G2Affine {
+
+	// selector1 = 1 when p is (0,0) and 0 otherwise
+	selector1 := g2.api.And(g2.Ext2.IsZero(&p.X), g2.Ext2.IsZero(&p.Y))
+	// selector2 = 1 when q is (0,0) and 0 otherwise
+	selector2 := g2.api.And(g2.Ext2.IsZero(&q.X), g2.Ext2.IsZero(&q.Y))
+
+	// λ = ((p.x+q.x)² - p.x*q.x)/(p.y + q.y)
+	pxqx := g2.Ext2.Mul(&p.X, &q.X)
+	pxplusqx := g2.Ext2.Add(&p.X, &q.X)
+	num := g2.Ext2.Square(pxplusqx)
+	num = g2.Ext2.Sub(num, pxqx)
+	denum := g2.Ext2.Add(&p.Y, &q.Y)
+	// if p.y + q.y = 0, assign dummy 1 to denum and continue
+	selector3 := g2.Ext2.IsZero(denum)
+	denum = g2.Ext2.Select(selector3, g2.Ext2.One(), denum)
+	λ := g2.Ext2.DivUnchecked(num, denum)
+
+	// x = λ^2 - p.x - q.x
+	xr := g2.Ext2.Square(λ)
+	xr = g2.Ext2.Sub(xr, pxplusqx)
+
+	// y = λ(p.x - xr) - p.y
+	yr := g2.Ext2.Sub(&p.X, xr)
+	yr = g2.Ext2.Mul(yr, λ)
+	yr = g2.Ext2.Sub(yr, &p.Y)
+	result := g2.reduce(&G2Affine{
+		X: *xr,
+		Y: *yr,
+	})
+
+	zero := g2.Ext2.Zero()
+	infinity := &G2Affine{X: *zero, Y: *zero}
+	// if p=(0,0) return q
+	result = g2.selectPoint(selector1, q, result)
+	// if q=(0,0) return p
+	result = g2.selectPoint(selector2, p, result)
+	// if p.y + q.y = 0, return (0, 0)
+	result = g2.selectPoint(selector3, infinity, result)
+
+	return result
+}
+
+// Add calls [G2.AddUnified]. It is defined for implementing the generic G2
+// curve interface.
+func (g2 *G2) Add(p, q *G2Affine) *G2Affine {
+	return g2.AddUnified(p, q)
+}
+
+// AssertIsOnTwist asserts that Q is on the twist E'(Fp²) or is the point
+// (0,0).
+func (g2 *G2) AssertIsOnTwist(Q *G2Affine) {
+	// Twist: Y² == X³ + aX + b, where a=0 and b=3/(9+u)
+	// (X,Y) ∈ {Y² == X³ + aX + b} U (0,0)
+
+	// if Q=(0,0) we assign b=0 otherwise 3/(9+u), and continue
+	selector := g2.api.And(g2.Ext2.IsZero(&Q.X), g2.Ext2.IsZero(&Q.Y))
+	b := g2.Ext2.Select(selector, g2.Ext2.Zero(), g2.bTwist)
+
+	left := g2.Ext2.Square(&Q.Y)
+	right := g2.Ext2.Square(&Q.X)
+	right = g2.Ext2.Mul(right, &Q.X)
+	right = g2.Ext2.Add(right, b)
+	g2.Ext2.AssertIsEqual(left, right)
+}
+
+// AssertIsOnG2 asserts that Q is on the twist and in the prime-order subgroup
+// G2.
+func (g2 *G2) AssertIsOnG2(Q *G2Affine) {
+	// 1- Check Q is on the curve
+	g2.AssertIsOnTwist(Q)
+
+	// 2- Check Q has the right subgroup order
+
+	// [x₀]Q
+	xQ := g2.scalarMulBySeed(Q)
+	// ψ([x₀]Q)
+	psixQ := g2.psi(xQ)
+	// ψ²([x₀]Q) = -ϕ([x₀]Q)
+	psi2xQ := g2.phi(xQ)
+	// ψ³([2x₀]Q)
+	psi3xxQ := g2.double(psi2xQ)
+	psi3xxQ = g2.psi(psi3xxQ)
+
+	// _Q = ψ³([2x₀]Q) - ψ²([x₀]Q) - ψ([x₀]Q) - [x₀]Q
+	_Q := g2.sub(psi2xQ, psi3xxQ)
+	_Q = g2.sub(_Q, psixQ)
+	_Q = g2.sub(_Q, xQ)
+
+	// [r]Q == 0 <==>  _Q == Q
+	g2.AssertIsEqual(Q, _Q)
+}
+
+// ScalarMul computes s * p and returns it. It doesn't modify p nor s.
+// This function doesn't check that the p is on the twist. See AssertIsOnTwist.
+//
+// ✅ p can can be (0,0) and s can be 0.
+// (0,0) is not on the twist but we conventionally take it as the
+// neutral/infinity point.
+//
+// It computes the right-to-left variable-base double-and-add algorithm
+// ([Joye07], Alg.1) in the same way as [sw_emulated.Curve.ScalarMul].
+//
+// The option [algopts.WithCompleteArithmetic] is not supported and the method
+// panics if it is given.
+//
+// [Joye07]: https://www.iacr.org/archive/ches2007/47270135/47270135.pdf
+func (g2 *G2) ScalarMul(p *G2Affine, s *Scalar, opts ...algopts.AlgebraOption) *G2Affine {
+	cfg, err := algopts.NewConfig(opts...)
+	if err != nil {
+		panic(fmt.Sprintf("parse opts: %v", err))
+	}
+	if cfg.CompleteArithmetic {
+		panic("complete arithmetic is not supported for G2")
+	}
+
+	// if p=(0,0) we assign a dummy (1,1) to p and continue
+	selector := g2.api.And(g2.Ext2.IsZero(&p.X), g2.Ext2.IsZero(&p.Y))
+	one := g2.Ext2.One()
+	p = g2.selectPoint(selector, &G2Affine{X: *one, Y: *one}, p)
+
+	var st ScalarField
+	sr := g2.fr.Reduce(s)
+	sBits := g2.fr.ToBits(sr)
+	n := st.Modulus().BitLen()
+	if cfg.NbScalarBits > 2 && cfg.NbScalarBits < n {
+		n = cfg.NbScalarBits
+	}
+
+	// i = 1
+	Rb := g2.triple(p)
+	R0 := g2.selectPoint(sBits[1], Rb, p)
+	R1 := g2.selectPoint(sBits[1], p, Rb)
+
+	for i := 2; i < n-1; i++ {
+		Rb = g2.doubleAndAddSelect(sBits[i], R0, R1)
+		R0 = g2.selectPoint(sBits[i], Rb, R0)
+		R1 = g2.selectPoint(sBits[i], R1, Rb)
+	}
+
+	// i = n-1
+	Rb = g2.doubleAndAddSelect(sBits[n-1], R0, R1)
+	R0 = g2.selectPoint(sBits[n-1], Rb, R0)
+
+	// i = 0
+	// we use AddUnified here instead of add so that when s=0, res=(0,0)
+	// because AddUnified(p, -p) = (0,0)
+	R0 = g2.selectPoint(sBits[0], R0, g2.AddUnified(R0, g2.neg(p)))
+
+	// if p=(0,0), return (0,0)
+	zero := g2.Ext2.Zero()
+	R0 = g2.selectPoint(selector, &G2Affine{X: *zero, Y: *zero}, R0)
+
+	return R0
+}
+
+// MultiScalarMul computes the multi scalar multiplication of the points P and
+// scalars s. It returns an error if the length of the slices mismatch. If the
+// input slices are empty, then returns point at infinity.
+//
+// For the points and scalars the same edge cases apply as for [G2.ScalarMul].
+// It returns an error if the option [algopts.WithCompleteArithmetic] is given.
+func (g2 *G2) MultiScalarMul(p []*G2Affine, s []*Scalar, opts ...algopts.AlgebraOption) (*G2Affine, error) {
+	if len(p) == 0 {
+		return &G2Affine{
+			X: *g2.Ext2.Zero(),
+			Y: *g2.Ext2.Zero(),
+		}, nil
+	}
+	cfg, err := algopts.NewConfig(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("new config: %w", err)
+	}
+	if cfg.CompleteArithmetic {
+		return nil, fmt.Errorf("complete arithmetic is not supported for G2")
+	}
+	if !cfg.FoldMulti {
+		// the scalars are unique
+		if len(p) != len(s) {
+			return nil, fmt.Errorf("mismatching points and scalars slice lengths")
+		}
+		res := g2.ScalarMul(p[0], s[0], opts...)
+		for i := 1; i < len(p); i++ {
+			q := g2.ScalarMul(p[i], s[i], opts...)
+			res = g2.AddUnified(res, q)
+		}
+		return res, nil
+	} else {
+		// scalars are powers
+		if len(s) == 0 {
+			return nil, fmt.Errorf("need scalar for folding")
+		}
+		gamma := s[0]
+		res := g2.ScalarMul(p[len(p)-1], gamma, opts...)
+		for i := len(p) - 2; i > 0; i-- {
+			res = g2.AddUnified(p[i], res)
+			res = g2.ScalarMul(res, gamma, opts...)
+		}
+		res = g2.AddUnified(p[0], res)
+		return res, nil
+	}
+}
@@ -0,0 +1,144 @@
+package sw_bn254
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/fields_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"golang.org/x/exp/slices"
+)
+
+// UnmarshalG1Compressed unmarshals the point from its compressed encoding in
+// bits, most significant bit first. The encoding is the one of
+// [bn254.G1Affine.Bytes] in gnark-crypto: the big-endian encoding of X on 32
+// bytes. As the base field modulus has 254 bits, the two most significant bits
+// are free and store the metadata
+//
+//	10 -> use the lexicographically smallest square root of Y²
+//	11 -> use the lexicographically largest square root of Y²
+//	01 -> the point at infinity, all the other bits are zero
+//	00 -> the uncompressed encoding, not accepted
+//
+// Contrary to the zcash encoding of BLS12-381, there is no separate
+// compression flag and the sort bit is not defined for the point at infinity.
+// It returns an error if the number of bits does not match the encoding
+// length.
+//
+// The method asserts that the metadata is valid, that X is canonical (less
+// than the modulus) and that X is the X-coordinate of a point on the curve.
+// As G1 has cofactor 1, the point is then in G1. The Y-coordinate is recovered
+// using a square root hint. The point at infinity is returned as (0,0).
+func (pr Pairing) UnmarshalG1Compressed(bits []frontend.Variable) (*G1Affine, error) {
+	if len(bits) != 8*bn254.SizeOfG1AffineCompressed {
+		return nil, fmt.Errorf("invalid number of bits: %d, expected %d", len(bits), 8*bn254.SizeOfG1AffineCompressed)
+	}
+	isInfinity, largest := pr.unmarshalMetadata(bits)
+	x := pr.unmarshalFp(bits[2:])
+
+	// if the point is at infinity, we continue with the generator as a dummy
+	// point and return (0,0) at the end.
+	_, _, g1, _ := bn254.Generators()
+	gx := emulated.ValueOf[BaseField](g1.X)
+	x = pr.curveF.Select(isInfinity, &gx, x)
+
+	// Y² = X³ + 3
+	three := emulated.ValueOf[BaseField](3)
+	y2 := pr.curveF.Mul(x, pr.curveF.Mul(x, x))
+	y2 = pr.curveF.Add(y2, &three)
+	y := pr.curveF.Sqrt(y2)
+	neg := pr.api.Xor(pr.isLargest(y), largest)
+	y = pr.curveF.Select(neg, pr.curveF.Neg(y), y)
+
+	zero := pr.curveF.Zero()
+	return &G1Affine{
+		X: *pr.curveF.Select(isInfinity, zero, x),
+		Y: *pr.curveF.Select(isInfinity, zero, y),
+	}, nil
+}
+
+// UnmarshalG2Compressed unmarshals the point from its compressed encoding in
+// bits, most significant bit first. The encoding is the one of
+// [bn254.G2Affine.Bytes] in gnark-crypto: the big-endian encodings of X.A1 and
+// X.A0 on 32 bytes each, where the two most significant bits of X.A1 store the
+// metadata as in [Pairing.UnmarshalG1Compressed]. The lexicographic order of Y
+// is given by Y.A1, or by Y.A0 when Y.A1 is zero. It returns an error if the
+// number of bits does not match the encoding length.
+//
+// The method asserts that the metadata is valid, that the coordinates of X are
+// canonical and that the point is in G2. The point at infinity is returned as
+// (0,0).
+func (pr Pairing) UnmarshalG2Compressed(bits []frontend.Variable) (*G2Affine, error) {
+	if len(bits) != 8*bn254.SizeOfG2AffineCompressed {
+		return nil, fmt.Errorf("invalid number of bits: %d, expected %d", len(bits), 8*bn254.SizeOfG2AffineCompressed)
+	}
+	isInfinity, largest := pr.unmarshalMetadata(bits)
+	nbBits := 8 * bn254.SizeOfG1AffineCompressed
+	x := &fields_bn254.E2{
+		A0: *pr.unmarshalFp(bits[nbBits:]),
+		A1: *pr.unmarshalFp(bits[2:nbBits]),
+	}
+
+	// if the point is at infinity, we continue with the generator as a dummy
+	// point and return (0,0) at the end.
+	_, _, _, g2 := bn254.Generators()
+	gx := fields_bn254.FromE2(&g2.X)
+	x = pr.g2.Ext2.Select(isInfinity, &gx, x)
+
+	// Y² = X³ + b'
+	y2 := pr.g2.Ext2.Mul(x, pr.g2.Ext2.Square(x))
+	y2 = pr.g2.Ext2.Add(y2, pr.g2.bTwist)
+	y := pr.g2.Ext2.Sqrt(y2)
+	// the sign is defined by the lexicographic order of A1, or of A0 when A1
+	// is zero.
+	yLargest := pr.api.Select(pr.curveF.IsZero(&y.A1), pr.isLargest(&y.A0), pr.isLargest(&y.A1))
+	neg := pr.api.Xor(yLargest, largest)
+	y = pr.g2.Ext2.Select(neg, pr.g2.Ext2.Neg(y), y)
+	pr.AssertIsOnG2(&G2Affine{X: *x, Y: *y})
+
+	zero := pr.g2.Ext2.Zero()
+	return &G2Affine{
+		X: *pr.g2.Ext2.Select(isInfinity, zero, x),
+		Y: *pr.g2.Ext2.Select(isInfinity, zero, y),
+	}, nil
+}
+
+// unmarshalMetadata asserts that the two most significant bits of the
+// compressed encoding are valid and returns if the encoded point is the point
+// at infinity and if Y is the lexicographically largest square root. For the
+// point at infinity it also asserts that all the other bits are zero.
+func (pr Pairing) unmarshalMetadata(bits []frontend.Variable) (isInfinity, largest frontend.Variable) {
+	pr.api.AssertIsBoolean(bits[0])
+	pr.api.AssertIsBoolean(bits[1])
+	// 00 is the uncompressed encoding
+	pr.api.AssertIsEqual(pr.api.Or(bits[0], bits[1]), 1)
+	isInfinity = pr.api.Sub(1, bits[0])
+	var sum frontend.Variable = 0
+	for i := 2; i < len(bits); i++ {
+		sum = pr.api.Add(sum, bits[i])
+	}
+	pr.api.AssertIsEqual(pr.api.Mul(isInfinity, sum), 0)
+	return isInfinity, bits[1]
+}
+
+// unmarshalFp returns the element given by its big-endian encoding in bits
+// and asserts that it is canonical.
+func (pr Pairing) unmarshalFp(bits []frontend.Variable) *emulated.Element[BaseField] {
+	bx := make([]frontend.Variable, len(bits))
+	copy(bx, bits)
+	slices.Reverse(bx)
+	x := pr.curveF.FromBits(bx...)
+	pr.curveF.AssertIsInRange(x)
+	return x
+}
+
+// isLargest returns 1 if y is lexicographically larger than -y, i.e. if the
+// canonical representation of y is larger than (p-1)/2, and 0 otherwise.
+func (pr Pairing) isLargest(y *emulated.Element[BaseField]) frontend.Variable {
+	var fp BaseField
+	half := new(big.Int).Rsh(fp.Modulus(), 1)
+	h := emulated.ValueOf[BaseField](half)
+	return pr.curveF.IsLess(&h, y)
+}
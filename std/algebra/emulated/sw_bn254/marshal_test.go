@@ -0,0 +1,245 @@
+package sw_bn254
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+// the metadata in the two most significant bits of the gnark-crypto
+// compressed encoding
+const (
+	mUncompressed       byte = 0b00
+	mCompressedInfinity byte = 0b01
+	mCompressedSmallest byte = 0b10
+	mCompressedLargest  byte = 0b11
+)
+
+// bytesToBits returns the bits of b, most significant bit first.
+func bytesToBits(b []byte) []frontend.Variable {
+	res := make([]frontend.Variable, 8*len(b))
+	for i := range b {
+		for j := 0; j < 8; j++ {
+			res[i*8+j] = (b[i] >> (7 - j)) & 1
+		}
+	}
+	return res
+}
+
+// metadata returns the two most significant bits of the encoding b.
+func metadata(b []byte) byte {
+	return b[0] >> 6
+}
+
+// setMetadata sets the two most significant bits of the encoding b to m.
+func setMetadata(b []byte, m byte) {
+	b[0] = b[0]&0b00111111 | m<<6
+}
+
+type unmarshalG1CompressedCircuit struct {
+	In  [8 * bn254.SizeOfG1AffineCompressed]frontend.Variable
+	Res G1Affine
+}
+
+func (c *unmarshalG1CompressedCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return err
+	}
+	res, err := pairing.UnmarshalG1Compressed(c.In[:])
+	if err != nil {
+		return err
+	}
+	pairing.curve.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+// unmarshalG1CompressedOnlyCircuit only decodes the input, so that the
+// invalid encodings are not rejected because of a mismatching result.
+type unmarshalG1CompressedOnlyCircuit struct {
+	In [8 * bn254.SizeOfG1AffineCompressed]frontend.Variable
+}
+
+func (c *unmarshalG1CompressedOnlyCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return err
+	}
+	_, err = pairing.UnmarshalG1Compressed(c.In[:])
+	return err
+}
+
+func TestUnmarshalG1CompressedTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p, _ := randomG1G2Affines()
+	var smallest, largest, inf bn254.G1Affine
+	smallest.Set(&p)
+	if smallest.Y.LexicographicallyLargest() {
+		smallest.Neg(&smallest)
+	}
+	largest.Neg(&smallest)
+	for _, tc := range []struct {
+		name string
+		p    bn254.G1Affine
+		m    byte
+	}{
+		{"smallest", smallest, mCompressedSmallest},
+		{"largest", largest, mCompressedLargest},
+		{"infinity", inf, mCompressedInfinity},
+	} {
+		b := tc.p.Bytes()
+		assert.Equal(tc.m, metadata(b[:]), tc.name)
+		var witness unmarshalG1CompressedCircuit
+		copy(witness.In[:], bytesToBits(b[:]))
+		witness.Res = NewG1Affine(tc.p)
+		err := test.IsSolved(&unmarshalG1CompressedCircuit{}, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, tc.name)
+	}
+}
+
+func TestUnmarshalG1CompressedInvalid(t *testing.T) {
+	assert := test.NewAssert(t)
+	p, _ := randomG1G2Affines()
+	// X such that X³ + 3 is not a square, i.e. not on the curve
+	var x, y2 fp.Element
+	three := fp.NewElement(3)
+	for {
+		x.SetRandom()
+		y2.Square(&x).Mul(&y2, &x).Add(&y2, &three)
+		if y2.Legendre() == -1 {
+			break
+		}
+	}
+	// the generator has X = 1, so X + p fits in the 254 bits
+	_, _, g, _ := bn254.Generators()
+	// the unmodified encoding is valid
+	b := p.Bytes()
+	var witness unmarshalG1CompressedOnlyCircuit
+	copy(witness.In[:], bytesToBits(b[:]))
+	err := test.IsSolved(&unmarshalG1CompressedOnlyCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	for _, tc := range []struct {
+		name   string
+		modify func(b []byte)
+	}{
+		{"uncompressed", func(b []byte) { setMetadata(b, mUncompressed) }},
+		{"infinity with non-zero X", func(b []byte) { setMetadata(b, mCompressedInfinity) }},
+		{"non-canonical", func(b []byte) {
+			gb := g.Bytes()
+			v := g.X.BigInt(new(big.Int))
+			v.Add(v, fp.Modulus()).FillBytes(b)
+			setMetadata(b, metadata(gb[:]))
+		}},
+		{"not on curve", func(b []byte) {
+			xb := x.Bytes()
+			copy(b, xb[:])
+			setMetadata(b, mCompressedSmallest)
+		}},
+	} {
+		b := p.Bytes()
+		tc.modify(b[:])
+		var witness unmarshalG1CompressedOnlyCircuit
+		copy(witness.In[:], bytesToBits(b[:]))
+		err := test.IsSolved(&unmarshalG1CompressedOnlyCircuit{}, &witness, ecc.BN254.ScalarField())
+		assert.Error(err, tc.name)
+	}
+}
+
+type unmarshalG2CompressedCircuit struct {
+	In  [8 * bn254.SizeOfG2AffineCompressed]frontend.Variable
+	Res G2Affine
+}
+
+func (c *unmarshalG2CompressedCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return err
+	}
+	res, err := pairing.UnmarshalG2Compressed(c.In[:])
+	if err != nil {
+		return err
+	}
+	pairing.g2.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+// unmarshalG2CompressedOnlyCircuit only decodes the input, so that the
+// invalid encodings are not rejected because of a mismatching result.
+type unmarshalG2CompressedOnlyCircuit struct {
+	In [8 * bn254.SizeOfG2AffineCompressed]frontend.Variable
+}
+
+func (c *unmarshalG2CompressedOnlyCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return err
+	}
+	_, err = pairing.UnmarshalG2Compressed(c.In[:])
+	return err
+}
+
+func TestUnmarshalG2CompressedTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, q := randomG1G2Affines()
+	var smallest, largest, inf bn254.G2Affine
+	smallest.Set(&q)
+	if smallest.Y.LexicographicallyLargest() {
+		smallest.Neg(&smallest)
+	}
+	largest.Neg(&smallest)
+	for _, tc := range []struct {
+		name string
+		q    bn254.G2Affine
+		m    byte
+	}{
+		{"smallest", smallest, mCompressedSmallest},
+		{"largest", largest, mCompressedLargest},
+		{"infinity", inf, mCompressedInfinity},
+	} {
+		b := tc.q.Bytes()
+		assert.Equal(tc.m, metadata(b[:]), tc.name)
+		var witness unmarshalG2CompressedCircuit
+		copy(witness.In[:], bytesToBits(b[:]))
+		witness.Res = NewG2Affine(tc.q)
+		err := test.IsSolved(&unmarshalG2CompressedCircuit{}, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, tc.name)
+	}
+}
+
+func TestUnmarshalG2CompressedInvalid(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, q := randomG1G2Affines()
+	// the unmodified encoding is valid
+	b := q.Bytes()
+	var witness unmarshalG2CompressedOnlyCircuit
+	copy(witness.In[:], bytesToBits(b[:]))
+	err := test.IsSolved(&unmarshalG2CompressedOnlyCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	for _, tc := range []struct {
+		name   string
+		modify func(b []byte)
+	}{
+		// the metadata is stored in X.A1
+		{"uncompressed", func(b []byte) { setMetadata(b, mUncompressed) }},
+		{"infinity with non-zero X", func(b []byte) { setMetadata(b, mCompressedInfinity) }},
+		// X.A0 + p fits in the 32 bytes of X.A0
+		{"non-canonical", func(b []byte) {
+			v := q.X.A0.BigInt(new(big.Int))
+			v.Add(v, fp.Modulus()).FillBytes(b[bn254.SizeOfG1AffineCompressed:])
+		}},
+	} {
+		b := q.Bytes()
+		tc.modify(b[:])
+		var witness unmarshalG2CompressedOnlyCircuit
+		copy(witness.In[:], bytesToBits(b[:]))
+		err := test.IsSolved(&unmarshalG2CompressedOnlyCircuit{}, &witness, ecc.BN254.ScalarField())
+		assert.Error(err, tc.name)
+	}
+}
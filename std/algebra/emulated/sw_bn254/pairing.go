@@ -18,7 +18,6 @@ type Pairing struct {
 	curveF *emulated.Field[BaseField]
 	curve  *sw_emulated.Curve[BaseField, ScalarField]
 	g2     *G2
-	bTwist *fields_bn254.E2
 	lines  [4][67]fields_bn254.E2
 }
 
@@ -66,17 +65,12 @@ func NewPairing(api frontend.API) (*Pairing, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new curve: %w", err)
 	}
-	bTwist := fields_bn254.E2{
-		A0: emulated.ValueOf[BaseField]("19485874751759354771024239261021720505790618469301721065564631296452457478373"),
-		A1: emulated.ValueOf[BaseField]("266929791119991161246907387137283842545076965332900288569378510910307636690"),
-	}
 	return &Pairing{
 		api:    api,
 		Ext12:  fields_bn254.NewExt12(api),
 		curveF: ba,
 		curve:  curve,
 		g2:     NewG2(api),
-		bTwist: &bTwist,
 		lines:  getPrecomputeLines(),
 	}, nil
 }
@@ -248,27 +242,50 @@ func (pr Pairing) PairingCheck(P []*G1Affine, Q []*G2Affine) error {
 	return nil
 }
 
+// PairingCheckFixedQ calculates the reduced pairing for a set of points and
+// asserts if the result is One
+// ∏ᵢ e(Pᵢ, Qᵢ) =? 1
+// where Qᵢ are constant points in G2. The lines of the Miller loop are
+// precomputed natively at circuit compile time and only evaluated at Pᵢ
+// in-circuit.
+//
+// This function doesn't check that the inputs are in the correct subgroups. See AssertIsOnG1.
+func (pr Pairing) PairingCheckFixedQ(P []*G1Affine, Q []bn254.G2Affine) error {
+	lines := make([]lineEvaluations, len(Q))
+	for k := range Q {
+		lines[k] = precomputeLines(Q[k])
+	}
+	res, err := pr.millerLoopLines(P, lines)
+	if err != nil {
+		return fmt.Errorf("miller loop: %w", err)
+	}
+	res = pr.finalExponentiation(res, len(P) == 1)
+	pr.AssertIsEqual(res, pr.One())
+	return nil
+}
+
 func (pr Pairing) AssertIsEqual(x, y *GTEl) {
 	pr.Ext12.AssertIsEqual(x, y)
 }
 
+// MulGT computes the product of the target group elements and returns it. It
+// doesn't modify the inputs.
+func (pr Pairing) MulGT(x, y *GTEl) *GTEl {
+	return pr.Ext12.Mul(x, y)
+}
+
+// ExpGT computes x^k for the target group element x and returns it. It doesn't
+// modify the inputs.
+func (pr Pairing) ExpGT(x *GTEl, k *Scalar) *GTEl {
+	return pr.Ext12.Exp(x, k)
+}
+
 func (pr Pairing) AssertIsOnCurve(P *G1Affine) {
 	pr.curve.AssertIsOnCurve(P)
 }
 
 func (pr Pairing) AssertIsOnTwist(Q *G2Affine) {
-	// Twist: Y² == X³ + aX + b, where a=0 and b=3/(9+u)
-	// (X,Y) ∈ {Y² == X³ + aX + b} U (0,0)
-
-	// if Q=(0,0) we assign b=0 otherwise 3/(9+u), and continue
-	selector := pr.api.And(pr.Ext2.IsZero(&Q.X), pr.Ext2.IsZero(&Q.Y))
-	b := pr.Ext2.Select(selector, pr.Ext2.Zero(), pr.bTwist)
-
-	left := pr.Ext2.Square(&Q.Y)
-	right := pr.Ext2.Square(&Q.X)
-	right = pr.Ext2.Mul(right, &Q.X)
-	right = pr.Ext2.Add(right, b)
-	pr.Ext2.AssertIsEqual(left, right)
+	pr.g2.AssertIsOnTwist(Q)
 }
 
 func (pr Pairing) AssertIsOnG1(P *G1Affine) {
@@ -278,28 +295,7 @@ func (pr Pairing) AssertIsOnG1(P *G1Affine) {
 }
 
 func (pr Pairing) AssertIsOnG2(Q *G2Affine) {
-	// 1- Check Q is on the curve
-	pr.AssertIsOnTwist(Q)
-
-	// 2- Check Q has the right subgroup order
-
-	// [x₀]Q
-	xQ := pr.g2.scalarMulBySeed(Q)
-	// ψ([x₀]Q)
-	psixQ := pr.g2.psi(xQ)
-	// ψ²([x₀]Q) = -ϕ([x₀]Q)
-	psi2xQ := pr.g2.phi(xQ)
-	// ψ³([2x₀]Q)
-	psi3xxQ := pr.g2.double(psi2xQ)
-	psi3xxQ = pr.g2.psi(psi3xxQ)
-
-	// _Q = ψ³([2x₀]Q) - ψ²([x₀]Q) - ψ([x₀]Q) - [x₀]Q
-	_Q := pr.g2.sub(psi2xQ, psi3xxQ)
-	_Q = pr.g2.sub(_Q, psixQ)
-	_Q = pr.g2.sub(_Q, xQ)
-
-	// [r]Q == 0 <==>  _Q == Q
-	pr.g2.AssertIsEqual(Q, _Q)
+	pr.g2.AssertIsOnG2(Q)
 }
 
 // loopCounter = 6x₀+2 = 29793968203157093288
@@ -324,6 +320,9 @@ type lineEvaluation struct {
 
 // MillerLoop computes the multi-Miller loop
 // ∏ᵢ { fᵢ_{6x₀+2,Q}(P) · ℓᵢ_{[6x₀+2]Q,π(Q)}(P) · ℓᵢ_{[6x₀+2]Q+π(Q),-π²(Q)}(P) }
+//
+// If some Qᵢ have embedded line precomputations (see [NewG2AffineFixed]), then
+// the lines are only evaluated at Pᵢ for these points.
 func (pr Pairing) MillerLoop(P []*G1Affine, Q []*G2Affine) (*GTEl, error) {
 	// check input size match
 	n := len(P)
@@ -331,6 +330,44 @@ func (pr Pairing) MillerLoop(P []*G1Affine, Q []*G2Affine) (*GTEl, error) {
 		return nil, errors.New("invalid inputs sizes")
 	}
 
+	// split the inputs depending on whether the lines are precomputed
+	var PVar, PFixed []*G1Affine
+	var QVar []*G2Affine
+	var lines []lineEvaluations
+	for k := 0; k < n; k++ {
+		if Q[k].Lines != nil {
+			PFixed = append(PFixed, P[k])
+			lines = append(lines, *Q[k].Lines)
+		} else {
+			PVar = append(PVar, P[k])
+			QVar = append(QVar, Q[k])
+		}
+	}
+	if len(lines) == 0 {
+		return pr.millerLoop(PVar, QVar)
+	}
+	if len(QVar) == 0 {
+		return pr.millerLoopLines(PFixed, lines)
+	}
+	resVar, err := pr.millerLoop(PVar, QVar)
+	if err != nil {
+		return nil, err
+	}
+	resFixed, err := pr.millerLoopLines(PFixed, lines)
+	if err != nil {
+		return nil, err
+	}
+	return pr.Mul(resVar, resFixed), nil
+}
+
+// millerLoop computes the multi-Miller loop with the lines computed in-circuit.
+func (pr Pairing) millerLoop(P []*G1Affine, Q []*G2Affine) (*GTEl, error) {
+	// check input size match
+	n := len(P)
+	if n == 0 || n != len(Q) {
+		return nil, errors.New("invalid inputs sizes")
+	}
+
 	res := pr.Ext12.One()
 	var prodLines [5]*fields_bn254.E2
 
@@ -609,6 +646,120 @@ func (pr Pairing) MillerLoop(P []*G1Affine, Q []*G2Affine) (*GTEl, error) {
 	return res, nil
 }
 
+// millerLoopLines computes the multi-Miller loop as in MillerLoop but using the
+// precomputed lines of the fixed points Qᵢ. The lines are only evaluated at Pᵢ.
+func (pr Pairing) millerLoopLines(P []*G1Affine, lines []lineEvaluations) (*GTEl, error) {
+	// check input size match
+	n := len(P)
+	if n == 0 || n != len(lines) {
+		return nil, errors.New("invalid inputs sizes")
+	}
+
+	res := pr.Ext12.One()
+	var prodLines [5]*fields_bn254.E2
+
+	yInv := make([]*emulated.Element[BaseField], n)
+	xNegOverY := make([]*emulated.Element[BaseField], n)
+	for k := 0; k < n; k++ {
+		// 1/y is well defined for all points P's (see MillerLoop).
+		yInv[k] = pr.curveF.Inverse(&P[k].Y)
+		xNegOverY[k] = pr.curveF.MulMod(&P[k].X, yInv[k])
+		xNegOverY[k] = pr.curveF.Neg(xNegOverY[k])
+	}
+
+	// lineEval evaluates the line at P[k]
+	lineEval := func(l *lineEvaluation, k int) *lineEvaluation {
+		return &lineEvaluation{
+			R0: *pr.MulByElement(&l.R0, xNegOverY[k]),
+			R1: *pr.MulByElement(&l.R1, yInv[k]),
+		}
+	}
+
+	// Compute ∏ᵢ { fᵢ_{6x₀+2,Q}(P) }
+	// i = 64, separately to avoid an E12 Square
+	// (Square(res) = 1² = 1)
+
+	// k = 0, separately to avoid MulBy034 (res × ℓ)
+	// (assign line to res)
+	l1 := lineEval(lines[0][0][64], 0)
+	res = &fields_bn254.E12{
+		C0: res.C0,
+		C1: fields_bn254.E6{
+			B0: l1.R0,
+			B1: l1.R1,
+			B2: res.C1.B2,
+		},
+	}
+
+	if n >= 2 {
+		// k = 1, separately to avoid MulBy034 (res × ℓ)
+		// (res is also a line at this point, so we use Mul034By034 ℓ × ℓ)
+		l1 = lineEval(lines[1][0][64], 1)
+		prodLines = pr.Mul034By034(&l1.R0, &l1.R1, &res.C1.B0, &res.C1.B1)
+		res = &fields_bn254.E12{
+			C0: fields_bn254.E6{
+				B0: *prodLines[0],
+				B1: *prodLines[1],
+				B2: *prodLines[2],
+			},
+			C1: fields_bn254.E6{
+				B0: *prodLines[3],
+				B1: *prodLines[4],
+				B2: res.C1.B2,
+			},
+		}
+	}
+
+	if n >= 3 {
+		// k = 2, separately to avoid MulBy034 (res × ℓ)
+		// (res has a zero E2 element, so we use Mul01234By034)
+		l1 = lineEval(lines[2][0][64], 2)
+		res = pr.Mul01234By034(prodLines, &l1.R0, &l1.R1)
+
+		// k >= 3
+		for k := 3; k < n; k++ {
+			l1 = lineEval(lines[k][0][64], k)
+			res = pr.MulBy034(res, &l1.R0, &l1.R1)
+		}
+	}
+
+	for i := 63; i >= 0; i-- {
+		// mutualize the square among n Miller loops
+		// (∏ᵢfᵢ)²
+		if i == 63 && n == 1 {
+			res = pr.Square034(res)
+		} else {
+			res = pr.Square(res)
+		}
+
+		for k := 0; k < n; k++ {
+			l1 = lineEval(lines[k][0][i], k)
+			if loopCounter[i] == 0 {
+				// ℓ × res
+				res = pr.MulBy034(res, &l1.R0, &l1.R1)
+			} else {
+				l2 := lineEval(lines[k][1][i], k)
+				// ℓ × ℓ
+				prodLines = pr.Mul034By034(&l1.R0, &l1.R1, &l2.R0, &l2.R1)
+				// (ℓ × ℓ) × res
+				res = pr.MulBy01234(res, prodLines)
+			}
+		}
+	}
+
+	// Compute  ∏ᵢ { ℓᵢ_{[6x₀+2]Q,π(Q)}(P) · ℓᵢ_{[6x₀+2]Q+π(Q),-π²(Q)}(P) }
+	for k := 0; k < n; k++ {
+		l1 = lineEval(lines[k][1][65], k)
+		l2 := lineEval(lines[k][0][65], k)
+		// ℓ × ℓ
+		prodLines = pr.Mul034By034(&l1.R0, &l1.R1, &l2.R0, &l2.R1)
+		// (ℓ × ℓ) × res
+		res = pr.MulBy01234(res, prodLines)
+	}
+
+	return res, nil
+}
+
 // doubleAndAddStep doubles p1 and adds p2 to the result in affine coordinates, and evaluates the line in Miller loop
 // https://eprint.iacr.org/2022/1162 (Section 6.1)
 func (pr Pairing) doubleAndAddStep(p1, p2 *G2Affine) (*G2Affine, *lineEvaluation, *lineEvaluation) {
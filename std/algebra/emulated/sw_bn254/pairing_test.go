@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"crypto/rand"
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
 	"github.com/consensys/gnark/constraint"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/frontend/cs/r1cs"
@@ -63,6 +65,45 @@ func TestFinalExponentiationTestSolve(t *testing.T) {
 	assert.NoError(err)
 }
 
+type GTOpsCircuit struct {
+	A, B GTEl
+	K    Scalar
+	Res  GTEl
+}
+
+func (c *GTOpsCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	res := pairing.MulGT(&c.A, pairing.ExpGT(&c.B, &c.K))
+	pairing.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestGTOpsTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p1, q1 := randomG1G2Affines()
+	p2, q2 := randomG1G2Affines()
+	a, err := bn254.Pair([]bn254.G1Affine{p1}, []bn254.G2Affine{q1})
+	assert.NoError(err)
+	b, err := bn254.Pair([]bn254.G1Affine{p2}, []bn254.G2Affine{q2})
+	assert.NoError(err)
+	var k fr_bn254.Element
+	k.SetRandom()
+	var res bn254.GT
+	res.Exp(b, k.BigInt(new(big.Int)))
+	res.Mul(&a, &res)
+	witness := GTOpsCircuit{
+		A:   NewGTEl(a),
+		B:   NewGTEl(b),
+		K:   NewScalar(k),
+		Res: NewGTEl(res),
+	}
+	err = test.IsSolved(&GTOpsCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
 type PairCircuit struct {
 	InG1 G1Affine
 	InG2 G2Affine
@@ -183,6 +224,88 @@ func TestPairingCheckTestSolve(t *testing.T) {
 	assert.NoError(err)
 }
 
+type PairingCheckFixedQCircuit struct {
+	In1G1 G1Affine
+	In2G1 G1Affine
+	q1    bn254.G2Affine `gnark:"-"`
+	q2    bn254.G2Affine `gnark:"-"`
+}
+
+func (c *PairingCheckFixedQCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	err = pairing.PairingCheckFixedQ([]*G1Affine{&c.In1G1, &c.In1G1, &c.In2G1, &c.In2G1}, []bn254.G2Affine{c.q1, c.q2, c.q1, c.q2})
+	if err != nil {
+		return fmt.Errorf("pair: %w", err)
+	}
+	return nil
+}
+
+func TestPairingCheckFixedQTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p1, q1 := randomG1G2Affines()
+	p2, q2 := randomG1G2Affines()
+	var p3 bn254.G1Affine
+	p3.Neg(&p1)
+	witness := PairingCheckFixedQCircuit{
+		In1G1: NewG1Affine(p1),
+		In2G1: NewG1Affine(p3),
+	}
+	err := test.IsSolved(&PairingCheckFixedQCircuit{q1: q1, q2: q2}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	// the check should fail for a non-trivial product
+	witness.In2G1 = NewG1Affine(p2)
+	err = test.IsSolved(&PairingCheckFixedQCircuit{q1: q1, q2: q2}, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+type PairPrecomputedCircuit struct {
+	InG1      G1Affine
+	InG2Fixed G2Affine
+	InG2      G2Affine
+	Res1      GTEl
+	Res       GTEl
+}
+
+func (c *PairPrecomputedCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	res1, err := pairing.Pair([]*G1Affine{&c.InG1}, []*G2Affine{&c.InG2Fixed})
+	if err != nil {
+		return fmt.Errorf("pair: %w", err)
+	}
+	pairing.AssertIsEqual(res1, &c.Res1)
+	res, err := pairing.Pair([]*G1Affine{&c.InG1, &c.InG1}, []*G2Affine{&c.InG2Fixed, &c.InG2})
+	if err != nil {
+		return fmt.Errorf("pair: %w", err)
+	}
+	pairing.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestPairPrecomputedTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p, q1 := randomG1G2Affines()
+	_, q2 := randomG1G2Affines()
+	res1, err := bn254.Pair([]bn254.G1Affine{p}, []bn254.G2Affine{q1})
+	assert.NoError(err)
+	res, err := bn254.Pair([]bn254.G1Affine{p, p}, []bn254.G2Affine{q1, q2})
+	assert.NoError(err)
+	witness := PairPrecomputedCircuit{
+		InG1:      NewG1Affine(p),
+		InG2Fixed: NewG2AffineFixed(q1),
+		InG2:      NewG2Affine(q2),
+		Res1:      NewGTEl(res1),
+		Res:       NewGTEl(res),
+	}
+	err = test.IsSolved(&PairPrecomputedCircuit{InG2Fixed: NewG2AffineFixedPlaceholder()}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
 type FinalExponentiationSafeCircuit struct {
 	P1, P2 G1Affine
 	Q1, Q2 G2Affine
@@ -3,10 +3,35 @@ package sw_bn254
 import (
 	"sync"
 
+	"github.com/consensys/gnark-crypto/ecc/bn254"
 	"github.com/consensys/gnark/std/algebra/emulated/fields_bn254"
 	"github.com/consensys/gnark/std/math/emulated"
 )
 
+// lineEvaluations are the lines of the Miller loop for a fixed G2 point Q. The
+// first row holds the tangent lines and the second row the lines through Q (or
+// -Q) when the loop counter is non-zero. The last column holds the lines
+// through π(Q) and -π²(Q).
+type lineEvaluations [2][len(bn254.LoopCounter)]*lineEvaluation
+
+// precomputeLines computes natively the lines of the Miller loop for Q and
+// returns them as constants which can be used in-circuit.
+func precomputeLines(Q bn254.G2Affine) lineEvaluations {
+	var cLines lineEvaluations
+	nLines := bn254.PrecomputeLines(Q)
+	for j := range cLines[0] {
+		cLines[0][j] = &lineEvaluation{
+			R0: fields_bn254.FromE2(&nLines[0][j].R0),
+			R1: fields_bn254.FromE2(&nLines[0][j].R1),
+		}
+		cLines[1][j] = &lineEvaluation{
+			R0: fields_bn254.FromE2(&nLines[1][j].R0),
+			R1: fields_bn254.FromE2(&nLines[1][j].R1),
+		}
+	}
+	return cLines
+}
+
 // precomputed lines going through Q and multiples of Q
 // where Q is the fixed canonical generator of G2
 //
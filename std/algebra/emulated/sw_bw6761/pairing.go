@@ -139,6 +139,18 @@ func (pr Pairing) AssertIsEqual(x, y *GTEl) {
 	pr.Ext6.AssertIsEqual(x, y)
 }
 
+// MulGT computes the product of the target group elements and returns it. It
+// doesn't modify the inputs.
+func (pr Pairing) MulGT(x, y *GTEl) *GTEl {
+	return pr.Ext6.Mul(x, y)
+}
+
+// ExpGT computes x^k for the target group element x and returns it. It doesn't
+// modify the inputs.
+func (pr Pairing) ExpGT(x *GTEl, k *Scalar) *GTEl {
+	return pr.Ext6.Exp(x, k)
+}
+
 // seed x₀=9586122913090633729
 //
 // x₀+1 in binary (64 bits) padded with 0s
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"crypto/rand"
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
 	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
+	fr_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr"
 	"github.com/consensys/gnark/constraint"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/frontend/cs/r1cs"
@@ -61,6 +63,45 @@ func TestFinalExponentiationTestSolve(t *testing.T) {
 	assert.NoError(err)
 }
 
+type GTOpsCircuit struct {
+	A, B GTEl
+	K    Scalar
+	Res  GTEl
+}
+
+func (c *GTOpsCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	res := pairing.MulGT(&c.A, pairing.ExpGT(&c.B, &c.K))
+	pairing.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestGTOpsTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p1, q1 := randomG1G2Affines()
+	p2, q2 := randomG1G2Affines()
+	a, err := bw6761.Pair([]bw6761.G1Affine{p1}, []bw6761.G2Affine{q1})
+	assert.NoError(err)
+	b, err := bw6761.Pair([]bw6761.G1Affine{p2}, []bw6761.G2Affine{q2})
+	assert.NoError(err)
+	var k fr_bw6761.Element
+	k.SetRandom()
+	var res bw6761.GT
+	res.Exp(b, k.BigInt(new(big.Int)))
+	res.Mul(&a, &res)
+	witness := GTOpsCircuit{
+		A:   NewGTEl(a),
+		B:   NewGTEl(b),
+		K:   NewScalar(k),
+		Res: NewGTEl(res),
+	}
+	err = test.IsSolved(&GTOpsCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
 type PairCircuit struct {
 	InG1 G1Affine
 	InG2 G2Affine
@@ -17,6 +17,21 @@ infinity. As such, this package does not expose separate Add and Double methods.
 The package provides a few curve parameters, see functions [GetSecp256k1Params]
 and [GetBN254Params].
 
+When the curve parameters define an efficient endomorphism (as for secp256k1
+and BN254), the variable-base scalar multiplications can use the GLV
+decomposition of the scalar, computed in a hint and verified in-circuit, with
+the option [github.com/consensys/gnark/std/algebra/algopts.WithGLV]. The GLV
+variants use incomplete formulas and are not satisfiable for a small set of
+exceptional inputs, so they are not used by default. With the option, the
+methods [Curve.JointScalarMul] and [Curve.JointScalarMulBase] compute two
+scalar multiplications in a single double-and-add loop.
+
+Similarly, with the option
+[github.com/consensys/gnark/std/algebra/algopts.WithWindowedMultiScalarMul]
+the multi-scalar multiplication uses windowed tables of the multiples of the
+points stored in log-derivative lookup tables. The multi-scalar multiplication
+of fixed points [Curve.MultiScalarMulFixedBase] always uses such tables.
+
 Unconventionally, this package uses type parameters to define the base field of
 the points and variables to define the coefficients of the curve. This is due to
 how the emulated elements are constructed by their type parameters. To unify the
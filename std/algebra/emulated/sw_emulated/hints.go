@@ -0,0 +1,96 @@
+package sw_emulated
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all the hints used in this package.
+func GetHints() []solver.Hint {
+	return []solver.Hint{decomposeScalarG1}
+}
+
+// glvParams returns the lattice of the GLV decomposition for the eigenvalue
+// lambda modulo r, a lattice vector with both coordinates positive and the
+// number of bits of the decomposed scalars after shifting them by this vector.
+func glvParams(r, lambda *big.Int) (lattice *ecc.Lattice, shift [2]*big.Int, nbBits int) {
+	lattice = new(ecc.Lattice)
+	ecc.PrecomputeLattice(r, lambda, lattice)
+	v1, v2 := lattice.V1, lattice.V2
+	var best *big.Int
+	for _, c := range [][2]int64{{1, 0}, {-1, 0}, {0, 1}, {0, -1}, {1, 1}, {-1, -1}, {1, -1}, {-1, 1}} {
+		var cand [2]*big.Int
+		for i := range cand {
+			t := new(big.Int).Mul(&v2[i], big.NewInt(c[1]))
+			cand[i] = new(big.Int).Mul(&v1[i], big.NewInt(c[0]))
+			cand[i].Add(cand[i], t)
+		}
+		if cand[0].Sign() <= 0 || cand[1].Sign() <= 0 {
+			continue
+		}
+		m := cand[0]
+		if cand[1].Cmp(m) < 0 {
+			m = cand[1]
+		}
+		if best == nil || m.Cmp(best) > 0 {
+			best, shift = m, cand
+		}
+	}
+	if best == nil {
+		panic("no positive vector in the GLV lattice")
+	}
+	nbBits = shift[0].BitLen()
+	if shift[1].BitLen() > nbBits {
+		nbBits = shift[1].BitLen()
+	}
+	return lattice, shift, nbBits + 2
+}
+
+// decomposeScalarG1 is a hint which decomposes the scalar s into s1 and s2
+// such that s = s1 + λ*s2 mod r, see [glvDecompose].
+func decomposeScalarG1(mod *big.Int, inputs, outputs []*big.Int) error {
+	return emulated.UnwrapHint(inputs, outputs, func(r *big.Int, inputs, outputs []*big.Int) error {
+		if len(inputs) != 2 {
+			return fmt.Errorf("expecting two inputs")
+		}
+		if len(outputs) != 2 {
+			return fmt.Errorf("expecting two outputs")
+		}
+		s1, s2, err := glvDecompose(r, inputs[0], inputs[1])
+		if err != nil {
+			return err
+		}
+		outputs[0].Set(s1)
+		outputs[1].Set(s2)
+		return nil
+	})
+}
+
+// glvDecompose decomposes the scalar s into s1 and s2 such that s = s1 +
+// λ*s2 mod r. Both s1 and s2 are non-negative, less than 2^nbBits and at least
+// one of them has the bit nbBits-1 set, where nbBits is given by [glvParams].
+func glvDecompose(r, s, lambda *big.Int) (s1, s2 *big.Int, err error) {
+	lattice, shift, nbBits := glvParams(r, lambda)
+	sp := ecc.SplitScalar(new(big.Int).Mod(s, r), lattice)
+	s1, s2 = &sp[0], &sp[1]
+	// the scalars from the decomposition may be negative and short. We shift
+	// them by a lattice vector until they are non-negative and the longest of
+	// them has exactly nbBits bits.
+	half := new(big.Int).Lsh(big.NewInt(1), uint(nbBits-1))
+	for s1.Sign() < 0 || s2.Sign() < 0 || (s1.Cmp(half) < 0 && s2.Cmp(half) < 0) {
+		s1.Add(s1, shift[0])
+		s2.Add(s2, shift[1])
+	}
+	if s1.BitLen() > nbBits || s2.BitLen() > nbBits {
+		return nil, nil, fmt.Errorf("decomposed scalar exceeds %d bits", nbBits)
+	}
+	return s1, s2, nil
+}
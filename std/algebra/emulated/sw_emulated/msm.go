@@ -0,0 +1,278 @@
+package sw_emulated
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// msmWindowSize is the number of scalar bits processed per table lookup in the
+// multi-scalar multiplications.
+const msmWindowSize = 4
+
+// pointTable is a table of points which can be queried at variable indices. It
+// uses log-derivative lookups of the coordinates.
+type pointTable[B emulated.FieldParams] struct {
+	x, y *emulated.LookupTable[B]
+}
+
+func (c *Curve[B, S]) newPointTable(entries []*AffinePoint[B]) *pointTable[B] {
+	xs := make([]*emulated.Element[B], len(entries))
+	ys := make([]*emulated.Element[B], len(entries))
+	for i := range entries {
+		xs[i] = &entries[i].X
+		ys[i] = &entries[i].Y
+	}
+	return &pointTable[B]{
+		x: c.baseApi.NewLookupTable(xs...),
+		y: c.baseApi.NewLookupTable(ys...),
+	}
+}
+
+func (t *pointTable[B]) lookup(idx frontend.Variable) *AffinePoint[B] {
+	return &AffinePoint[B]{
+		X: *t.x.Lookup(idx),
+		Y: *t.y.Lookup(idx),
+	}
+}
+
+// msmWindows returns the number of windows for the recoding of the scalars.
+func (c *Curve[B, S]) msmWindows() int {
+	var fr S
+	return (fr.Modulus().BitLen() + msmWindowSize - 1) / msmWindowSize
+}
+
+// msmRecode recodes the scalar s into odd signed digits d_j in [-(2^w-1),
+// 2^w-1] such that s = ∑ d_j 2^(w*j) mod r, where w is the window size. As
+// the digits are never zero, the table lookups never return the point at
+// infinity. It returns the indices of the digits in the tables (see
+// [Curve.msmTable]), least significant first.
+//
+// For this, it computes the bits b_i of k = (s + 2^(w*W) - 1)/2 mod r, where W
+// is the number of windows. Then s = ∑ (2b_i - 1) 2^i mod r and the window
+// value v_j = ∑_{i<w} b_(w*j+i) 2^i corresponds to the digit d_j = 2v_j -
+// 2^w + 1.
+func (c *Curve[B, S]) msmRecode(s *emulated.Element[S]) []frontend.Variable {
+	var fr S
+	nbWindows := c.msmWindows()
+	nbBits := nbWindows * msmWindowSize
+	shift := new(big.Int).Lsh(big.NewInt(1), uint(nbBits))
+	shift.Sub(shift, big.NewInt(1))
+	half := new(big.Int).ModInverse(big.NewInt(2), fr.Modulus())
+	shiftEl := emulated.ValueOf[S](shift)
+	halfEl := emulated.ValueOf[S](half)
+	k := c.scalarApi.Mul(c.scalarApi.Add(s, &shiftEl), &halfEl)
+	kBits := c.scalarApi.ToBits(c.scalarApi.Reduce(k))
+	n := fr.Modulus().BitLen()
+	res := make([]frontend.Variable, nbWindows)
+	for j := range res {
+		var v frontend.Variable = 0
+		for i := msmWindowSize - 1; i >= 0; i-- {
+			v = c.api.Mul(v, 2)
+			if idx := j*msmWindowSize + i; idx < n {
+				v = c.api.Add(v, kBits[idx])
+			}
+		}
+		res[j] = v
+	}
+	return res
+}
+
+// msmTable returns the table of the multiples [d]p for all the digits d, indexed
+// as returned by [Curve.msmRecode].
+func (c *Curve[B, S]) msmTable(p *AffinePoint[B]) *pointTable[B] {
+	half := 1 << (msmWindowSize - 1)
+	entries := make([]*AffinePoint[B], 2*half)
+	// entries[half+k] = [2k+1]p and entries[half-1-k] = -[2k+1]p
+	p2 := c.double(p)
+	entries[half] = p
+	for k := 1; k < half; k++ {
+		entries[half+k] = c.add(entries[half+k-1], p2)
+	}
+	for k := 0; k < half; k++ {
+		entries[half-1-k] = c.Neg(entries[half+k])
+	}
+	return c.newPointTable(entries)
+}
+
+// msmOffset returns the accumulator offset point H, which is a multiple of the
+// generator by a fixed scalar with unknown structure, and [2^e]H. We start the
+// accumulation in the multi-scalar multiplications from H so that the
+// incomplete additions do not hit exceptional cases for structured inputs
+// (repeated points or the generator as a point).
+func (c *Curve[B, S]) msmOffset(e int) (h, he [2]*big.Int) {
+	var fr S
+	hs := sha256.Sum256([]byte("gnark sw_emulated multi-scalar multiplication offset"))
+	hb := new(big.Int).SetBytes(hs[:])
+	hb.Mod(hb, fr.Modulus())
+	h = c.nativeScalarMul([2]*big.Int{c.params.Gx, c.params.Gy}, hb)
+	he = h
+	for i := 0; i < e; i++ {
+		he = c.nativeAdd(he, he)
+	}
+	return h, he
+}
+
+// MultiScalarMulFixedBase computes ∑ s_i * P_i where the points P_i are fixed
+// and known at compile time. It returns an error if the lengths of the inputs
+// mismatch or if any point is not on the curve.
+//
+// ✅ the scalars can be 0 and the result can be (0,0).
+// ⚠️  the points must not be (0,0).
+//
+// As the points are known, for every point and window of the scalars we
+// precompute the table of the multiples of the point at compile time. The
+// circuit then consists only of lookups from the constant tables and
+// additions, without any doublings.
+func (c *Curve[B, S]) MultiScalarMulFixedBase(p [][2]*big.Int, s []*emulated.Element[S]) (*AffinePoint[B], error) {
+	if len(p) != len(s) {
+		return nil, fmt.Errorf("mismatching points and scalars slice lengths")
+	}
+	if len(p) == 0 {
+		return &AffinePoint[B]{
+			X: *c.baseApi.Zero(),
+			Y: *c.baseApi.Zero(),
+		}, nil
+	}
+	for i := range p {
+		if !c.nativeIsOnCurve(p[i]) {
+			return nil, fmt.Errorf("point %d is not on the curve", i)
+		}
+	}
+	nbWindows := c.msmWindows()
+	half := 1 << (msmWindowSize - 1)
+	h, _ := c.msmOffset(0)
+	acc := c.constPoint(h)
+	for i := range p {
+		digits := c.msmRecode(s[i])
+		q := p[i]
+		for j := 0; j < nbWindows; j++ {
+			// entries are [d][2^(w*j)]p for all digits d
+			entries := make([]*AffinePoint[B], 2*half)
+			q2 := c.nativeAdd(q, q)
+			m := q
+			for k := 0; k < half; k++ {
+				entries[half+k] = c.constPoint(m)
+				entries[half-1-k] = c.constPoint(c.nativeNeg(m))
+				m = c.nativeAdd(m, q2)
+			}
+			table := c.newPointTable(entries)
+			acc = c.add(acc, table.lookup(digits[j]))
+			for k := 0; k < msmWindowSize; k++ {
+				q = c.nativeAdd(q, q)
+			}
+		}
+	}
+	return c.AddUnified(acc, c.constPoint(c.nativeNeg(h))), nil
+}
+
+// multiScalarMulWindowed computes ∑ s_i * p_i using the windowed joint-table
+// (Straus) algorithm. The points can be (0,0) and the scalars can be 0.
+//
+// For every point we build a lookup table of the odd multiples of the point
+// (see [Curve.msmTable]) and recode the scalar into odd signed digits (see
+// [Curve.msmRecode]). Then we share the doublings of the accumulator between
+// all points, so that for every window we perform w doublings and a single
+// addition per point.
+func (c *Curve[B, S]) multiScalarMulWindowed(p []*AffinePoint[B], s []*emulated.Element[S]) *AffinePoint[B] {
+	nbWindows := c.msmWindows()
+	tables := make([]*pointTable[B], len(p))
+	digits := make([][]frontend.Variable, len(p))
+	for i := range p {
+		// if p=(0,0) we assign a dummy generator to p and set the scalar to 0
+		selector := c.api.And(c.baseApi.IsZero(&p[i].X), c.baseApi.IsZero(&p[i].Y))
+		pi := c.Select(selector, &c.g, p[i])
+		si := c.scalarApi.Select(selector, c.scalarApi.Zero(), s[i])
+		tables[i] = c.msmTable(pi)
+		digits[i] = c.msmRecode(si)
+	}
+	h, he := c.msmOffset(msmWindowSize * (nbWindows - 1))
+	acc := c.constPoint(h)
+	for i := range p {
+		acc = c.add(acc, tables[i].lookup(digits[i][nbWindows-1]))
+	}
+	for j := nbWindows - 2; j >= 0; j-- {
+		for k := 0; k < msmWindowSize-1; k++ {
+			acc = c.double(acc)
+		}
+		acc = c.doubleAndAdd(acc, tables[0].lookup(digits[0][j]))
+		for i := 1; i < len(p); i++ {
+			acc = c.add(acc, tables[i].lookup(digits[i][j]))
+		}
+	}
+	return c.AddUnified(acc, c.constPoint(c.nativeNeg(he)))
+}
+
+// constPoint returns the constant point with the coordinates of p.
+func (c *Curve[B, S]) constPoint(p [2]*big.Int) *AffinePoint[B] {
+	return &AffinePoint[B]{
+		X: emulated.ValueOf[B](p[0]),
+		Y: emulated.ValueOf[B](p[1]),
+	}
+}
+
+// nativeIsOnCurve returns true if p satisfies the curve equation.
+func (c *Curve[B, S]) nativeIsOnCurve(p [2]*big.Int) bool {
+	var fp B
+	mod := fp.Modulus()
+	if p[0] == nil || p[1] == nil {
+		return false
+	}
+	lhs := new(big.Int).Mul(p[1], p[1])
+	lhs.Mod(lhs, mod)
+	rhs := new(big.Int).Mul(p[0], p[0])
+	rhs.Add(rhs, c.params.A).Mul(rhs, p[0]).Add(rhs, c.params.B).Mod(rhs, mod)
+	return lhs.Cmp(rhs) == 0
+}
+
+// nativeNeg returns -p computed outside of the circuit.
+func (c *Curve[B, S]) nativeNeg(p [2]*big.Int) [2]*big.Int {
+	var fp B
+	y := new(big.Int).Neg(p[1])
+	return [2]*big.Int{p[0], y.Mod(y, fp.Modulus())}
+}
+
+// nativeAdd adds the points p and q outside of the circuit using affine
+// formulas. It is used for precomputing constant points. The points must not be
+// (0,0) and the result must not be (0,0).
+func (c *Curve[B, S]) nativeAdd(p, q [2]*big.Int) [2]*big.Int {
+	var fp B
+	mod := fp.Modulus()
+	num, den := new(big.Int), new(big.Int)
+	if p[0].Cmp(q[0]) == 0 {
+		// λ = (3x²+a)/2y
+		num.Mul(p[0], p[0]).Mul(num, big.NewInt(3)).Add(num, c.params.A)
+		den.Lsh(p[1], 1)
+	} else {
+		// λ = (q.y-p.y)/(q.x-p.x)
+		num.Sub(q[1], p[1])
+		den.Sub(q[0], p[0])
+	}
+	den.Mod(den, mod)
+	if den.ModInverse(den, mod) == nil {
+		panic("native addition hit the point at infinity")
+	}
+	l := num.Mul(num, den)
+	l.Mod(l, mod)
+	x := new(big.Int).Mul(l, l)
+	x.Sub(x, p[0]).Sub(x, q[0]).Mod(x, mod)
+	y := new(big.Int).Sub(p[0], x)
+	y.Mul(y, l).Sub(y, p[1]).Mod(y, mod)
+	return [2]*big.Int{x, y}
+}
+
+// nativeScalarMul computes [s]p outside of the circuit. The scalar must be
+// positive and the result must not be (0,0).
+func (c *Curve[B, S]) nativeScalarMul(p [2]*big.Int, s *big.Int) [2]*big.Int {
+	res := p
+	for i := s.BitLen() - 2; i >= 0; i-- {
+		res = c.nativeAdd(res, res)
+		if s.Bit(i) == 1 {
+			res = c.nativeAdd(res, p)
+		}
+	}
+	return res
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/elliptic"
 	"math/big"
 
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
 	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
@@ -17,12 +18,18 @@ import (
 //	Y² = X³ + aX + b
 //
 // The base point is defined by (Gx, Gy).
+//
+// If the curve has an efficient endomorphism φ(X,Y) = (ωX,Y) = [λ](X,Y), then
+// Eigenvalue and ThirdRootOne define it and the scalar multiplications use the
+// GLV decomposition. Otherwise they are nil.
 type CurveParams struct {
-	A  *big.Int      // a in curve equation
-	B  *big.Int      // b in curve equation
-	Gx *big.Int      // base point x
-	Gy *big.Int      // base point y
-	Gm [][2]*big.Int // m*base point coords
+	A            *big.Int      // a in curve equation
+	B            *big.Int      // b in curve equation
+	Gx           *big.Int      // base point x
+	Gy           *big.Int      // base point y
+	Gm           [][2]*big.Int // m*base point coords
+	Eigenvalue   *big.Int      // endomorphism eigenvalue λ in the scalar field
+	ThirdRootOne *big.Int      // endomorphism image scaler ω in the base field
 }
 
 // GetSecp256k1Params returns curve parameters for the curve secp256k1. When
@@ -31,11 +38,13 @@ type CurveParams struct {
 func GetSecp256k1Params() CurveParams {
 	_, g1aff := secp256k1.Generators()
 	return CurveParams{
-		A:  big.NewInt(0),
-		B:  big.NewInt(7),
-		Gx: g1aff.X.BigInt(new(big.Int)),
-		Gy: g1aff.Y.BigInt(new(big.Int)),
-		Gm: computeSecp256k1Table(),
+		A:            big.NewInt(0),
+		B:            big.NewInt(7),
+		Gx:           g1aff.X.BigInt(new(big.Int)),
+		Gy:           g1aff.Y.BigInt(new(big.Int)),
+		Gm:           computeSecp256k1Table(),
+		Eigenvalue:   newBigInt("0x5363ad4cc05c30e0a5261c028812645a122e22ea20816678df02967c1b23bd72"),
+		ThirdRootOne: newBigInt("0x7ae96a2b657c07106e64479eac3434e99cf0497512f58995c1396c28719501ee"),
 	}
 }
 
@@ -45,11 +54,13 @@ func GetSecp256k1Params() CurveParams {
 func GetBN254Params() CurveParams {
 	_, _, g1aff, _ := bn254.Generators()
 	return CurveParams{
-		A:  big.NewInt(0),
-		B:  big.NewInt(3),
-		Gx: g1aff.X.BigInt(new(big.Int)),
-		Gy: g1aff.Y.BigInt(new(big.Int)),
-		Gm: computeBN254Table(),
+		A:            big.NewInt(0),
+		B:            big.NewInt(3),
+		Gx:           g1aff.X.BigInt(new(big.Int)),
+		Gy:           g1aff.Y.BigInt(new(big.Int)),
+		Gm:           computeBN254Table(),
+		Eigenvalue:   newBigInt("4407920970296243842393367215006156084916469457145843978461"),
+		ThirdRootOne: newBigInt("2203960485148121921418603742825762020974279258880205651966"),
 	}
 }
 
@@ -67,6 +78,20 @@ func GetBLS12381Params() CurveParams {
 	}
 }
 
+// GetBLS12377Params returns the curve parameters for the curve BLS12-377.
+// When initialising new curve, use the base field [emulated.BLS12377Fp] and scalar
+// field [emulated.BLS12377Fr].
+func GetBLS12377Params() CurveParams {
+	_, _, g1aff, _ := bls12377.Generators()
+	return CurveParams{
+		A:  big.NewInt(0),
+		B:  big.NewInt(1),
+		Gx: g1aff.X.BigInt(new(big.Int)),
+		Gy: g1aff.Y.BigInt(new(big.Int)),
+		Gm: computeBLS12377Table(),
+	}
+}
+
 // GetP256Params returns the curve parameters for the curve P-256 (also
 // SECP256r1). When initialising new curve, use the base field
 // [emulated.P256Fp] and scalar field [emulated.P256Fr].
@@ -111,6 +136,14 @@ func GetBW6761Params() CurveParams {
 	}
 }
 
+func newBigInt(s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		panic("invalid big.Int string")
+	}
+	return v
+}
+
 // GetCurveParams returns suitable curve parameters given the parametric type
 // Base as base field. It caches the parameters and modifying the values in the
 // parameters struct leads to undefined behaviour.
@@ -123,6 +156,8 @@ func GetCurveParams[Base emulated.FieldParams]() CurveParams {
 		return bn254Params
 	case emulated.BLS12381Fp{}.Modulus().String():
 		return bls12381Params
+	case emulated.BLS12377Fp{}.Modulus().String():
+		return bls12377Params
 	case emulated.P256Fp{}.Modulus().String():
 		return p256Params
 	case emulated.P384Fp{}.Modulus().String():
@@ -138,6 +173,7 @@ var (
 	secp256k1Params CurveParams
 	bn254Params     CurveParams
 	bls12381Params  CurveParams
+	bls12377Params  CurveParams
 	p256Params      CurveParams
 	p384Params      CurveParams
 	bw6761Params    CurveParams
@@ -147,6 +183,7 @@ func init() {
 	secp256k1Params = GetSecp256k1Params()
 	bn254Params = GetBN254Params()
 	bls12381Params = GetBLS12381Params()
+	bls12377Params = GetBLS12377Params()
 	p256Params = GetP256Params()
 	p384Params = GetP384Params()
 	bw6761Params = GetBW6761Params()
@@ -29,6 +29,19 @@ func New[Base, Scalars emulated.FieldParams](api frontend.API, params CurveParam
 	}
 	Gx := emulated.ValueOf[Base](params.Gx)
 	Gy := emulated.ValueOf[Base](params.Gy)
+	var eigenvalue *emulated.Element[Scalars]
+	var thirdRootOne *emulated.Element[Base]
+	var glvNbBits int
+	// we only use the endomorphism if the eigenvalue and the image scaler are
+	// primitive cube roots of unity in the scalar and base fields.
+	var fr Scalars
+	var fp Base
+	if isThirdRootOne(params.Eigenvalue, fr.Modulus()) && isThirdRootOne(params.ThirdRootOne, fp.Modulus()) {
+		ev := emulated.ValueOf[Scalars](params.Eigenvalue)
+		tro := emulated.ValueOf[Base](params.ThirdRootOne)
+		eigenvalue, thirdRootOne = &ev, &tro
+		_, _, glvNbBits = glvParams(fr.Modulus(), params.Eigenvalue)
+	}
 	return &Curve[Base, Scalars]{
 		params:    params,
 		api:       api,
@@ -42,9 +55,24 @@ func New[Base, Scalars emulated.FieldParams](api frontend.API, params CurveParam
 		a:    emulated.ValueOf[Base](params.A),
 		b:    emulated.ValueOf[Base](params.B),
 		addA: params.A.Cmp(big.NewInt(0)) != 0,
+
+		eigenvalue:   eigenvalue,
+		thirdRootOne: thirdRootOne,
+		glvNbBits:    glvNbBits,
 	}, nil
 }
 
+// isThirdRootOne returns true if x is a primitive cube root of unity modulo q.
+func isThirdRootOne(x, q *big.Int) bool {
+	if x == nil {
+		return false
+	}
+	// x² + x + 1 == 0 mod q
+	t := new(big.Int).Mul(x, x)
+	t.Add(t, x).Add(t, big.NewInt(1)).Mod(t, q)
+	return t.Sign() == 0
+}
+
 // Curve is an initialised curve which allows performing group operations.
 type Curve[Base, Scalars emulated.FieldParams] struct {
 	// params is the parameters of the curve
@@ -65,6 +93,14 @@ type Curve[Base, Scalars emulated.FieldParams] struct {
 	a    emulated.Element[Base]
 	b    emulated.Element[Base]
 	addA bool
+
+	// eigenvalue and thirdRootOne define the endomorphism used in the GLV
+	// scalar multiplication. They are nil if the curve has no such
+	// endomorphism.
+	eigenvalue   *emulated.Element[Scalars]
+	thirdRootOne *emulated.Element[Base]
+	// glvNbBits is the bit-length of the sub-scalars of the GLV decomposition.
+	glvNbBits int
 }
 
 // Generator returns the base point of the curve. The method does not copy and
@@ -123,6 +159,52 @@ func (c *Curve[B, S]) MarshalG1(p AffinePoint[B]) []frontend.Variable {
 	return res
 }
 
+// UnmarshalSEC1Compressed unmarshals the point from its compressed SEC1
+// encoding given in bits, most significant bit first. The encoding consists of
+// the prefix byte 0x02 or 0x03, depending if Y is even or odd, followed by the
+// big-endian encoding of X. It returns an error if the number of bits does not
+// match the encoding length.
+//
+// The method asserts that the prefix is valid, that X is canonical (less than
+// the modulus) and that it is the X-coordinate of a point on the curve. The
+// Y-coordinate is recovered using a square root hint.
+//
+// ⚠️  the point at infinity (single byte 0x00) is not supported.
+func (c *Curve[B, S]) UnmarshalSEC1Compressed(bits []frontend.Variable) (*AffinePoint[B], error) {
+	var fp B
+	nbBits := 8 * ((fp.Modulus().BitLen() + 7) / 8)
+	if len(bits) != 8+nbBits {
+		return nil, fmt.Errorf("invalid number of bits: %d, expected %d", len(bits), 8+nbBits)
+	}
+	// prefix is 0b0000001s where s is the parity of Y
+	for i := 0; i < 6; i++ {
+		c.api.AssertIsEqual(bits[i], 0)
+	}
+	c.api.AssertIsEqual(bits[6], 1)
+	c.api.AssertIsBoolean(bits[7])
+
+	bx := make([]frontend.Variable, nbBits)
+	copy(bx, bits[8:])
+	slices.Reverse(bx)
+	x := c.baseApi.FromBits(bx...)
+	c.baseApi.AssertIsInRange(x)
+
+	// Y² = X³ + aX + b
+	y2 := c.baseApi.Mul(x, c.baseApi.Mul(x, x))
+	y2 = c.baseApi.Add(y2, &c.b)
+	if c.addA {
+		y2 = c.baseApi.Add(y2, c.baseApi.Mul(&c.a, x))
+	}
+	y := c.baseApi.Sqrt(y2)
+	// choose the square root with the parity given by the prefix
+	neg := c.api.Xor(c.baseApi.IsOdd(y), bits[7])
+	y = c.baseApi.Select(neg, c.baseApi.Neg(y), y)
+	return &AffinePoint[B]{
+		X: *x,
+		Y: *y,
+	}, nil
+}
+
 // Neg returns an inverse of p. It doesn't modify p.
 func (c *Curve[B, S]) Neg(p *AffinePoint[B]) *AffinePoint[B] {
 	return &AffinePoint[B]{
@@ -244,6 +326,99 @@ func (c *Curve[B, S]) Add(p, q *AffinePoint[B]) *AffinePoint[B] {
 	return c.AddUnified(p, q)
 }
 
+// addComplete adds p and q and returns it. It doesn't modify p nor q.
+//
+// ✅ p can be equal to q, and either or both can be (0,0).
+// (0,0) is not on the curve but we conventionally take it as the
+// neutral/infinity point as per the [EVM].
+//
+// Contrary to [Curve.AddUnified], it selects between the chord and the tangent
+// slopes, so that the result is correct for all the inputs, including p = -q
+// and p.y = -q.y with p ≠ -q. It is used when the option
+// [algopts.WithCompleteArithmetic] is given.
+//
+// [EVM]: https://ethereum.github.io/yellowpaper/paper.pdf
+func (c *Curve[B, S]) addComplete(p, q *AffinePoint[B]) *AffinePoint[B] {
+
+	// selector1 = 1 when p is (0,0) and 0 otherwise
+	selector1 := c.api.And(c.baseApi.IsZero(&p.X), c.baseApi.IsZero(&p.Y))
+	// selector2 = 1 when q is (0,0) and 0 otherwise
+	selector2 := c.api.And(c.baseApi.IsZero(&q.X), c.baseApi.IsZero(&q.Y))
+	// selector3 = 1 when p.x = q.x, i.e. q = ±p
+	selector3 := c.baseApi.IsZero(c.baseApi.Sub(&q.X, &p.X))
+	// selector4 = 1 when q = -p and the sum is (0,0)
+	selector4 := c.api.And(selector3, c.baseApi.IsZero(c.baseApi.Add(&p.Y, &q.Y)))
+
+	// λ = (3p.x²+a)/2p.y if q = p and (q.y-p.y)/(q.x-p.x) otherwise. In the
+	// exceptional cases we set the denominator to 1 and discard the result.
+	xx3a := c.baseApi.MulMod(&p.X, &p.X)
+	xx3a = c.baseApi.MulConst(xx3a, big.NewInt(3))
+	if c.addA {
+		xx3a = c.baseApi.Add(xx3a, &c.a)
+	}
+	num := c.baseApi.Select(selector3, xx3a, c.baseApi.Sub(&q.Y, &p.Y))
+	denum := c.baseApi.Select(selector3, c.baseApi.MulConst(&p.Y, big.NewInt(2)), c.baseApi.Sub(&q.X, &p.X))
+	exceptional := c.api.Or(c.api.Or(selector1, selector2), selector4)
+	denum = c.baseApi.Select(exceptional, c.baseApi.One(), denum)
+	λ := c.baseApi.Div(num, denum)
+
+	// xr = λ²-p.x-q.x
+	λλ := c.baseApi.MulMod(λ, λ)
+	xr := c.baseApi.Sub(λλ, c.baseApi.Add(&p.X, &q.X))
+
+	// yr = λ(p.x-xr) - p.y
+	yr := c.baseApi.Sub(c.baseApi.MulMod(λ, c.baseApi.Sub(&p.X, xr)), &p.Y)
+	result := &AffinePoint[B]{
+		X: *c.baseApi.Reduce(xr),
+		Y: *c.baseApi.Reduce(yr),
+	}
+
+	zero := c.baseApi.Zero()
+	// if q = -p return (0,0)
+	result = c.Select(selector4, &AffinePoint[B]{X: *zero, Y: *zero}, result)
+	// if q = (0,0) return p
+	result = c.Select(selector2, p, result)
+	// if p = (0,0) return q
+	result = c.Select(selector1, q, result)
+
+	return result
+}
+
+// doubleComplete doubles p and returns it. It doesn't modify p. Contrary to
+// [Curve.double], it handles the points with p.y = 0, including (0,0), for
+// which the result is (0,0).
+func (c *Curve[B, S]) doubleComplete(p *AffinePoint[B]) *AffinePoint[B] {
+
+	// selector = 1 when p.y = 0 and 0 otherwise
+	selector := c.baseApi.IsZero(&p.Y)
+
+	// compute λ = (3p.x²+a)/2*p.y
+	xx3a := c.baseApi.MulMod(&p.X, &p.X)
+	xx3a = c.baseApi.MulConst(xx3a, big.NewInt(3))
+	if c.addA {
+		xx3a = c.baseApi.Add(xx3a, &c.a)
+	}
+	y2 := c.baseApi.MulConst(&p.Y, big.NewInt(2))
+	y2 = c.baseApi.Select(selector, c.baseApi.One(), y2)
+	λ := c.baseApi.Div(xx3a, y2)
+
+	// xr = λ²-2p.x
+	x2 := c.baseApi.MulConst(&p.X, big.NewInt(2))
+	λλ := c.baseApi.MulMod(λ, λ)
+	xr := c.baseApi.Sub(λλ, x2)
+
+	// yr = λ(p-xr) - p.y
+	pxrx := c.baseApi.Sub(&p.X, xr)
+	λpxrx := c.baseApi.MulMod(λ, pxrx)
+	yr := c.baseApi.Sub(λpxrx, &p.Y)
+
+	zero := c.baseApi.Zero()
+	return c.Select(selector, &AffinePoint[B]{X: *zero, Y: *zero}, &AffinePoint[B]{
+		X: *c.baseApi.Reduce(xr),
+		Y: *c.baseApi.Reduce(yr),
+	})
+}
+
 // double doubles p and return it. It doesn't modify p.
 //
 // ⚠️  p.Y must be nonzero.
@@ -463,6 +638,17 @@ func (c *Curve[B, S]) Lookup2(b0, b1 frontend.Variable, i0, i1, i2, i3 *AffinePo
 // positions 1 and n-1 outside of the loop to optimize the number of
 // constraints using [ELM03] (Section 3.1)
 //
+// If the option [algopts.WithGLV] is given, the curve has an efficient
+// endomorphism (see [CurveParams]) and the scalar is not bounded to fewer bits
+// than the sub-scalars of the GLV decomposition, then the method uses the GLV
+// decomposition instead, which halves the number of iterations. See
+// [Curve.scalarMulGLV] for its exceptional cases.
+//
+// If the option [algopts.WithCompleteArithmetic] is given, then the method
+// uses a left-to-right double-and-add algorithm with complete formulas
+// instead, see [Curve.scalarMulComplete]. It takes precedence over
+// [algopts.WithGLV].
+//
 // [ELM03]: https://arxiv.org/pdf/math/0208038.pdf
 // [EVM]: https://ethereum.github.io/yellowpaper/paper.pdf
 // [Joye07]: https://www.iacr.org/archive/ches2007/47270135/47270135.pdf
@@ -472,18 +658,25 @@ func (c *Curve[B, S]) ScalarMul(p *AffinePoint[B], s *emulated.Element[S], opts
 		panic(fmt.Sprintf("parse opts: %v", err))
 	}
 
+	var st S
+	n := st.Modulus().BitLen()
+	if cfg.NbScalarBits > 2 && cfg.NbScalarBits < n {
+		n = cfg.NbScalarBits
+	}
+	if cfg.CompleteArithmetic {
+		return c.scalarMulComplete(p, s, n)
+	}
+	if cfg.UseGLV && c.eigenvalue != nil && n > c.glvNbBits {
+		return c.scalarMulGLV(p, s)
+	}
+
 	// if p=(0,0) we assign a dummy (0,1) to p and continue
 	selector := c.api.And(c.baseApi.IsZero(&p.X), c.baseApi.IsZero(&p.Y))
 	one := c.baseApi.One()
 	p = c.Select(selector, &AffinePoint[B]{X: *one, Y: *one}, p)
 
-	var st S
 	sr := c.scalarApi.Reduce(s)
 	sBits := c.scalarApi.ToBits(sr)
-	n := st.Modulus().BitLen()
-	if cfg.NbScalarBits > 2 && cfg.NbScalarBits < n {
-		n = cfg.NbScalarBits
-	}
 
 	// i = 1
 	Rb := c.triple(p)
@@ -512,6 +705,129 @@ func (c *Curve[B, S]) ScalarMul(p *AffinePoint[B], s *emulated.Element[S], opts
 	return R0
 }
 
+// scalarMulComplete computes s * p using the n least significant bits of s and
+// returns it. It doesn't modify p nor s.
+//
+// ✅ p can be (0,0) and s can be 0.
+//
+// It computes the left-to-right double-and-add algorithm with the complete
+// formulas [Curve.doubleComplete] and [Curve.addComplete], so that all the
+// intermediate results, including (0,0) and ±p, are handled. It is used when
+// the option [algopts.WithCompleteArithmetic] is given.
+func (c *Curve[B, S]) scalarMulComplete(p *AffinePoint[B], s *emulated.Element[S], n int) *AffinePoint[B] {
+	sr := c.scalarApi.Reduce(s)
+	sBits := c.scalarApi.ToBits(sr)
+
+	zero := c.baseApi.Zero()
+	// i = n-1
+	res := c.Select(sBits[n-1], p, &AffinePoint[B]{X: *zero, Y: *zero})
+	for i := n - 2; i >= 0; i-- {
+		res = c.doubleComplete(res)
+		res = c.Select(sBits[i], c.addComplete(res, p), res)
+	}
+
+	return res
+}
+
+// phi computes the endomorphism φ(p) = (ω*p.x, p.y) = [λ]p and returns it. It
+// doesn't modify p.
+func (c *Curve[B, S]) phi(p *AffinePoint[B]) *AffinePoint[B] {
+	return &AffinePoint[B]{
+		X: *c.baseApi.Mul(&p.X, c.thirdRootOne),
+		Y: p.Y,
+	}
+}
+
+// decomposeScalar decomposes the scalar s as s = s1 + λ*s2 mod r using
+// [decomposeScalarG1] and returns the glvNbBits little-endian bits of s1 and
+// s2. It asserts that the decomposition is correct, that s1 and s2 fit into
+// glvNbBits bits and that at least one of them has the most significant bit
+// set.
+func (c *Curve[B, S]) decomposeScalar(s *emulated.Element[S]) (s1Bits, s2Bits []frontend.Variable) {
+	sd, err := c.scalarApi.NewHint(decomposeScalarG1, 2, s, c.eigenvalue)
+	if err != nil {
+		panic(fmt.Sprintf("compute GLV decomposition: %v", err))
+	}
+	s1, s2 := sd[0], sd[1]
+	// s1 + λ * s2 == s mod r
+	c.scalarApi.AssertIsEqual(
+		c.scalarApi.Add(s1, c.scalarApi.Mul(s2, c.eigenvalue)),
+		s,
+	)
+	n := c.glvNbBits
+	s1Bits = c.scalarApi.ToBits(s1)
+	s2Bits = c.scalarApi.ToBits(s2)
+	for i := n; i < len(s1Bits); i++ {
+		c.api.AssertIsEqual(s1Bits[i], 0)
+	}
+	for i := n; i < len(s2Bits); i++ {
+		c.api.AssertIsEqual(s2Bits[i], 0)
+	}
+	c.api.AssertIsEqual(c.api.Or(s1Bits[n-1], s2Bits[n-1]), 1)
+	return s1Bits[:n], s2Bits[:n]
+}
+
+// scalarMulGLV computes s * p using the GLV decomposition s = s1 + λ*s2 and
+// returns it. It doesn't modify p nor s.
+//
+// ✅ p can be (0,0) and s can be 0.
+// (0,0) is not on the curve but we conventionally take it as the
+// neutral/infinity point as per the [EVM].
+//
+// It computes s1*p + s2*φ(p) with the joint signed-digit double-and-add
+// algorithm, where a bit 1 stands for the digit +1 and a bit 0 for the digit
+// -1. It starts with the accumulator p+φ(p) and, as the digits sum up to
+// 2^n-1, subtracts p (resp. φ(p)) at the end when the least significant bit of
+// s1 (resp. s2) is 0.
+//
+// ⚠️  The formulas are incomplete. The circuit is not satisfiable when an
+// intermediate accumulator equals ± the added point, which happens for a
+// negligible but non-empty set of scalars. For example, on BN254 the scalars
+// -λ and -λ-1 are exceptional.
+//
+// [EVM]: https://ethereum.github.io/yellowpaper/paper.pdf
+func (c *Curve[B, S]) scalarMulGLV(p *AffinePoint[B], s *emulated.Element[S]) *AffinePoint[B] {
+	// if p=(0,0) we assign a dummy generator to p and continue
+	selector0 := c.api.And(c.baseApi.IsZero(&p.X), c.baseApi.IsZero(&p.Y))
+	p = c.Select(selector0, &c.g, p)
+	// if s=0 we assign a dummy 1 to s and continue
+	selector1 := c.scalarApi.IsZero(s)
+	s = c.scalarApi.Select(selector1, c.scalarApi.One(), s)
+
+	s1Bits, s2Bits := c.decomposeScalar(s)
+	n := c.glvNbBits
+
+	// precompute -p, -φ(p), φ(p) and the table
+	//   T = [-p-φ(p), p-φ(p), -p+φ(p), p+φ(p)]
+	phip := c.phi(p)
+	negp := c.Neg(p)
+	negphip := c.Neg(phip)
+	var table [4]*AffinePoint[B]
+	table[3] = c.add(p, phip)
+	table[1] = c.add(p, negphip)
+	table[0] = c.Neg(table[3])
+	table[2] = c.Neg(table[1])
+
+	// i = n-1
+	// we add ±p and ±φ(p) separately as the accumulator equals table[3].
+	acc := c.doubleAndAdd(table[3], c.Select(s1Bits[n-1], p, negp))
+	acc = c.add(acc, c.Select(s2Bits[n-1], phip, negphip))
+
+	for i := n - 2; i > 0; i-- {
+		acc = c.doubleAndAdd(acc, c.Lookup2(s1Bits[i], s2Bits[i], table[0], table[1], table[2], table[3]))
+	}
+
+	// i = 0
+	// we use AddUnified here instead of add so that small scalars (e.g. s=1)
+	// are not exceptional.
+	acc = c.Select(s1Bits[0], acc, c.AddUnified(acc, negp))
+	acc = c.Select(s2Bits[0], acc, c.AddUnified(acc, negphip))
+
+	// if p=(0,0) or s=0, return (0,0)
+	zero := c.baseApi.Zero()
+	return c.Select(c.api.Or(selector0, selector1), &AffinePoint[B]{X: *zero, Y: *zero}, acc)
+}
+
 // ScalarMulBase computes s * g and returns it, where g is the fixed generator.
 // It doesn't modify s.
 //
@@ -524,6 +840,10 @@ func (c *Curve[B, S]) ScalarMul(p *AffinePoint[B], s *emulated.Element[S], opts
 // positions 1 and 2 are handled outside of the loop to optimize the number of
 // constraints using a Lookup2 with pre-computed [3]g, [5]g and [7]g points.
 //
+// If the option [algopts.WithCompleteArithmetic] is given, then the additions
+// use complete formulas (see [Curve.addComplete]), so that the result is
+// correct also for the scalars whose partial sums equal ±[2^i]g.
+//
 // [HMV04]: https://link.springer.com/book/10.1007/b97644
 // [EVM]: https://ethereum.github.io/yellowpaper/paper.pdf
 func (c *Curve[B, S]) ScalarMulBase(s *emulated.Element[S], opts ...algopts.AlgebraOption) *AffinePoint[B] {
@@ -546,14 +866,18 @@ func (c *Curve[B, S]) ScalarMulBase(s *emulated.Element[S], opts ...algopts.Alge
 	// gm[0] = 3g, gm[1] = 5g, gm[2] = 7g
 	res := c.Lookup2(sBits[1], sBits[2], g, &gm[0], &gm[1], &gm[2])
 
+	add, addLast := c.add, c.AddUnified
+	if cfg.CompleteArithmetic {
+		add, addLast = c.addComplete, c.addComplete
+	}
 	for i := 3; i < n; i++ {
 		// gm[i] = [2^i]g
-		tmp := c.add(res, &gm[i])
+		tmp := add(res, &gm[i])
 		res = c.Select(sBits[i], tmp, res)
 	}
 
 	// i = 0
-	tmp := c.AddUnified(res, c.Neg(g))
+	tmp := addLast(res, c.Neg(g))
 	res = c.Select(sBits[0], res, tmp)
 
 	return res
@@ -580,7 +904,22 @@ func (c *Curve[B, S]) ScalarMulBase(s *emulated.Element[S], opts ...algopts.Alge
 //
 // This saves the Select logic related to (0,0) and the use of AddUnified to
 // handle the 0-scalar edge case.
-func (c *Curve[B, S]) JointScalarMulBase(p *AffinePoint[B], s2, s1 *emulated.Element[S]) *AffinePoint[B] {
+//
+// If the option [algopts.WithGLV] is given and the curve has an efficient
+// endomorphism (see [CurveParams]), then it computes s1 * g + s2 * p with the
+// GLV algorithm of [Curve.JointScalarMul], which roughly halves the number of
+// constraints. In this case additionally:
+//
+// ⚠️   p must NOT be a small multiple of g, in particular p must not be ±g or
+// ±φ(g). This holds for public keys with a random secret key.
+func (c *Curve[B, S]) JointScalarMulBase(p *AffinePoint[B], s2, s1 *emulated.Element[S], opts ...algopts.AlgebraOption) *AffinePoint[B] {
+	cfg, err := algopts.NewConfig(opts...)
+	if err != nil {
+		panic(fmt.Sprintf("parse opts: %v", err))
+	}
+	if cfg.UseGLV && c.eigenvalue != nil {
+		return c.jointScalarMulGLV(&c.g, p, s1, s2)
+	}
 	g := c.Generator()
 	gm := c.GeneratorMultiples()
 
@@ -644,12 +983,124 @@ func (c *Curve[B, S]) JointScalarMulBase(p *AffinePoint[B], s2, s1 *emulated.Ele
 	return c.add(res1, R0)
 }
 
+// JointScalarMul computes s * p + t * q and returns it. It doesn't modify the
+// inputs.
+//
+// It computes both scalar multiplications separately with [Curve.ScalarMul]
+// and adds the results with [Curve.AddUnified], so the same considerations
+// apply for the inputs. If the option [algopts.WithCompleteArithmetic] is
+// given, then the scalar multiplications and the addition use complete
+// formulas and all inputs are handled.
+//
+// Otherwise, if the option [algopts.WithGLV] is given and the curve has an efficient
+// endomorphism (see [CurveParams]), then it decomposes both scalars with GLV
+// and computes the four scalar multiplications with a shared double-and-add
+// loop and a 16-entry table of the sums ±p±φ(p)±q±φ(q), see
+// [Curve.scalarMulGLV] for the details of the signed-digit algorithm. In this
+// case:
+//
+// ⚠️   p and q must NOT be (0,0).
+// ⚠️   s and t must NOT be 0.
+// ⚠️   p and q must be independent, i.e. the prover must not know a small
+// relation between them. In particular p must not be ±q or ±φ(q), otherwise
+// the table can not be computed.
+func (c *Curve[B, S]) JointScalarMul(p, q *AffinePoint[B], s, t *emulated.Element[S], opts ...algopts.AlgebraOption) *AffinePoint[B] {
+	cfg, err := algopts.NewConfig(opts...)
+	if err != nil {
+		panic(fmt.Sprintf("parse opts: %v", err))
+	}
+	if cfg.CompleteArithmetic {
+		return c.addComplete(c.ScalarMul(p, s, opts...), c.ScalarMul(q, t, opts...))
+	}
+	if cfg.UseGLV && c.eigenvalue != nil {
+		return c.jointScalarMulGLV(p, q, s, t)
+	}
+	return c.AddUnified(c.ScalarMul(p, s, opts...), c.ScalarMul(q, t, opts...))
+}
+
+// jointScalarMulGLV computes s * p + t * q using the GLV decomposition of s
+// and t and returns it. The same restrictions as for [Curve.JointScalarMul]
+// apply.
+func (c *Curve[B, S]) jointScalarMulGLV(p, q *AffinePoint[B], s, t *emulated.Element[S]) *AffinePoint[B] {
+	s1Bits, s2Bits := c.decomposeScalar(s)
+	t1Bits, t2Bits := c.decomposeScalar(t)
+	n := c.glvNbBits
+
+	phip := c.phi(p)
+	phiq := c.phi(q)
+	negp := c.Neg(p)
+	negphip := c.Neg(phip)
+	negq := c.Neg(q)
+	negphiq := c.Neg(phiq)
+
+	// precompute the table T[b0+2b1+4b2+8b3] = ±p±φ(p)±q±φ(q), where the sign
+	// is + if the corresponding bit is 1 and - otherwise. As the entries with
+	// complementary indices are opposite, we only compute the second half.
+	var tableP [4]*AffinePoint[B]
+	tableP[3] = c.add(p, phip)
+	tableP[1] = c.add(p, negphip)
+	tableP[0] = c.Neg(tableP[3])
+	tableP[2] = c.Neg(tableP[1])
+	tableQ := [2]*AffinePoint[B]{c.add(negq, phiq), c.add(q, phiq)}
+	var table [16]*AffinePoint[B]
+	for i := 8; i < 16; i++ {
+		table[i] = c.add(tableP[i&3], tableQ[(i>>2)&1])
+		table[15-i] = c.Neg(table[i])
+	}
+	tableX := make([]*emulated.Element[B], len(table))
+	tableY := make([]*emulated.Element[B], len(table))
+	for i := range table {
+		tableX[i] = &table[i].X
+		tableY[i] = &table[i].Y
+	}
+
+	// i = n-1
+	// we add ±p, ±φ(p), ±q and ±φ(q) separately as the accumulator equals
+	// table[15].
+	acc := c.doubleAndAdd(table[15], c.Select(s1Bits[n-1], p, negp))
+	acc = c.add(acc, c.Select(s2Bits[n-1], phip, negphip))
+	acc = c.add(acc, c.Select(t1Bits[n-1], q, negq))
+	acc = c.add(acc, c.Select(t2Bits[n-1], phiq, negphiq))
+
+	for i := n - 2; i > 0; i-- {
+		sel := c.api.Add(
+			s1Bits[i],
+			c.api.Mul(s2Bits[i], 2),
+			c.api.Mul(t1Bits[i], 4),
+			c.api.Mul(t2Bits[i], 8),
+		)
+		acc = c.doubleAndAdd(acc, &AffinePoint[B]{
+			X: *c.baseApi.Mux(sel, tableX...),
+			Y: *c.baseApi.Mux(sel, tableY...),
+		})
+	}
+
+	// i = 0
+	acc = c.Select(s1Bits[0], acc, c.add(acc, negp))
+	acc = c.Select(s2Bits[0], acc, c.add(acc, negphip))
+	acc = c.Select(t1Bits[0], acc, c.add(acc, negq))
+	acc = c.Select(t2Bits[0], acc, c.add(acc, negphiq))
+
+	return acc
+}
+
 // MultiScalarMul computes the multi scalar multiplication of the points P and
 // scalars s. It returns an error if the length of the slices mismatch. If the
 // input slices are empty, then returns point at infinity.
 //
 // For the points and scalars the same considerations apply as for
 // [Curve.AddUnified] and [Curve.SalarMul].
+//
+// If the option [algopts.WithWindowedMultiScalarMul] is given and the scalars
+// are neither folded nor bounded with the options, for several points it uses
+// the windowed joint-table algorithm, where the tables of the multiples of the
+// points are stored in log-derivative lookup tables and the doublings are
+// shared between all the points. For points known at compile time, see
+// [Curve.MultiScalarMulFixedBase].
+//
+// If the option [algopts.WithCompleteArithmetic] is given, then the scalar
+// multiplications and the accumulation use complete formulas and the windowed
+// algorithm is not used.
 func (c *Curve[B, S]) MultiScalarMul(p []*AffinePoint[B], s []*emulated.Element[S], opts ...algopts.AlgebraOption) (*AffinePoint[B], error) {
 
 	if len(p) == 0 {
@@ -667,6 +1118,17 @@ func (c *Curve[B, S]) MultiScalarMul(p []*AffinePoint[B], s []*emulated.Element[
 		if len(p) != len(s) {
 			return nil, fmt.Errorf("mismatching points and scalars slice lengths")
 		}
+		if cfg.WindowedMSM && !cfg.CompleteArithmetic && len(p) > 1 && cfg.NbScalarBits == 0 {
+			return c.multiScalarMulWindowed(p, s), nil
+		}
+		if cfg.CompleteArithmetic {
+			res := c.ScalarMul(p[0], s[0], opts...)
+			for i := 1; i < len(p); i++ {
+				q := c.ScalarMul(p[i], s[i], opts...)
+				res = c.addComplete(res, q)
+			}
+			return res, nil
+		}
 		res := c.ScalarMul(p[0], s[0])
 		for i := 1; i < len(p); i++ {
 			q := c.ScalarMul(p[i], s[i], opts...)
@@ -678,13 +1140,17 @@ func (c *Curve[B, S]) MultiScalarMul(p []*AffinePoint[B], s []*emulated.Element[
 		if len(s) == 0 {
 			return nil, fmt.Errorf("need scalar for folding")
 		}
+		addFn := c.Add
+		if cfg.CompleteArithmetic {
+			addFn = c.addComplete
+		}
 		gamma := s[0]
 		res := c.ScalarMul(p[len(p)-1], gamma, opts...)
 		for i := len(p) - 2; i > 0; i-- {
-			res = c.Add(p[i], res)
+			res = addFn(p[i], res)
 			res = c.ScalarMul(res, gamma, opts...)
 		}
-		res = c.Add(p[0], res)
+		res = addFn(p[0], res)
 		return res, nil
 	}
 }
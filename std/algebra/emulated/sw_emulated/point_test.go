@@ -1,6 +1,7 @@
 package sw_emulated
 
 import (
+	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
 	"math/big"
@@ -18,6 +19,8 @@ import (
 	fp_secp "github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
 	fr_secp "github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
 	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/frontend/cs/scs"
 	"github.com/consensys/gnark/std/algebra/algopts"
 	"github.com/consensys/gnark/std/math/emulated"
 	"github.com/consensys/gnark/std/math/emulated/emparams"
@@ -121,6 +124,70 @@ func TestMarshalG1(t *testing.T) {
 	})
 }
 
+type UnmarshalSEC1CompressedTest[T, S emulated.FieldParams] struct {
+	R []frontend.Variable
+	G AffinePoint[T]
+}
+
+func (c *UnmarshalSEC1CompressedTest[T, S]) Define(api frontend.API) error {
+	cr, err := New[T, S](api, GetCurveParams[T]())
+	if err != nil {
+		return err
+	}
+	res, err := cr.UnmarshalSEC1Compressed(c.R)
+	if err != nil {
+		return err
+	}
+	cr.AssertIsEqual(res, &c.G)
+	return nil
+}
+
+func TestUnmarshalSEC1Compressed(t *testing.T) {
+	assert := test.NewAssert(t)
+	testFn := func(encode func(b []byte) []byte) error {
+		priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		assert.NoError(err)
+		gBytes := encode(elliptic.MarshalCompressed(elliptic.P256(), priv.X, priv.Y))
+		nbBits := 8 * len(gBytes)
+		circuit := &UnmarshalSEC1CompressedTest[emulated.P256Fp, emulated.P256Fr]{
+			R: make([]frontend.Variable, nbBits),
+		}
+		witness := &UnmarshalSEC1CompressedTest[emulated.P256Fp, emulated.P256Fr]{
+			R: make([]frontend.Variable, nbBits),
+			G: AffinePoint[emulated.P256Fp]{
+				X: emulated.ValueOf[emulated.P256Fp](priv.X),
+				Y: emulated.ValueOf[emulated.P256Fp](priv.Y),
+			},
+		}
+		for i := range gBytes {
+			for j := 0; j < 8; j++ {
+				witness.R[i*8+j] = (gBytes[i] >> (7 - j)) & 1
+			}
+		}
+		return test.IsSolved(circuit, witness, testCurve.ScalarField())
+	}
+	assert.Run(func(assert *test.Assert) {
+		err := testFn(func(b []byte) []byte { return b })
+		assert.NoError(err)
+	}, "valid")
+	assert.Run(func(assert *test.Assert) {
+		err := testFn(func(b []byte) []byte {
+			// flip the parity of Y
+			b[0] ^= 1
+			return b
+		})
+		assert.Error(err)
+	}, "wrong parity")
+	assert.Run(func(assert *test.Assert) {
+		err := testFn(func(b []byte) []byte {
+			// uncompressed prefix
+			b[0] = 0x04
+			return b
+		})
+		assert.Error(err)
+	}, "invalid prefix")
+}
+
 type NegTest[T, S emulated.FieldParams] struct {
 	P, Q AffinePoint[T]
 }
@@ -438,9 +505,70 @@ func TestAddUnifiedEdgeCases(t *testing.T) {
 	assert.NoError(err)
 }
 
+type AddCompleteTest[T, S emulated.FieldParams] struct {
+	P, Q, R AffinePoint[T]
+}
+
+func (c *AddCompleteTest[T, S]) Define(api frontend.API) error {
+	cr, err := New[T, S](api, GetCurveParams[T]())
+	if err != nil {
+		return err
+	}
+	res := cr.addComplete(&c.P, &c.Q)
+	cr.AssertIsEqual(res, &c.R)
+	res = cr.doubleComplete(&c.P)
+	cr.AssertIsEqual(res, cr.addComplete(&c.P, &c.P))
+	return nil
+}
+
+func TestAddComplete(t *testing.T) {
+	assert := test.NewAssert(t)
+	var infinity bn254.G1Affine
+	_, _, g, _ := bn254.Generators()
+	var r1, r2 fr_bn.Element
+	_, _ = r1.SetRandom()
+	_, _ = r2.SetRandom()
+	var S, T, Sn, S2, ST bn254.G1Affine
+	S.ScalarMultiplication(&g, r1.BigInt(new(big.Int)))
+	T.ScalarMultiplication(&g, r2.BigInt(new(big.Int)))
+	Sn.Neg(&S)
+	S2.Double(&S)
+	ST.Add(&S, &T)
+
+	for _, tc := range []struct {
+		name    string
+		p, q, r bn254.G1Affine
+	}{
+		{"S+T", S, T, ST},
+		{"S+S", S, S, S2},
+		{"S+(-S)", S, Sn, infinity},
+		{"S+(0,0)", S, infinity, S},
+		{"(0,0)+S", infinity, S, S},
+		{"(0,0)+(0,0)", infinity, infinity, infinity},
+	} {
+		witness := AddCompleteTest[emulated.BN254Fp, emulated.BN254Fr]{
+			P: AffinePoint[emulated.BN254Fp]{
+				X: emulated.ValueOf[emulated.BN254Fp](tc.p.X),
+				Y: emulated.ValueOf[emulated.BN254Fp](tc.p.Y),
+			},
+			Q: AffinePoint[emulated.BN254Fp]{
+				X: emulated.ValueOf[emulated.BN254Fp](tc.q.X),
+				Y: emulated.ValueOf[emulated.BN254Fp](tc.q.Y),
+			},
+			R: AffinePoint[emulated.BN254Fp]{
+				X: emulated.ValueOf[emulated.BN254Fp](tc.r.X),
+				Y: emulated.ValueOf[emulated.BN254Fp](tc.r.Y),
+			},
+		}
+		err := test.IsSolved(&AddCompleteTest[emulated.BN254Fp, emulated.BN254Fr]{}, &witness, testCurve.ScalarField())
+		assert.NoError(err, tc.name)
+	}
+}
+
 type ScalarMulBaseTest[T, S emulated.FieldParams] struct {
-	Q AffinePoint[T]
-	S emulated.Element[S]
+	Q        AffinePoint[T]
+	S        emulated.Element[S]
+	complete bool
 }
 
 func (c *ScalarMulBaseTest[T, S]) Define(api frontend.API) error {
@@ -448,7 +576,11 @@ func (c *ScalarMulBaseTest[T, S]) Define(api frontend.API) error {
 	if err != nil {
 		return err
 	}
-	res := cr.ScalarMulBase(&c.S)
+	var opts []algopts.AlgebraOption
+	if c.complete {
+		opts = append(opts, algopts.WithCompleteArithmetic())
+	}
+	res := cr.ScalarMulBase(&c.S, opts...)
 	cr.AssertIsEqual(res, &c.Q)
 	return nil
 }
@@ -542,8 +674,9 @@ func TestScalarMulBase4(t *testing.T) {
 }
 
 type ScalarMulTest[T, S emulated.FieldParams] struct {
-	P, Q AffinePoint[T]
-	S    emulated.Element[S]
+	P, Q     AffinePoint[T]
+	S        emulated.Element[S]
+	complete bool
 }
 
 func (c *ScalarMulTest[T, S]) Define(api frontend.API) error {
@@ -551,7 +684,11 @@ func (c *ScalarMulTest[T, S]) Define(api frontend.API) error {
 	if err != nil {
 		return err
 	}
-	res := cr.ScalarMul(&c.P, &c.S)
+	var opts []algopts.AlgebraOption
+	if c.complete {
+		opts = append(opts, algopts.WithCompleteArithmetic())
+	}
+	res := cr.ScalarMul(&c.P, &c.S, opts...)
 	cr.AssertIsEqual(res, &c.Q)
 	return nil
 }
@@ -706,6 +843,172 @@ func TestScalarMul6(t *testing.T) {
 	assert.NoError(err)
 }
 
+type ScalarMulGLVTest[T, S emulated.FieldParams] struct {
+	P, Q AffinePoint[T]
+	S    emulated.Element[S]
+}
+
+func (c *ScalarMulGLVTest[T, S]) Define(api frontend.API) error {
+	cr, err := New[T, S](api, GetCurveParams[T]())
+	if err != nil {
+		return err
+	}
+	res := cr.ScalarMul(&c.P, &c.S, algopts.WithGLV())
+	cr.AssertIsEqual(res, &c.Q)
+	return nil
+}
+
+func TestScalarMulGLV(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, _, gen, _ := bn254.Generators()
+	var p bn254.G1Affine
+	p.Double(&gen)
+	var r fr_bn.Element
+	_, _ = r.SetRandom()
+	circuit := ScalarMulGLVTest[emulated.BN254Fp, emulated.BN254Fr]{}
+	for _, s := range []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(12345), r.BigInt(new(big.Int))} {
+		var res bn254.G1Affine
+		res.ScalarMultiplication(&p, s)
+		witness := ScalarMulGLVTest[emulated.BN254Fp, emulated.BN254Fr]{
+			S: emulated.ValueOf[emulated.BN254Fr](s),
+			P: AffinePoint[emulated.BN254Fp]{
+				X: emulated.ValueOf[emulated.BN254Fp](p.X),
+				Y: emulated.ValueOf[emulated.BN254Fp](p.Y),
+			},
+			Q: AffinePoint[emulated.BN254Fp]{
+				X: emulated.ValueOf[emulated.BN254Fp](res.X),
+				Y: emulated.ValueOf[emulated.BN254Fp](res.Y),
+			},
+		}
+		err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+		assert.NoError(err, s)
+	}
+}
+
+func TestScalarMulGLVExceptionalScalars(t *testing.T) {
+	// the scalars -λ and -λ-1 are exceptional for the GLV scalar
+	// multiplication on BN254, but not for the default one.
+	assert := test.NewAssert(t)
+	_, _, gen, _ := bn254.Generators()
+	r := emulated.BN254Fr{}.Modulus()
+	negLambda := new(big.Int).Sub(r, GetBN254Params().Eigenvalue)
+	for _, s := range []*big.Int{negLambda, new(big.Int).Sub(negLambda, big.NewInt(1))} {
+		var res bn254.G1Affine
+		res.ScalarMultiplication(&gen, s)
+		witness := ScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{
+			S: emulated.ValueOf[emulated.BN254Fr](s),
+			P: AffinePoint[emulated.BN254Fp]{
+				X: emulated.ValueOf[emulated.BN254Fp](gen.X),
+				Y: emulated.ValueOf[emulated.BN254Fp](gen.Y),
+			},
+			Q: AffinePoint[emulated.BN254Fp]{
+				X: emulated.ValueOf[emulated.BN254Fp](res.X),
+				Y: emulated.ValueOf[emulated.BN254Fp](res.Y),
+			},
+		}
+		err := test.IsSolved(&ScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{}, &witness, testCurve.ScalarField())
+		assert.NoError(err, s)
+	}
+}
+
+func TestScalarMulComplete(t *testing.T) {
+	assert := test.NewAssert(t)
+	var infinity bn254.G1Affine
+	_, _, g, _ := bn254.Generators()
+	var p bn254.G1Affine
+	p.Double(&g)
+	var r fr_bn.Element
+	_, _ = r.SetRandom()
+	mod := emulated.BN254Fr{}.Modulus()
+	negLambda := new(big.Int).Sub(mod, GetBN254Params().Eigenvalue)
+	scalars := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(2),
+		new(big.Int).Sub(mod, big.NewInt(1)),
+		new(big.Int).Sub(mod, big.NewInt(2)),
+		negLambda,
+		new(big.Int).Sub(negLambda, big.NewInt(1)),
+		r.BigInt(new(big.Int)),
+	}
+	circuit := ScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{complete: true}
+	for _, P := range []bn254.G1Affine{p, infinity} {
+		for _, s := range scalars {
+			var res bn254.G1Affine
+			res.ScalarMultiplication(&P, s)
+			witness := ScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{
+				S: emulated.ValueOf[emulated.BN254Fr](s),
+				P: AffinePoint[emulated.BN254Fp]{
+					X: emulated.ValueOf[emulated.BN254Fp](P.X),
+					Y: emulated.ValueOf[emulated.BN254Fp](P.Y),
+				},
+				Q: AffinePoint[emulated.BN254Fp]{
+					X: emulated.ValueOf[emulated.BN254Fp](res.X),
+					Y: emulated.ValueOf[emulated.BN254Fp](res.Y),
+				},
+			}
+			err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+			assert.NoError(err, s)
+		}
+	}
+}
+
+func TestScalarMulBaseComplete(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, _, g, _ := bn254.Generators()
+	var r fr_bn.Element
+	_, _ = r.SetRandom()
+	mod := emulated.BN254Fr{}.Modulus()
+	circuit := ScalarMulBaseTest[emulated.BN254Fp, emulated.BN254Fr]{complete: true}
+	for _, s := range []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		new(big.Int).Sub(mod, big.NewInt(1)),
+		new(big.Int).Sub(mod, big.NewInt(2)),
+		r.BigInt(new(big.Int)),
+	} {
+		var res bn254.G1Affine
+		res.ScalarMultiplication(&g, s)
+		witness := ScalarMulBaseTest[emulated.BN254Fp, emulated.BN254Fr]{
+			S: emulated.ValueOf[emulated.BN254Fr](s),
+			Q: AffinePoint[emulated.BN254Fp]{
+				X: emulated.ValueOf[emulated.BN254Fp](res.X),
+				Y: emulated.ValueOf[emulated.BN254Fp](res.Y),
+			},
+		}
+		err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+		assert.NoError(err, s)
+	}
+}
+
+func TestGLVDecompose(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, params := range []struct {
+		r, eigenvalue *big.Int
+	}{
+		{emulated.Secp256k1Fr{}.Modulus(), GetSecp256k1Params().Eigenvalue},
+		{emulated.BN254Fr{}.Modulus(), GetBN254Params().Eigenvalue},
+	} {
+		_, _, nbBits := glvParams(params.r, params.eigenvalue)
+		half := new(big.Int).Lsh(big.NewInt(1), uint(nbBits-1))
+		for i := 0; i < 100; i++ {
+			s, err := rand.Int(rand.Reader, params.r)
+			assert.NoError(err)
+			if i == 0 {
+				s.SetUint64(0)
+			}
+			s1, s2, err := glvDecompose(params.r, s, params.eigenvalue)
+			assert.NoError(err)
+			assert.True(s1.Sign() >= 0 && s2.Sign() >= 0)
+			assert.True(s1.BitLen() <= nbBits && s2.BitLen() <= nbBits)
+			assert.True(s1.Cmp(half) >= 0 || s2.Cmp(half) >= 0)
+			v := new(big.Int).Mul(s2, params.eigenvalue)
+			v.Add(v, s1).Sub(v, s).Mod(v, params.r)
+			assert.Equal(0, v.Sign())
+		}
+	}
+}
+
 type ScalarMulEdgeCasesTest[T, S emulated.FieldParams] struct {
 	P, R AffinePoint[T]
 	S    emulated.Element[S]
@@ -877,6 +1180,7 @@ func TestIsOnCurve3(t *testing.T) {
 type JointScalarMulBaseTest[T, S emulated.FieldParams] struct {
 	P, Q   AffinePoint[T]
 	S1, S2 emulated.Element[S]
+	glv    bool
 }
 
 func (c *JointScalarMulBaseTest[T, S]) Define(api frontend.API) error {
@@ -884,7 +1188,11 @@ func (c *JointScalarMulBaseTest[T, S]) Define(api frontend.API) error {
 	if err != nil {
 		return err
 	}
-	res := cr.JointScalarMulBase(&c.P, &c.S2, &c.S1)
+	var opts []algopts.AlgebraOption
+	if c.glv {
+		opts = append(opts, algopts.WithGLV())
+	}
+	res := cr.JointScalarMulBase(&c.P, &c.S2, &c.S1, opts...)
 	cr.AssertIsEqual(res, &c.Q)
 	return nil
 }
@@ -919,14 +1227,256 @@ func TestJointScalarMulBase(t *testing.T) {
 			Y: emulated.ValueOf[emulated.Secp256k1Fp](S.Y),
 		},
 	}
+	for _, glv := range []bool{false, true} {
+		circuit.glv = glv
+		err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+		assert.NoError(err, glv)
+	}
+}
+
+func TestJointScalarMulBaseGenerator(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, g := secp256k1.Generators()
+	var r1, r2 fr_secp.Element
+	_, _ = r1.SetRandom()
+	_, _ = r2.SetRandom()
+	s1 := new(big.Int)
+	r1.BigInt(s1)
+	s2 := new(big.Int)
+	r2.BigInt(s2)
+	var S secp256k1.G1Affine
+	S.ScalarMultiplication(&g, new(big.Int).Add(s1, s2))
+
+	circuit := JointScalarMulBaseTest[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{}
+	witness := JointScalarMulBaseTest[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{
+		S1: emulated.ValueOf[emulated.Secp256k1Fr](s1),
+		S2: emulated.ValueOf[emulated.Secp256k1Fr](s2),
+		P: AffinePoint[emulated.Secp256k1Fp]{
+			X: emulated.ValueOf[emulated.Secp256k1Fp](g.X),
+			Y: emulated.ValueOf[emulated.Secp256k1Fp](g.Y),
+		},
+		Q: AffinePoint[emulated.Secp256k1Fp]{
+			X: emulated.ValueOf[emulated.Secp256k1Fp](S.X),
+			Y: emulated.ValueOf[emulated.Secp256k1Fp](S.Y),
+		},
+	}
+	err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+	assert.NoError(err)
+	// p = g is an exceptional input of the GLV variant.
+	circuit.glv = true
+	err = test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+	assert.Error(err)
+}
+
+func TestJointScalarMulBaseGLVConstraints(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, builder := range []frontend.NewBuilder{r1cs.NewBuilder, scs.NewBuilder} {
+		var nbConstraints [2]int
+		for i, glv := range []bool{false, true} {
+			ccs, err := frontend.Compile(testCurve.ScalarField(), builder, &JointScalarMulBaseTest[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{glv: glv})
+			assert.NoError(err)
+			nbConstraints[i] = ccs.GetNbConstraints()
+		}
+		assert.Less(nbConstraints[1], nbConstraints[0], "secp256k1")
+		for i, glv := range []bool{false, true} {
+			ccs, err := frontend.Compile(testCurve.ScalarField(), builder, &JointScalarMulBaseTest[emulated.BN254Fp, emulated.BN254Fr]{glv: glv})
+			assert.NoError(err)
+			nbConstraints[i] = ccs.GetNbConstraints()
+		}
+		assert.Less(nbConstraints[1], nbConstraints[0], "BN254")
+	}
+}
+
+type JointScalarMulTest[T, S emulated.FieldParams] struct {
+	P, Q, R  AffinePoint[T]
+	S, T     emulated.Element[S]
+	glv      bool
+	complete bool
+}
+
+func (c *JointScalarMulTest[T, S]) Define(api frontend.API) error {
+	cr, err := New[T, S](api, GetCurveParams[T]())
+	if err != nil {
+		return err
+	}
+	var opts []algopts.AlgebraOption
+	if c.glv {
+		opts = append(opts, algopts.WithGLV())
+	}
+	if c.complete {
+		opts = append(opts, algopts.WithCompleteArithmetic())
+	}
+	res := cr.JointScalarMul(&c.P, &c.Q, &c.S, &c.T, opts...)
+	cr.AssertIsEqual(res, &c.R)
+	return nil
+}
+
+func TestJointScalarMul(t *testing.T) {
+	assert := test.NewAssert(t)
+	var r1, r2, r3 fr_secp.Element
+	_, _ = r1.SetRandom()
+	_, _ = r2.SetRandom()
+	_, _ = r3.SetRandom()
+	s := r1.BigInt(new(big.Int))
+	u := r2.BigInt(new(big.Int))
+	var p, q, sp, uq, res secp256k1.G1Affine
+	p.ScalarMultiplicationBase(r3.BigInt(new(big.Int)))
+	q.ScalarMultiplicationBase(big.NewInt(5))
+	sp.ScalarMultiplication(&p, s)
+	uq.ScalarMultiplication(&q, u)
+	res.Add(&sp, &uq)
+
+	circuit := JointScalarMulTest[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{}
+	witness := JointScalarMulTest[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{
+		S: emulated.ValueOf[emulated.Secp256k1Fr](s),
+		T: emulated.ValueOf[emulated.Secp256k1Fr](u),
+		P: AffinePoint[emulated.Secp256k1Fp]{
+			X: emulated.ValueOf[emulated.Secp256k1Fp](p.X),
+			Y: emulated.ValueOf[emulated.Secp256k1Fp](p.Y),
+		},
+		Q: AffinePoint[emulated.Secp256k1Fp]{
+			X: emulated.ValueOf[emulated.Secp256k1Fp](q.X),
+			Y: emulated.ValueOf[emulated.Secp256k1Fp](q.Y),
+		},
+		R: AffinePoint[emulated.Secp256k1Fp]{
+			X: emulated.ValueOf[emulated.Secp256k1Fp](res.X),
+			Y: emulated.ValueOf[emulated.Secp256k1Fp](res.Y),
+		},
+	}
+	for _, glv := range []bool{false, true} {
+		circuit.glv = glv
+		err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+		assert.NoError(err, glv)
+	}
+}
+
+func TestJointScalarMul2(t *testing.T) {
+	assert := test.NewAssert(t)
+	var r1, r2, r3 fr_bn.Element
+	_, _ = r1.SetRandom()
+	_, _ = r2.SetRandom()
+	_, _ = r3.SetRandom()
+	s := r1.BigInt(new(big.Int))
+	u := r2.BigInt(new(big.Int))
+	_, _, gen, _ := bn254.Generators()
+	var p, sp, uq, res bn254.G1Affine
+	p.ScalarMultiplication(&gen, r3.BigInt(new(big.Int)))
+	sp.ScalarMultiplication(&p, s)
+	uq.ScalarMultiplication(&gen, u)
+	res.Add(&sp, &uq)
+
+	circuit := JointScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{}
+	witness := JointScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{
+		S: emulated.ValueOf[emulated.BN254Fr](s),
+		T: emulated.ValueOf[emulated.BN254Fr](u),
+		P: AffinePoint[emulated.BN254Fp]{
+			X: emulated.ValueOf[emulated.BN254Fp](p.X),
+			Y: emulated.ValueOf[emulated.BN254Fp](p.Y),
+		},
+		Q: AffinePoint[emulated.BN254Fp]{
+			X: emulated.ValueOf[emulated.BN254Fp](gen.X),
+			Y: emulated.ValueOf[emulated.BN254Fp](gen.Y),
+		},
+		R: AffinePoint[emulated.BN254Fp]{
+			X: emulated.ValueOf[emulated.BN254Fp](res.X),
+			Y: emulated.ValueOf[emulated.BN254Fp](res.Y),
+		},
+	}
+	for _, glv := range []bool{false, true} {
+		circuit.glv = glv
+		err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+		assert.NoError(err, glv)
+	}
+}
+
+func TestJointScalarMulEqualPoints(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, g := secp256k1.Generators()
+	var r1, r2 fr_secp.Element
+	_, _ = r1.SetRandom()
+	_, _ = r2.SetRandom()
+	s := r1.BigInt(new(big.Int))
+	u := r2.BigInt(new(big.Int))
+	var res secp256k1.G1Affine
+	res.ScalarMultiplication(&g, new(big.Int).Add(s, u))
+
+	circuit := JointScalarMulTest[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{}
+	witness := JointScalarMulTest[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{
+		S: emulated.ValueOf[emulated.Secp256k1Fr](s),
+		T: emulated.ValueOf[emulated.Secp256k1Fr](u),
+		P: AffinePoint[emulated.Secp256k1Fp]{
+			X: emulated.ValueOf[emulated.Secp256k1Fp](g.X),
+			Y: emulated.ValueOf[emulated.Secp256k1Fp](g.Y),
+		},
+		Q: AffinePoint[emulated.Secp256k1Fp]{
+			X: emulated.ValueOf[emulated.Secp256k1Fp](g.X),
+			Y: emulated.ValueOf[emulated.Secp256k1Fp](g.Y),
+		},
+		R: AffinePoint[emulated.Secp256k1Fp]{
+			X: emulated.ValueOf[emulated.Secp256k1Fp](res.X),
+			Y: emulated.ValueOf[emulated.Secp256k1Fp](res.Y),
+		},
+	}
 	err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
 	assert.NoError(err)
 }
 
+func TestJointScalarMulComplete(t *testing.T) {
+	assert := test.NewAssert(t)
+	var infinity bn254.G1Affine
+	_, _, g, _ := bn254.Generators()
+	var r1, r2 fr_bn.Element
+	_, _ = r1.SetRandom()
+	_, _ = r2.SetRandom()
+	s := r1.BigInt(new(big.Int))
+	u := r2.BigInt(new(big.Int))
+	var p, pn bn254.G1Affine
+	p.ScalarMultiplication(&g, u)
+	pn.Neg(&p)
+
+	circuit := JointScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{complete: true}
+	for _, tc := range []struct {
+		name string
+		p, q bn254.G1Affine
+		s, t *big.Int
+	}{
+		{"p=q", p, p, s, u},
+		{"p=-q, s=t", p, pn, s, s},
+		{"p=q, s=-t", p, p, s, new(big.Int).Sub(emulated.BN254Fr{}.Modulus(), s)},
+		{"p=(0,0)", infinity, p, s, u},
+		{"s=0", p, g, big.NewInt(0), u},
+	} {
+		var sp, tq, res bn254.G1Affine
+		sp.ScalarMultiplication(&tc.p, tc.s)
+		tq.ScalarMultiplication(&tc.q, tc.t)
+		res.Add(&sp, &tq)
+		witness := JointScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{
+			S: emulated.ValueOf[emulated.BN254Fr](tc.s),
+			T: emulated.ValueOf[emulated.BN254Fr](tc.t),
+			P: AffinePoint[emulated.BN254Fp]{
+				X: emulated.ValueOf[emulated.BN254Fp](tc.p.X),
+				Y: emulated.ValueOf[emulated.BN254Fp](tc.p.Y),
+			},
+			Q: AffinePoint[emulated.BN254Fp]{
+				X: emulated.ValueOf[emulated.BN254Fp](tc.q.X),
+				Y: emulated.ValueOf[emulated.BN254Fp](tc.q.Y),
+			},
+			R: AffinePoint[emulated.BN254Fp]{
+				X: emulated.ValueOf[emulated.BN254Fp](res.X),
+				Y: emulated.ValueOf[emulated.BN254Fp](res.Y),
+			},
+		}
+		err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+		assert.NoError(err, tc.name)
+	}
+}
+
 type MultiScalarMulTest[T, S emulated.FieldParams] struct {
-	Points  []AffinePoint[T]
-	Scalars []emulated.Element[S]
-	Res     AffinePoint[T]
+	Points   []AffinePoint[T]
+	Scalars  []emulated.Element[S]
+	Res      AffinePoint[T]
+	windowed bool
+	complete bool
 }
 
 func (c *MultiScalarMulTest[T, S]) Define(api frontend.API) error {
@@ -942,7 +1492,14 @@ func (c *MultiScalarMulTest[T, S]) Define(api frontend.API) error {
 	for i := range c.Scalars {
 		ss[i] = &c.Scalars[i]
 	}
-	res, err := cr.MultiScalarMul(ps, ss)
+	var opts []algopts.AlgebraOption
+	if c.windowed {
+		opts = append(opts, algopts.WithWindowedMultiScalarMul())
+	}
+	if c.complete {
+		opts = append(opts, algopts.WithCompleteArithmetic())
+	}
+	res, err := cr.MultiScalarMul(ps, ss, opts...)
 	if err != nil {
 		return err
 	}
@@ -989,6 +1546,171 @@ func TestMultiScalarMul(t *testing.T) {
 	assert.NoError(err)
 }
 
+func TestMultiScalarMulEdgeCases(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, _, g, _ := bn254.Generators()
+	var r fr_bn.Element
+	_, _ = r.SetRandom()
+	var p bn254.G1Affine
+	p.ScalarMultiplication(&g, r.BigInt(new(big.Int)))
+	// the generator, a repeated point, the point at infinity and a zero scalar
+	P := []bn254.G1Affine{g, p, p, {}, p}
+	S := make([]fr_bn.Element, len(P))
+	for i := range S {
+		S[i].SetRandom()
+	}
+	S[4].SetZero()
+	var res bn254.G1Affine
+	_, err := res.MultiExp(P, S, ecc.MultiExpConfig{})
+	assert.NoError(err)
+
+	cP := make([]AffinePoint[emulated.BN254Fp], len(P))
+	cS := make([]emulated.Element[emulated.BN254Fr], len(S))
+	for i := range cP {
+		cP[i] = AffinePoint[emulated.BN254Fp]{
+			X: emulated.ValueOf[emulated.BN254Fp](P[i].X),
+			Y: emulated.ValueOf[emulated.BN254Fp](P[i].Y),
+		}
+		cS[i] = emulated.ValueOf[emulated.BN254Fr](S[i])
+	}
+	circuit := MultiScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{
+		Points:  make([]AffinePoint[emulated.BN254Fp], len(P)),
+		Scalars: make([]emulated.Element[emulated.BN254Fr], len(S)),
+	}
+	assignment := MultiScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{
+		Points:  cP,
+		Scalars: cS,
+		Res: AffinePoint[emulated.BN254Fp]{
+			X: emulated.ValueOf[emulated.BN254Fp](res.X),
+			Y: emulated.ValueOf[emulated.BN254Fp](res.Y),
+		},
+	}
+	for _, windowed := range []bool{false, true} {
+		circuit.windowed = windowed
+		err = test.IsSolved(&circuit, &assignment, testCurve.ScalarField())
+		assert.NoError(err, windowed)
+	}
+
+	// all scalars zero
+	for i := range cS {
+		cS[i] = emulated.ValueOf[emulated.BN254Fr](0)
+	}
+	assignment.Res = AffinePoint[emulated.BN254Fp]{
+		X: emulated.ValueOf[emulated.BN254Fp](0),
+		Y: emulated.ValueOf[emulated.BN254Fp](0),
+	}
+	for _, windowed := range []bool{false, true} {
+		circuit.windowed = windowed
+		err = test.IsSolved(&circuit, &assignment, testCurve.ScalarField())
+		assert.NoError(err, windowed)
+	}
+}
+
+func TestMultiScalarMulComplete(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, _, g, _ := bn254.Generators()
+	var r fr_bn.Element
+	_, _ = r.SetRandom()
+	var p, pn bn254.G1Affine
+	p.ScalarMultiplication(&g, r.BigInt(new(big.Int)))
+	pn.Neg(&p)
+	// the partial sums hit (0,0) and the point at infinity is an input
+	P := []bn254.G1Affine{p, pn, {}, g, p}
+	S := make([]fr_bn.Element, len(P))
+	for i := range S {
+		S[i].SetRandom()
+	}
+	S[1].Set(&S[0])
+	var res bn254.G1Affine
+	_, err := res.MultiExp(P, S, ecc.MultiExpConfig{})
+	assert.NoError(err)
+
+	cP := make([]AffinePoint[emulated.BN254Fp], len(P))
+	cS := make([]emulated.Element[emulated.BN254Fr], len(S))
+	for i := range cP {
+		cP[i] = AffinePoint[emulated.BN254Fp]{
+			X: emulated.ValueOf[emulated.BN254Fp](P[i].X),
+			Y: emulated.ValueOf[emulated.BN254Fp](P[i].Y),
+		}
+		cS[i] = emulated.ValueOf[emulated.BN254Fr](S[i])
+	}
+	circuit := MultiScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{
+		Points:   make([]AffinePoint[emulated.BN254Fp], len(P)),
+		Scalars:  make([]emulated.Element[emulated.BN254Fr], len(S)),
+		complete: true,
+	}
+	assignment := MultiScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{
+		Points:  cP,
+		Scalars: cS,
+		Res: AffinePoint[emulated.BN254Fp]{
+			X: emulated.ValueOf[emulated.BN254Fp](res.X),
+			Y: emulated.ValueOf[emulated.BN254Fp](res.Y),
+		},
+	}
+	err = test.IsSolved(&circuit, &assignment, testCurve.ScalarField())
+	assert.NoError(err)
+}
+
+type MultiScalarMulFixedBaseTest[T, S emulated.FieldParams] struct {
+	Points  [][2]*big.Int `gnark:"-"`
+	Scalars []emulated.Element[S]
+	Res     AffinePoint[T]
+}
+
+func (c *MultiScalarMulFixedBaseTest[T, S]) Define(api frontend.API) error {
+	cr, err := New[T, S](api, GetCurveParams[T]())
+	if err != nil {
+		return err
+	}
+	ss := make([]*emulated.Element[S], len(c.Scalars))
+	for i := range c.Scalars {
+		ss[i] = &c.Scalars[i]
+	}
+	res, err := cr.MultiScalarMulFixedBase(c.Points, ss)
+	if err != nil {
+		return err
+	}
+	cr.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestMultiScalarMulFixedBase(t *testing.T) {
+	assert := test.NewAssert(t)
+	nbLen := 3
+	P := make([]secp256k1.G1Affine, nbLen)
+	S := make([]fr_secp.Element, nbLen)
+	points := make([][2]*big.Int, nbLen)
+	for i := 0; i < nbLen; i++ {
+		var r fr_secp.Element
+		r.SetRandom()
+		P[i].ScalarMultiplicationBase(r.BigInt(new(big.Int)))
+		S[i].SetRandom()
+		points[i] = [2]*big.Int{P[i].X.BigInt(new(big.Int)), P[i].Y.BigInt(new(big.Int))}
+	}
+	S[1].SetZero()
+	var res secp256k1.G1Affine
+	_, err := res.MultiExp(P, S, ecc.MultiExpConfig{})
+	assert.NoError(err)
+
+	cS := make([]emulated.Element[emulated.Secp256k1Fr], len(S))
+	for i := range cS {
+		cS[i] = emulated.ValueOf[emulated.Secp256k1Fr](S[i])
+	}
+	circuit := MultiScalarMulFixedBaseTest[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{
+		Points:  points,
+		Scalars: make([]emulated.Element[emulated.Secp256k1Fr], nbLen),
+	}
+	assignment := MultiScalarMulFixedBaseTest[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{
+		Scalars: cS,
+		Res: AffinePoint[emulated.Secp256k1Fp]{
+			X: emulated.ValueOf[emulated.Secp256k1Fp](res.X),
+			Y: emulated.ValueOf[emulated.Secp256k1Fp](res.Y),
+		},
+	}
+	err = test.IsSolved(&circuit, &assignment, testCurve.ScalarField())
+	assert.NoError(err)
+}
+
 type ScalarMulTestBounded[T, S emulated.FieldParams] struct {
 	P, Q AffinePoint[T]
 	S    emulated.Element[S]
@@ -45,6 +45,34 @@ type Curve[FR emulated.FieldParams, G1El G1ElementT] interface {
 	MarshalScalar(emulated.Element[FR]) []frontend.Variable
 }
 
+// G2Curve defines group operations on the G2 group of a pairing-friendly
+// curve.
+type G2Curve[FR emulated.FieldParams, G2El G2ElementT] interface {
+	// Add adds two points and returns the sum. It does not modify the input
+	// points.
+	Add(*G2El, *G2El) *G2El
+
+	// AssertIsEqual asserts that two points are equal.
+	AssertIsEqual(*G2El, *G2El)
+
+	// Neg negates the points and returns a negated point. It does not modify
+	// the input.
+	Neg(*G2El) *G2El
+
+	// ScalarMul returns the scalar multiplication of the point by a scalar. It
+	// does not modify the inputs.
+	ScalarMul(*G2El, *emulated.Element[FR], ...algopts.AlgebraOption) *G2El
+
+	// MultiScalarMul computes the sum ∑ s_i Q_i for the input
+	// scalars s_i and points Q_i. It returns an error if the input lengths
+	// mismatch.
+	MultiScalarMul([]*G2El, []*emulated.Element[FR], ...algopts.AlgebraOption) (*G2El, error)
+
+	// AssertIsOnG2 asserts that the point is on the curve and in the prime
+	// order subgroup.
+	AssertIsOnG2(*G2El)
+}
+
 // Pairing allows to compute the bi-linear pairing of G1 and G2 elements.
 // Additionally, the interface provides steps used in pairing computation and a
 // dedicated optimised pairing check.
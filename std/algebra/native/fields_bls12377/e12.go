@@ -623,6 +623,16 @@ func (e *E12) Select(api frontend.API, b frontend.Variable, r1, r2 E12) *E12 {
 	return e
 }
 
+// Lookup2 sets e to r1 if b1=0 and b2=0, r2 if b1=1 and b2=0, r3 if b1=0 and
+// b2=1 and r4 if b1=1 and b2=1.
+func (e *E12) Lookup2(api frontend.API, b1, b2 frontend.Variable, r1, r2, r3, r4 E12) *E12 {
+
+	e.C0.Lookup2(api, b1, b2, r1.C0, r2.C0, r3.C0, r4.C0)
+	e.C1.Lookup2(api, b1, b2, r1.C1, r2.C1, r3.C1, r4.C1)
+
+	return e
+}
+
 // Assign a value to self (witness assignment)
 func (e *E12) Assign(a *bls12377.E12) {
 	e.C0.Assign(&a.C0)
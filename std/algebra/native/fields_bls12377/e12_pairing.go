@@ -151,3 +151,68 @@ func (e *E12) Expt(api frontend.API, e1 E12, exponent uint64) *E12 {
 	return e
 
 }
+
+// Exp sets e to e1**k, where e1 is assumed to be in the cyclotomic subgroup
+// and k is an exponent of at most 253 bits (bit-length of the BLS12-377 scalar
+// field). It uses the left-to-right square-and-multiply algorithm with the
+// Granger-Scott cyclotomic squaring. If k is a constant, then we only multiply
+// for the set bits. Otherwise we process the bits in windows of two and
+// multiply once per window with the element selected from {1, e1, e1², e1³}.
+func (e *E12) Exp(api frontend.API, e1 E12, k frontend.Variable) *E12 {
+	var res, tmp E12
+	if kc, ok := api.Compiler().ConstantValue(k); ok {
+		res.SetOne()
+		if kc.BitLen() > 0 {
+			res = e1
+		}
+		for i := kc.BitLen() - 2; i >= 0; i-- {
+			res.CyclotomicSquare(api, res)
+			if kc.Bit(i) == 1 {
+				res.Mul(api, res, e1)
+			}
+		}
+		*e = res
+		return e
+	}
+	kBits := api.ToBinary(k, 253)
+	// pad to an even number of bits
+	kBits = append(kBits, 0)
+	n := len(kBits)
+
+	var one, e2, e3 E12
+	one.SetOne()
+	e2.CyclotomicSquare(api, e1)
+	e3.Mul(api, e2, e1)
+	res.Lookup2(api, kBits[n-2], kBits[n-1], one, e1, e2, e3)
+	for i := n - 4; i >= 0; i -= 2 {
+		res.CyclotomicSquare(api, res)
+		res.CyclotomicSquare(api, res)
+		tmp.Lookup2(api, kBits[i], kBits[i+1], one, e1, e2, e3)
+		res.Mul(api, res, tmp)
+	}
+	*e = res
+	return e
+}
+
+// CompressTorus compresses an element e1 of the cyclotomic subgroup into the
+// algebraic torus T2(Fp6) as y = (1+e1.C0)/e1.C1. The element must not be
+// ±1, otherwise the circuit is not satisfiable.
+func (e *E12) CompressTorus(api frontend.API, e1 E12) E6 {
+	var n, y, one E6
+	one.SetOne()
+	n.Add(api, e1.C0, one)
+	y.DivUnchecked(api, n, e1.C1)
+	return y
+}
+
+// DecompressTorus sets e to the cyclotomic subgroup element (y+w)/(y-w)
+// corresponding to the torus element y.
+func (e *E12) DecompressTorus(api frontend.API, y E6) *E12 {
+	var n, d E12
+	n.C0 = y
+	n.C1.SetOne()
+	d.C0 = y
+	d.C1.Neg(api, n.C1)
+	e.DivUnchecked(api, n, d)
+	return e
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/consensys/gnark-crypto/ecc"
 	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/test"
 )
@@ -447,3 +448,86 @@ func TestFp12MulBy034(t *testing.T) {
 	assert.CheckCircuit(&circuit, test.WithValidAssignment(&witness), test.WithCurves(ecc.BW6_761))
 
 }
+
+type fp12Exp struct {
+	A E12
+	K frontend.Variable
+	C E12 `gnark:",public"`
+}
+
+func (circuit *fp12Exp) Define(api frontend.API) error {
+	expected := E12{}
+	expected.Exp(api, circuit.A, circuit.K)
+	expected.AssertIsEqual(api, circuit.C)
+	return nil
+}
+
+func TestExpFp12(t *testing.T) {
+	var circuit, witness fp12Exp
+
+	// witness values
+	var a, b, c bls12377.E12
+	var k fr.Element
+	_, _ = k.SetRandom()
+
+	// put a in the cyclotomic subgroup (we assume the group is Fp12, field of definition of bls277)
+	_, _ = a.SetRandom()
+	b.Conjugate(&a)
+	a.Inverse(&a)
+	b.Mul(&b, &a)
+	a.FrobeniusSquare(&b).Mul(&a, &b)
+
+	c.CyclotomicExp(a, k.BigInt(new(big.Int)))
+
+	witness.A.Assign(&a)
+	witness.K = k.String()
+	witness.C.Assign(&c)
+
+	assert := test.NewAssert(t)
+	assert.SolvingSucceeded(&circuit, &witness, test.WithCurves(ecc.BW6_761))
+
+	// constant exponent
+	assert.NoError(test.IsSolved(&circuit, &witness, ecc.BW6_761.ScalarField(), test.SetAllVariablesAsConstants()))
+
+	// zero exponent
+	witness.K = 0
+	witness.C.Assign(new(bls12377.E12).SetOne())
+	assert.NoError(test.IsSolved(&circuit, &witness, ecc.BW6_761.ScalarField()))
+	assert.NoError(test.IsSolved(&circuit, &witness, ecc.BW6_761.ScalarField(), test.SetAllVariablesAsConstants()))
+}
+
+type fp12Torus struct {
+	A E12
+	C E6 `gnark:",public"`
+}
+
+func (circuit *fp12Torus) Define(api frontend.API) error {
+	var decompressed E12
+	compressed := decompressed.CompressTorus(api, circuit.A)
+	compressed.AssertIsEqual(api, circuit.C)
+	decompressed.DecompressTorus(api, compressed)
+	decompressed.AssertIsEqual(api, circuit.A)
+	return nil
+}
+
+func TestTorusFp12(t *testing.T) {
+	var circuit, witness fp12Torus
+
+	// witness values
+	var a, b bls12377.E12
+
+	// put a in the cyclotomic subgroup (we assume the group is Fp12, field of definition of bls277)
+	_, _ = a.SetRandom()
+	b.Conjugate(&a)
+	a.Inverse(&a)
+	b.Mul(&b, &a)
+	a.FrobeniusSquare(&b).Mul(&a, &b)
+
+	c, _ := a.CompressTorus()
+
+	witness.A.Assign(&a)
+	witness.C.Assign(&c)
+
+	assert := test.NewAssert(t)
+	assert.SolvingSucceeded(&circuit, &witness, test.WithCurves(ecc.BW6_761))
+}
@@ -375,3 +375,14 @@ func (e *E6) Select(api frontend.API, b frontend.Variable, r1, r2 E6) *E6 {
 
 	return e
 }
+
+// Lookup2 sets e to r1 if b1=0 and b2=0, r2 if b1=1 and b2=0, r3 if b1=0 and
+// b2=1 and r4 if b1=1 and b2=1.
+func (e *E6) Lookup2(api frontend.API, b1, b2 frontend.Variable, r1, r2, r3, r4 E6) *E6 {
+
+	e.B0.Lookup2(api, b1, b2, r1.B0, r2.B0, r3.B0, r4.B0)
+	e.B1.Lookup2(api, b1, b2, r1.B1, r2.B1, r3.B1, r4.B1)
+	e.B2.Lookup2(api, b1, b2, r1.B2, r2.B2, r3.B2, r4.B2)
+
+	return e
+}
@@ -0,0 +1,15 @@
+// Package montgomery implements the arithmetic of Montgomery curves
+//
+//	B*y² = x³ + A*x² + x
+//
+// in native fields. The curves are birationally equivalent to the twisted
+// Edwards curves defined over the scalar field of the SNARK curves (see
+// [github.com/consensys/gnark/std/algebra/native/twistededwards]). The map
+// from the twisted Edwards form is
+//
+//	(x, y) -> (u, v) = ((1+y)/(1-y), (1+y)/((1-y)*x)).
+//
+// In addition to the affine group law, the package provides the x-only
+// Montgomery ladder which computes the x-coordinate of a scalar multiple of a
+// point only from its x-coordinate.
+package montgomery
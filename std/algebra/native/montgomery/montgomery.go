@@ -0,0 +1,114 @@
+package montgomery
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/twistededwards"
+	"github.com/consensys/gnark/frontend"
+	edwards "github.com/consensys/gnark/std/algebra/native/twistededwards"
+)
+
+// Point represents a pair of affine X, Y coordinates inside a circuit.
+type Point struct {
+	X, Y frontend.Variable
+}
+
+// CurveParams are the parameters of the Montgomery curve B*y² = x³ + A*x² + x.
+type CurveParams struct {
+	A, B, Cofactor, Order *big.Int
+	Base                  [2]*big.Int // base point coordinates
+}
+
+// Curve implements the arithmetic of a Montgomery curve defined over the
+// native field.
+type Curve struct {
+	api    frontend.API
+	id     twistededwards.ID
+	params *CurveParams
+	// a24 = (A-2)/4 is the constant used in the Montgomery ladder.
+	a24 *big.Int
+	// ed are the parameters of the birationally equivalent twisted Edwards
+	// curve.
+	ed *edwards.CurveParams
+}
+
+// NewCurve returns a new Montgomery curve which is birationally equivalent to
+// the twisted Edwards curve with the given id.
+func NewCurve(api frontend.API, id twistededwards.ID) (*Curve, error) {
+	snarkField, err := edwards.GetSnarkField(id)
+	if err != nil {
+		return nil, err
+	}
+	if api.Compiler().Field().Cmp(snarkField) != 0 {
+		return nil, errors.New("invalid curve pair; snark field doesn't match montgomery field")
+	}
+	ed, err := edwards.GetCurveParams(id)
+	if err != nil {
+		return nil, err
+	}
+	params := fromEdwardsParams(ed, snarkField)
+	a24 := new(big.Int).Sub(params.A, big.NewInt(2))
+	a24.Mul(a24, new(big.Int).ModInverse(big.NewInt(4), snarkField))
+	a24.Mod(a24, snarkField)
+	return &Curve{api: api, id: id, params: params, a24: a24, ed: ed}, nil
+}
+
+// GetCurveParams returns the parameters of the Montgomery curve which is
+// birationally equivalent to the twisted Edwards curve with the given id.
+func GetCurveParams(id twistededwards.ID) (*CurveParams, error) {
+	snarkField, err := edwards.GetSnarkField(id)
+	if err != nil {
+		return nil, err
+	}
+	ed, err := edwards.GetCurveParams(id)
+	if err != nil {
+		return nil, err
+	}
+	return fromEdwardsParams(ed, snarkField), nil
+}
+
+// fromEdwardsParams computes the parameters of the Montgomery curve from the
+// parameters of the twisted Edwards curve a*x² + y² = 1 + d*x²*y² as
+//
+//	A = 2(a+d)/(a-d), B = 4/(a-d)
+//
+// and maps the base point.
+func fromEdwardsParams(ed *edwards.CurveParams, p *big.Int) *CurveParams {
+	amd := new(big.Int).Sub(ed.A, ed.D)
+	amd.Mod(amd, p).ModInverse(amd, p)
+	a := new(big.Int).Add(ed.A, ed.D)
+	a.Lsh(a, 1).Mul(a, amd).Mod(a, p)
+	b := new(big.Int).Lsh(amd, 2)
+	b.Mod(b, p)
+	return &CurveParams{
+		A:        a,
+		B:        b,
+		Cofactor: new(big.Int).Set(ed.Cofactor),
+		Order:    new(big.Int).Set(ed.Order),
+		Base:     fromEdwardsCoordinates(ed.Base, p),
+	}
+}
+
+// fromEdwardsCoordinates maps the twisted Edwards point (x, y) to the
+// Montgomery point (u, v) = ((1+y)/(1-y), u/x).
+func fromEdwardsCoordinates(p [2]*big.Int, mod *big.Int) [2]*big.Int {
+	num := new(big.Int).Add(big.NewInt(1), p[1])
+	den := new(big.Int).Sub(big.NewInt(1), p[1])
+	den.Mod(den, mod).ModInverse(den, mod)
+	u := num.Mul(num, den)
+	u.Mod(u, mod)
+	v := new(big.Int).ModInverse(p[0], mod)
+	v.Mul(v, u).Mod(v, mod)
+	return [2]*big.Int{u, v}
+}
+
+// Params returns the parameters of the curve.
+func (c *Curve) Params() *CurveParams {
+	return c.params
+}
+
+// API returns the native API.
+func (c *Curve) API() frontend.API {
+	return c.api
+}
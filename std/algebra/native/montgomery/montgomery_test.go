@@ -0,0 +1,175 @@
+package montgomery
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	tbn254 "github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/twistededwards"
+	"github.com/consensys/gnark/frontend"
+	edwards "github.com/consensys/gnark/std/algebra/native/twistededwards"
+	"github.com/consensys/gnark/test"
+)
+
+// randomEdwards returns a random multiple of the base point of the twisted
+// Edwards curve over the BN254 scalar field.
+func randomEdwards(t *testing.T) tbn254.PointAffine {
+	curve := tbn254.GetEdwardsCurve()
+	s, err := rand.Int(rand.Reader, &curve.Order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var p tbn254.PointAffine
+	p.ScalarMultiplication(&curve.Base, s)
+	return p
+}
+
+// toMontgomery maps the twisted Edwards point p to the Montgomery curve.
+func toMontgomery(p tbn254.PointAffine) Point {
+	c := fromEdwardsCoordinates([2]*big.Int{p.X.BigInt(new(big.Int)), p.Y.BigInt(new(big.Int))}, ecc.BN254.ScalarField())
+	return Point{X: c[0], Y: c[1]}
+}
+
+func TestCurveParams(t *testing.T) {
+	assert := test.NewAssert(t)
+	params, err := GetCurveParams(twistededwards.BN254)
+	assert.NoError(err)
+	// Baby-Jubjub is birationally equivalent to y² = x³ + 168698x² + x. As
+	// gnark-crypto uses the twisted Edwards form with a = -1, we get an
+	// isomorphic curve with B = -168700 instead.
+	assert.Equal(0, params.A.Cmp(big.NewInt(168698)))
+	b := new(big.Int).Sub(ecc.BN254.ScalarField(), big.NewInt(168700))
+	assert.Equal(0, params.B.Cmp(b))
+}
+
+type groupLawCircuit struct {
+	P, Q           Point
+	Ed             edwards.Point
+	Sum, Dbl, NegQ Point
+}
+
+func (circuit *groupLawCircuit) Define(api frontend.API) error {
+	curve, err := NewCurve(api, twistededwards.BN254)
+	if err != nil {
+		return err
+	}
+	curve.AssertIsOnCurve(circuit.P)
+	curve.AssertIsOnCurve(circuit.Q)
+	sum := curve.Add(circuit.P, circuit.Q)
+	api.AssertIsEqual(sum.X, circuit.Sum.X)
+	api.AssertIsEqual(sum.Y, circuit.Sum.Y)
+	dbl := curve.Double(circuit.P)
+	api.AssertIsEqual(dbl.X, circuit.Dbl.X)
+	api.AssertIsEqual(dbl.Y, circuit.Dbl.Y)
+	neg := curve.Neg(circuit.Q)
+	api.AssertIsEqual(neg.X, circuit.NegQ.X)
+	api.AssertIsEqual(neg.Y, circuit.NegQ.Y)
+	mapped := curve.FromEdwards(circuit.Ed)
+	api.AssertIsEqual(mapped.X, circuit.P.X)
+	api.AssertIsEqual(mapped.Y, circuit.P.Y)
+	back := curve.ToEdwards(mapped)
+	api.AssertIsEqual(back.X, circuit.Ed.X)
+	api.AssertIsEqual(back.Y, circuit.Ed.Y)
+	return nil
+}
+
+func TestGroupLaw(t *testing.T) {
+	assert := test.NewAssert(t)
+	p, q := randomEdwards(t), randomEdwards(t)
+	var sum, dbl, neg tbn254.PointAffine
+	sum.Add(&p, &q)
+	dbl.Double(&p)
+	neg.Neg(&q)
+	witness := groupLawCircuit{
+		P:    toMontgomery(p),
+		Q:    toMontgomery(q),
+		Ed:   edwards.Point{X: p.X, Y: p.Y},
+		Sum:  toMontgomery(sum),
+		Dbl:  toMontgomery(dbl),
+		NegQ: toMontgomery(neg),
+	}
+	err := test.IsSolved(&groupLawCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type scalarMulXCircuit struct {
+	X, S, Res frontend.Variable
+}
+
+func (circuit *scalarMulXCircuit) Define(api frontend.API) error {
+	curve, err := NewCurve(api, twistededwards.BN254)
+	if err != nil {
+		return err
+	}
+	res := curve.ScalarMulX(circuit.X, circuit.S)
+	api.AssertIsEqual(res, circuit.Res)
+	return nil
+}
+
+func TestScalarMulX(t *testing.T) {
+	assert := test.NewAssert(t)
+	curve := tbn254.GetEdwardsCurve()
+	p := randomEdwards(t)
+	s, err := rand.Int(rand.Reader, &curve.Order)
+	assert.NoError(err)
+	var res tbn254.PointAffine
+	res.ScalarMultiplication(&p, s)
+	witness := scalarMulXCircuit{
+		X:   toMontgomery(p).X,
+		S:   s,
+		Res: toMontgomery(res).X,
+	}
+	assert.CheckCircuit(&scalarMulXCircuit{}, test.WithValidAssignment(&witness), test.WithCurves(ecc.BN254))
+}
+
+func TestScalarMulXEdgeCases(t *testing.T) {
+	assert := test.NewAssert(t)
+	curve := tbn254.GetEdwardsCurve()
+	p := toMontgomery(randomEdwards(t))
+	for _, tc := range []struct {
+		name      string
+		x, s, res frontend.Variable
+	}{
+		{"zero scalar", p.X, 0, 0},
+		{"one", p.X, 1, p.X},
+		{"order", p.X, &curve.Order, 0},
+		// (0,0) is of order 2
+		{"order two odd", 0, 3, 0},
+		{"order two even", 0, 4, 0},
+	} {
+		witness := scalarMulXCircuit{X: tc.x, S: tc.s, Res: tc.res}
+		err := test.IsSolved(&scalarMulXCircuit{}, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, tc.name)
+	}
+}
+
+type scalarMulBaseXCircuit struct {
+	S, Res frontend.Variable
+}
+
+func (circuit *scalarMulBaseXCircuit) Define(api frontend.API) error {
+	curve, err := NewCurve(api, twistededwards.BN254)
+	if err != nil {
+		return err
+	}
+	res := curve.ScalarMulBaseX(circuit.S)
+	api.AssertIsEqual(res, circuit.Res)
+	return nil
+}
+
+func TestScalarMulBaseX(t *testing.T) {
+	assert := test.NewAssert(t)
+	curve := tbn254.GetEdwardsCurve()
+	s, err := rand.Int(rand.Reader, &curve.Order)
+	assert.NoError(err)
+	var res tbn254.PointAffine
+	res.ScalarMultiplication(&curve.Base, s)
+	witness := scalarMulBaseXCircuit{
+		S:   s,
+		Res: toMontgomery(res).X,
+	}
+	err = test.IsSolved(&scalarMulBaseXCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
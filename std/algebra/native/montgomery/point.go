@@ -0,0 +1,137 @@
+package montgomery
+
+import (
+	"github.com/consensys/gnark/frontend"
+	edwards "github.com/consensys/gnark/std/algebra/native/twistededwards"
+)
+
+// Neg returns -p.
+func (c *Curve) Neg(p Point) Point {
+	return Point{
+		X: p.X,
+		Y: c.api.Neg(p.Y),
+	}
+}
+
+// AssertIsOnCurve asserts that p satisfies B*y² = x³ + A*x² + x.
+func (c *Curve) AssertIsOnCurve(p Point) {
+	xx := c.api.Mul(p.X, p.X)
+	lhs := c.api.Mul(c.params.B, p.Y, p.Y)
+	rhs := c.api.Add(xx, c.api.Mul(c.params.A, p.X), 1)
+	rhs = c.api.Mul(rhs, p.X)
+	c.api.AssertIsEqual(lhs, rhs)
+}
+
+// Add returns p+q using the affine addition formulas.
+//
+// ⚠️  p and q must not be the point at infinity and p ≠ ±q.
+func (c *Curve) Add(p, q Point) Point {
+	// λ = (y2-y1)/(x2-x1)
+	l := c.api.Div(c.api.Sub(q.Y, p.Y), c.api.Sub(q.X, p.X))
+	return c.chord(p, q.X, l)
+}
+
+// Double returns 2p using the affine doubling formulas.
+//
+// ⚠️  p must not be the point at infinity or of order 2.
+func (c *Curve) Double(p Point) Point {
+	// λ = (3x²+2Ax+1)/2By
+	xx := c.api.Mul(p.X, p.X)
+	num := c.api.Add(c.api.Mul(3, xx), c.api.Mul(2, c.params.A, p.X), 1)
+	den := c.api.Mul(2, c.params.B, p.Y)
+	l := c.api.Div(num, den)
+	return c.chord(p, p.X, l)
+}
+
+// chord returns the third point of intersection of the line through p with
+// slope l and the curve, negated. x2 is the x-coordinate of the second point
+// of intersection.
+func (c *Curve) chord(p Point, x2, l frontend.Variable) Point {
+	// x3 = Bλ²-A-x1-x2
+	x3 := c.api.Mul(c.params.B, l, l)
+	x3 = c.api.Sub(x3, c.params.A, p.X, x2)
+	// y3 = λ(x1-x3)-y1
+	y3 := c.api.Mul(l, c.api.Sub(p.X, x3))
+	y3 = c.api.Sub(y3, p.Y)
+	return Point{X: x3, Y: y3}
+}
+
+// ScalarMulX returns the x-coordinate of [scalar]P, where x is the
+// x-coordinate of P. It uses the x-only Montgomery ladder over all the bits of
+// the native field. If the result is the point at infinity, then it returns 0.
+//
+// ✅ x can be the x-coordinate of any point on the curve or on its quadratic
+// twist, including 0.
+func (c *Curve) ScalarMulX(x, scalar frontend.Variable) frontend.Variable {
+	return c.ScalarMulXBits(x, c.api.ToBinary(scalar))
+}
+
+// ScalarMulBaseX returns the x-coordinate of [scalar]G, where G is the base
+// point of the curve.
+func (c *Curve) ScalarMulBaseX(scalar frontend.Variable) frontend.Variable {
+	return c.ScalarMulX(c.params.Base[0], scalar)
+}
+
+// ScalarMulXBits returns the x-coordinate of [s]P where x is the x-coordinate
+// of P and s = ∑ bits[i] 2^i. The bits are given least significant first and
+// must be boolean. If the result is the point at infinity, then it returns 0.
+//
+// The ladder follows RFC 7748, Section 5. It maintains the projective
+// x-coordinates (X2:Z2) and (X3:Z3) of [k]P and [k+1]P for the prefix k of
+// the scalar and swaps them conditionally depending on the current bit.
+func (c *Curve) ScalarMulXBits(x frontend.Variable, bits []frontend.Variable) frontend.Variable {
+	api := c.api
+	var x2, z2, x3, z3, swap frontend.Variable = 1, 0, x, 1, 0
+	for i := len(bits) - 1; i >= 0; i-- {
+		s := api.Xor(swap, bits[i])
+		x2, x3 = api.Select(s, x3, x2), api.Select(s, x2, x3)
+		z2, z3 = api.Select(s, z3, z2), api.Select(s, z2, z3)
+		swap = bits[i]
+
+		a := api.Add(x2, z2)
+		aa := api.Mul(a, a)
+		b := api.Sub(x2, z2)
+		bb := api.Mul(b, b)
+		e := api.Sub(aa, bb)
+		cc := api.Add(x3, z3)
+		d := api.Sub(x3, z3)
+		da := api.Mul(d, a)
+		cb := api.Mul(cc, b)
+		t := api.Add(da, cb)
+		x3 = api.Mul(t, t)
+		t = api.Sub(da, cb)
+		z3 = api.Mul(x, t, t)
+		x2 = api.Mul(aa, bb)
+		z2 = api.Mul(e, api.Add(aa, api.Mul(c.a24, e)))
+	}
+	x2 = api.Select(swap, x3, x2)
+	z2 = api.Select(swap, z3, z2)
+	// if Z2 = 0 then the result is the point at infinity and we return 0.
+	isInf := api.IsZero(z2)
+	z2 = api.Select(isInf, 1, z2)
+	return api.Select(isInf, 0, api.Div(x2, z2))
+}
+
+// FromEdwards maps the point p on the birationally equivalent twisted Edwards
+// curve to the Montgomery curve.
+//
+// ⚠️  p must not be (0, ±1).
+func (c *Curve) FromEdwards(p edwards.Point) Point {
+	num := c.api.Add(1, p.Y)
+	u := c.api.Div(num, c.api.Sub(1, p.Y))
+	return Point{
+		X: u,
+		Y: c.api.Div(u, p.X),
+	}
+}
+
+// ToEdwards maps the point p to the birationally equivalent twisted Edwards
+// curve as (x, y) = (u/v, (u-1)/(u+1)).
+//
+// ⚠️  p must not be the point at infinity or of order 2.
+func (c *Curve) ToEdwards(p Point) edwards.Point {
+	return edwards.Point{
+		X: c.api.Div(p.X, p.Y),
+		Y: c.api.Div(c.api.Sub(p.X, 1), c.api.Add(p.X, 1)),
+	}
+}
@@ -2,6 +2,11 @@
 // computation on BLS12-377 as a SNARK circuit over BW6-761. These two curves
 // form a 2-chain so the operations use native field arithmetic.
 //
+// By default, the G1 operations use incomplete affine formulas which assume
+// that the inputs are not the point at infinity (0,0) and do not hit the
+// exceptional cases (equal or opposite points). Use
+// algopts.WithCompleteArithmetic for the variants which handle these cases.
+//
 // References:
 // BW6-761: https://eprint.iacr.org/2020/351
 // Pairings in R1CS: https://eprint.iacr.org/2022/1162
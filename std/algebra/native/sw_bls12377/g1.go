@@ -25,6 +25,7 @@ import (
 
 	"github.com/consensys/gnark/constraint/solver"
 	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/algopts"
 )
 
 // G1Jac point in Jacobian coords
@@ -69,6 +70,53 @@ func (p *G1Affine) AddAssign(api frontend.API, p1 G1Affine) *G1Affine {
 	return p
 }
 
+// AddUnified adds p1 to p using complete affine formulas, and return p.
+//
+// ✅ p can be equal to p1 or -p1, and either or both can be (0,0).
+// (0,0) is not on the curve but we conventionally take it as the
+// neutral/infinity point.
+//
+// It selects between the chord and the tangent slopes depending on whether the
+// x coordinates match, and handles the point at infinity separately.
+func (p *G1Affine) AddUnified(api frontend.API, p1 G1Affine) *G1Affine {
+
+	// selector1 = 1 when p is (0,0) and 0 otherwise
+	selector1 := api.And(api.IsZero(p.X), api.IsZero(p.Y))
+	// selector2 = 1 when p1 is (0,0) and 0 otherwise
+	selector2 := api.And(api.IsZero(p1.X), api.IsZero(p1.Y))
+	// selector3 = 1 when p.x = p1.x, i.e. p1 = ±p
+	selector3 := api.IsZero(api.Sub(p1.X, p.X))
+	// selector4 = 1 when p1 = -p and the sum is (0,0)
+	selector4 := api.And(selector3, api.IsZero(api.Add(p.Y, p1.Y)))
+
+	// lambda = 3*p.x**2/2*p.y if p1 = p and (p1.y-p.y)/(p1.x-p.x) otherwise.
+	// In the exceptional cases we set the denominator to 1 and discard the
+	// result.
+	num := api.Select(selector3, api.Mul(p.X, p.X, 3), api.Sub(p1.Y, p.Y))
+	den := api.Select(selector3, api.Mul(p.Y, 2), api.Sub(p1.X, p.X))
+	exceptional := api.Or(api.Or(selector1, selector2), selector4)
+	den = api.Select(exceptional, 1, den)
+	lambda := api.DivUnchecked(num, den)
+
+	// xr = lambda**2-p.x-p1.x
+	xr := api.Sub(api.Mul(lambda, lambda), api.Add(p.X, p1.X))
+
+	// yr = lambda(p.x-xr) - p.y
+	yr := api.Sub(api.Mul(lambda, api.Sub(p.X, xr)), p.Y)
+
+	// if p1 = -p return (0,0)
+	xr = api.Select(selector4, 0, xr)
+	yr = api.Select(selector4, 0, yr)
+	// if p1 = (0,0) return p
+	xr = api.Select(selector2, p.X, xr)
+	yr = api.Select(selector2, p.Y, yr)
+	// if p = (0,0) return p1
+	p.X = api.Select(selector1, p1.X, xr)
+	p.Y = api.Select(selector1, p1.Y, yr)
+
+	return p
+}
+
 // AddAssign adds 2 point in Jacobian coordinates
 // p=p, a=p1
 func (p *G1Jac) AddAssign(api frontend.API, p1 G1Jac) *G1Jac {
@@ -194,12 +242,44 @@ func (p *G1Affine) Double(api frontend.API, p1 G1Affine) *G1Affine {
 	return p
 }
 
+// doubleUnified doubles p1 in affine coords using complete formulas, and
+// returns p. If p1 is (0,0) or a point of order 2, then p is (0,0).
+func (p *G1Affine) doubleUnified(api frontend.API, p1 G1Affine) *G1Affine {
+
+	// selector = 1 when p1.y = 0, i.e. p1 is (0,0) or of order 2
+	selector := api.IsZero(p1.Y)
+
+	// compute lambda = (3*p1.x**2+a)/2*p1.y, here we assume a=0 (j invariant 0 curve)
+	lambda := api.DivUnchecked(api.Mul(p1.X, p1.X, 3), api.Select(selector, 1, api.Mul(p1.Y, 2)))
+
+	// xr = lambda**2-p1.x-p1.x
+	xr := api.Sub(api.Mul(lambda, lambda), api.Mul(p1.X, 2))
+
+	// p.y = lambda(p.x-xr) - p.y
+	yr := api.Sub(api.Mul(lambda, api.Sub(p1.X, xr)), p1.Y)
+
+	p.X = api.Select(selector, 0, xr)
+	p.Y = api.Select(selector, 0, yr)
+
+	return p
+}
+
 // ScalarMul sets P = [s] Q and returns P.
 //
 // The method chooses an implementation based on scalar s. If it is constant,
 // then the compiled circuit depends on s. If it is variable type, then
 // the circuit is independent of the inputs.
-func (P *G1Affine) ScalarMul(api frontend.API, Q G1Affine, s interface{}) *G1Affine {
+//
+// If [algopts.WithCompleteArithmetic] is given, then the method uses complete
+// formulas and Q can be (0,0) and s can be 0. This is more expensive.
+func (P *G1Affine) ScalarMul(api frontend.API, Q G1Affine, s interface{}, opts ...algopts.AlgebraOption) *G1Affine {
+	cfg, err := algopts.NewConfig(opts...)
+	if err != nil {
+		panic(err)
+	}
+	if cfg.CompleteArithmetic {
+		return P.scalarMulComplete(api, Q, s)
+	}
 	if n, ok := api.Compiler().ConstantValue(s); ok {
 		return P.constScalarMul(api, Q, n)
 	} else {
@@ -336,6 +416,52 @@ func (P *G1Affine) varScalarMul(api frontend.API, Q G1Affine, s frontend.Variabl
 	return P
 }
 
+// scalarMulComplete sets P = [s] Q and returns P.
+//
+// ✅ Q can be (0,0) and s can be 0.
+//
+// It uses the same GLV decomposition as varScalarMul, but instead of the
+// signed-digit recoding with incomplete formulas it computes the unsigned
+// double-and-add algorithm on the table {0, Q, Φ(Q), Q+Φ(Q)} with complete
+// formulas. Thus, there are no exceptional cases for the accumulator.
+func (P *G1Affine) scalarMulComplete(api frontend.API, Q G1Affine, s frontend.Variable) *G1Affine {
+	cc := getInnerCurveConfig(api.Compiler().Field())
+
+	// see varScalarMul for the constraints of the decomposition
+	sd, err := api.Compiler().NewHint(DecomposeScalarG1, 3, s)
+	if err != nil {
+		// err is non-nil only for invalid number of inputs
+		panic(err)
+	}
+	s1, s2 := sd[0], sd[1]
+	api.AssertIsEqual(api.Add(s1, api.Mul(s2, cc.lambda)), api.Add(s, api.Mul(cc.fr, sd[2])))
+
+	nbits := cc.lambda.BitLen() + 1
+	s1bits := api.ToBinary(s1, nbits)
+	s2bits := api.ToBinary(s2, nbits)
+
+	// the endomorphism maps (0,0) to (0,0), so the table is correct also when
+	// Q is (0,0).
+	var phiQ, QphiQ G1Affine
+	cc.phi1(api, &phiQ, &Q)
+	QphiQ = Q
+	QphiQ.AddUnified(api, phiQ)
+
+	Acc := G1Affine{X: 0, Y: 0}
+	var B G1Affine
+	for i := nbits - 1; i >= 0; i-- {
+		Acc.doubleUnified(api, Acc)
+		B.X = api.Lookup2(s1bits[i], s2bits[i], 0, Q.X, phiQ.X, QphiQ.X)
+		B.Y = api.Lookup2(s1bits[i], s2bits[i], 0, Q.Y, phiQ.Y, QphiQ.Y)
+		Acc.AddUnified(api, B)
+	}
+
+	P.X = Acc.X
+	P.Y = Acc.Y
+
+	return P
+}
+
 // constScalarMul sets P = [s] Q and returns P.
 func (P *G1Affine) constScalarMul(api frontend.API, Q G1Affine, s *big.Int) *G1Affine {
 	// see the comments in varScalarMul. However, two-bit lookup is cheaper if
@@ -452,7 +578,15 @@ func (p *G1Affine) DoubleAndAdd(api frontend.API, p1, p2 *G1Affine) *G1Affine {
 }
 
 // ScalarMulBase computes s * g1 and returns it, where g1 is the fixed generator. It doesn't modify s.
-func (P *G1Affine) ScalarMulBase(api frontend.API, s frontend.Variable) *G1Affine {
+//
+// If [algopts.WithCompleteArithmetic] is given, then the method uses complete
+// formulas in the steps where the incomplete ones may fail, and s can be any
+// 253-bit value, including 0 and r-1.
+func (P *G1Affine) ScalarMulBase(api frontend.API, s frontend.Variable, opts ...algopts.AlgebraOption) *G1Affine {
+	cfg, err := algopts.NewConfig(opts...)
+	if err != nil {
+		panic(err)
+	}
 
 	points := getCurvePoints()
 
@@ -469,13 +603,24 @@ func (P *G1Affine) ScalarMulBase(api frontend.API, s frontend.Variable) *G1Affin
 		// gm[i] = [2^i]g
 		tmp.X = res.X
 		tmp.Y = res.Y
-		tmp.AddAssign(api, G1Affine{points.G1m[i][0], points.G1m[i][1]})
+		// the accumulator is an odd multiple of g less than 2^i and thus it
+		// can be ±[2^i]g only in the last iteration, as r > 2^252.
+		if cfg.CompleteArithmetic && i == 252 {
+			tmp.AddUnified(api, G1Affine{points.G1m[i][0], points.G1m[i][1]})
+		} else {
+			tmp.AddAssign(api, G1Affine{points.G1m[i][0], points.G1m[i][1]})
+		}
 		res.Select(api, sBits[i], tmp, res)
 	}
 
 	// i = 0
 	tmp.Neg(api, G1Affine{points.G1x, points.G1y})
-	tmp.AddAssign(api, res)
+	if cfg.CompleteArithmetic {
+		// res is g when s = 0
+		tmp.AddUnified(api, res)
+	} else {
+		tmp.AddAssign(api, res)
+	}
 	res.Select(api, sBits[0], res, tmp)
 
 	P.X = res.X
@@ -74,6 +74,18 @@ func (c *Curve) Add(P, Q *G1Affine) *G1Affine {
 	return res
 }
 
+// AddUnified adds points P and Q using complete formulas and returns the
+// result. Does not modify the inputs. P and Q can be equal, opposite and
+// either or both can be (0,0).
+func (c *Curve) AddUnified(P, Q *G1Affine) *G1Affine {
+	res := &G1Affine{
+		X: P.X,
+		Y: P.Y,
+	}
+	res.AddUnified(c.api, *Q)
+	return res
+}
+
 // AssertIsEqual asserts the equality of P and Q.
 func (c *Curve) AssertIsEqual(P, Q *G1Affine) {
 	P.AssertIsEqual(c.api, *Q)
@@ -90,23 +102,25 @@ func (c *Curve) Neg(P *G1Affine) *G1Affine {
 }
 
 // ScalarMul computes scalar*P and returns the result. It doesn't modify the
-// inputs.
+// inputs. With [algopts.WithCompleteArithmetic] P can be (0,0) and the scalar
+// can be 0.
 func (c *Curve) ScalarMul(P *G1Affine, s *Scalar, opts ...algopts.AlgebraOption) *G1Affine {
 	res := &G1Affine{
 		X: P.X,
 		Y: P.Y,
 	}
 	varScalar := c.packScalarToVar(s)
-	res.ScalarMul(c.api, *P, varScalar)
+	res.ScalarMul(c.api, *P, varScalar, opts...)
 	return res
 }
 
 // ScalarMulBase computes scalar*G where G is the standard base point of the
-// curve. It doesn't modify the scalar.
+// curve. It doesn't modify the scalar. With [algopts.WithCompleteArithmetic]
+// the scalar can be 0.
 func (c *Curve) ScalarMulBase(s *Scalar, opts ...algopts.AlgebraOption) *G1Affine {
 	res := new(G1Affine)
 	varScalar := c.packScalarToVar(s)
-	res.ScalarMulBase(c.api, varScalar)
+	res.ScalarMulBase(c.api, varScalar, opts...)
 	return res
 }
 
@@ -128,9 +142,13 @@ func (c *Curve) MultiScalarMul(P []*G1Affine, scalars []*Scalar, opts ...algopts
 		if len(P) != len(scalars) {
 			return nil, fmt.Errorf("mismatching points and scalars slice lengths")
 		}
-		res := c.ScalarMul(P[0], scalars[0])
+		res := c.ScalarMul(P[0], scalars[0], opts...)
 		for i := 1; i < len(P); i++ {
 			q := c.ScalarMul(P[i], scalars[i], opts...)
+			if cfg.CompleteArithmetic {
+				res = c.AddUnified(res, q)
+				continue
+			}
 
 			// check for infinity...
 			isInfinity := c.api.And(c.api.IsZero(P[i].X), c.api.IsZero(P[i].Y))
@@ -147,13 +165,21 @@ func (c *Curve) MultiScalarMul(P []*G1Affine, scalars []*Scalar, opts ...algopts
 		gamma := scalars[0]
 		res := c.ScalarMul(P[len(P)-1], gamma, opts...)
 		for i := len(P) - 2; i > 0; i-- {
-			isInfinity := c.api.And(c.api.IsZero(P[i].X), c.api.IsZero(P[i].Y))
-			tmp := c.Add(P[i], res)
-			res.X = c.api.Select(isInfinity, res.X, tmp.X)
-			res.Y = c.api.Select(isInfinity, res.Y, tmp.Y)
+			if cfg.CompleteArithmetic {
+				res = c.AddUnified(P[i], res)
+			} else {
+				isInfinity := c.api.And(c.api.IsZero(P[i].X), c.api.IsZero(P[i].Y))
+				tmp := c.Add(P[i], res)
+				res.X = c.api.Select(isInfinity, res.X, tmp.X)
+				res.Y = c.api.Select(isInfinity, res.Y, tmp.Y)
+			}
 			res = c.ScalarMul(res, gamma, opts...)
 		}
-		res = c.Add(P[0], res)
+		if cfg.CompleteArithmetic {
+			res = c.AddUnified(P[0], res)
+		} else {
+			res = c.Add(P[0], res)
+		}
 		return res, nil
 	}
 }
@@ -161,12 +187,18 @@ func (c *Curve) MultiScalarMul(P []*G1Affine, scalars []*Scalar, opts ...algopts
 // Pairing allows computing pairing-related operations in BLS12-377.
 type Pairing struct {
 	api frontend.API
+	fr  *emulated.Field[ScalarField]
 }
 
 // NewPairing initializes a [Pairing] instance.
 func NewPairing(api frontend.API) *Pairing {
+	f, err := emulated.NewField[ScalarField](api)
+	if err != nil {
+		panic(fmt.Sprintf("scalar field: %v", err))
+	}
 	return &Pairing{
 		api: api,
+		fr:  f,
 	}
 }
 
@@ -234,6 +266,22 @@ func (p *Pairing) AssertIsEqual(e1, e2 *GT) {
 	e1.AssertIsEqual(p.api, *e2)
 }
 
+// MulGT computes the product of the target group elements and returns it. It
+// doesn't modify the inputs.
+func (p *Pairing) MulGT(e1, e2 *GT) *GT {
+	var res GT
+	res.Mul(p.api, *e1, *e2)
+	return &res
+}
+
+// ExpGT computes e^k for the target group element e and returns it. It doesn't
+// modify the inputs.
+func (p *Pairing) ExpGT(e *GT, k *Scalar) *GT {
+	var res GT
+	res.Exp(p.api, *e, packScalarToVar(p.api, p.fr, k))
+	return &res
+}
+
 // NewG1Affine allocates a witness from the native G1 element and returns it.
 func NewG1Affine(v bls12377.G1Affine) G1Affine {
 	return G1Affine{
@@ -304,14 +352,18 @@ func NewScalar(v fr_bls12377.Element) Scalar {
 // The method is for compatibility for existing scalar multiplication
 // implementation which assumes as an input frontend.Variable.
 func (c *Curve) packScalarToVar(s *Scalar) frontend.Variable {
+	return packScalarToVar(c.api, c.fr, s)
+}
+
+func packScalarToVar(api frontend.API, f *emulated.Field[ScalarField], s *Scalar) frontend.Variable {
 	var fr ScalarField
-	reduced := c.fr.Reduce(s)
+	reduced := f.Reduce(s)
 	var res frontend.Variable = 0
 	nbBits := fr.BitsPerLimb()
 	coef := new(big.Int)
 	one := big.NewInt(1)
 	for i := range reduced.Limbs {
-		res = c.api.Add(res, c.api.Mul(reduced.Limbs[i], coef.Lsh(one, nbBits*uint(i))))
+		res = api.Add(res, api.Mul(reduced.Limbs[i], coef.Lsh(one, nbBits*uint(i))))
 	}
 	return res
 }
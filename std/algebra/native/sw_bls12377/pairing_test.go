@@ -192,6 +192,42 @@ func TestPairingCheckBLS377(t *testing.T) {
 
 }
 
+type gtOpsBLS377 struct {
+	A, B GT
+	K    Scalar
+	Res  GT
+}
+
+func (circuit *gtOpsBLS377) Define(api frontend.API) error {
+	pairing := NewPairing(api)
+	res := pairing.MulGT(&circuit.A, pairing.ExpGT(&circuit.B, &circuit.K))
+	pairing.AssertIsEqual(res, &circuit.Res)
+	return nil
+}
+
+func TestGTOpsBLS377(t *testing.T) {
+
+	// pairing test data
+	_, _, _, a := pairingData()
+	_, _, b := triplePairingData()
+	var k fr.Element
+	_, _ = k.SetRandom()
+	var res bls12377.GT
+	res.Exp(b, k.BigInt(new(big.Int)))
+	res.Mul(&a, &res)
+
+	// assign values to witness
+	var witness gtOpsBLS377
+	witness.A.Assign(&a)
+	witness.B.Assign(&b)
+	witness.K = NewScalar(k)
+	witness.Res.Assign(&res)
+
+	assert := test.NewAssert(t)
+	assert.NoError(test.IsSolved(&gtOpsBLS377{}, &witness, ecc.BW6_761.ScalarField()))
+
+}
+
 // utils
 func pairingData() (P bls12377.G1Affine, Q bls12377.G2Affine, milRes, pairingRes bls12377.GT) {
 	_, _, P, Q = bls12377.Generators()
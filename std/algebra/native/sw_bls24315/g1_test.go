@@ -27,6 +27,7 @@ import (
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/frontend/cs/r1cs"
 	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/std/algebra/algopts"
 	"github.com/consensys/gnark/std/math/emulated"
 	"github.com/consensys/gnark/test"
 
@@ -550,6 +551,202 @@ func TestMultiScalarMul(t *testing.T) {
 	assert.NoError(err)
 }
 
+// -------------------------------------------------------------------------------------------------
+// Complete arithmetic
+
+type g1AddUnified struct {
+	A, B G1Affine
+	C    G1Affine `gnark:",public"`
+}
+
+func (circuit *g1AddUnified) Define(api frontend.API) error {
+	expected := circuit.A
+	expected.AddUnified(api, circuit.B)
+	expected.AssertIsEqual(api, circuit.C)
+	return nil
+}
+
+func TestAddUnifiedG1(t *testing.T) {
+	assert := test.NewAssert(t)
+	_a, _b := randomPointG1(), randomPointG1()
+	var a, b, negA, infinity bls24315.G1Affine
+	a.FromJacobian(&_a)
+	b.FromJacobian(&_b)
+	negA.Neg(&a)
+	for _, tc := range []struct {
+		name string
+		a, b bls24315.G1Affine
+	}{
+		{"random", a, b},
+		{"equal", a, a},
+		{"opposite", a, negA},
+		{"left infinity", infinity, b},
+		{"right infinity", a, infinity},
+		{"both infinity", infinity, infinity},
+	} {
+		var c bls24315.G1Affine
+		c.Add(&tc.a, &tc.b)
+		witness := g1AddUnified{
+			A: NewG1Affine(tc.a),
+			B: NewG1Affine(tc.b),
+			C: NewG1Affine(c),
+		}
+		err := test.IsSolved(&g1AddUnified{}, &witness, ecc.BW6_633.ScalarField())
+		assert.NoError(err, tc.name)
+	}
+}
+
+type g1ScalarMulComplete struct {
+	A    G1Affine
+	C    G1Affine `gnark:",public"`
+	Rvar frontend.Variable
+	Rcon *big.Int
+}
+
+func (circuit *g1ScalarMulComplete) Define(api frontend.API) error {
+	var expected, expected2 G1Affine
+	expected.ScalarMul(api, circuit.A, circuit.Rvar, algopts.WithCompleteArithmetic())
+	expected.AssertIsEqual(api, circuit.C)
+	expected2.ScalarMul(api, circuit.A, circuit.Rcon, algopts.WithCompleteArithmetic())
+	expected2.AssertIsEqual(api, circuit.C)
+	return nil
+}
+
+func TestScalarMulCompleteG1(t *testing.T) {
+	assert := test.NewAssert(t)
+	_a := randomPointG1()
+	var a, infinity bls24315.G1Affine
+	a.FromJacobian(&_a)
+	var r fr.Element
+	r.SetRandom()
+	minusOne := new(big.Int).Sub(fr.Modulus(), big.NewInt(1))
+	for _, tc := range []struct {
+		name string
+		a    bls24315.G1Affine
+		s    *big.Int
+	}{
+		{"random", a, r.BigInt(new(big.Int))},
+		{"zero scalar", a, big.NewInt(0)},
+		{"one", a, big.NewInt(1)},
+		{"minus one", a, minusOne},
+		{"modulus", a, fr.Modulus()},
+		{"infinity", infinity, r.BigInt(new(big.Int))},
+		{"infinity and zero scalar", infinity, big.NewInt(0)},
+	} {
+		var c bls24315.G1Affine
+		c.ScalarMultiplication(&tc.a, tc.s)
+		witness := g1ScalarMulComplete{
+			A:    NewG1Affine(tc.a),
+			C:    NewG1Affine(c),
+			Rvar: tc.s,
+		}
+		err := test.IsSolved(&g1ScalarMulComplete{Rcon: tc.s}, &witness, ecc.BW6_633.ScalarField())
+		assert.NoError(err, tc.name)
+	}
+}
+
+type g1ScalarMulBaseComplete struct {
+	C G1Affine `gnark:",public"`
+	R frontend.Variable
+}
+
+func (circuit *g1ScalarMulBaseComplete) Define(api frontend.API) error {
+	expected := G1Affine{}
+	expected.ScalarMulBase(api, circuit.R, algopts.WithCompleteArithmetic())
+	expected.AssertIsEqual(api, circuit.C)
+	return nil
+}
+
+func TestScalarMulBaseCompleteG1(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, _, g, _ := bls24315.Generators()
+	var r fr.Element
+	r.SetRandom()
+	minusOne := new(big.Int).Sub(fr.Modulus(), big.NewInt(1))
+	for _, tc := range []struct {
+		name string
+		s    *big.Int
+	}{
+		{"random", r.BigInt(new(big.Int))},
+		{"zero scalar", big.NewInt(0)},
+		{"one", big.NewInt(1)},
+		{"minus one", minusOne},
+		{"modulus", fr.Modulus()},
+	} {
+		var c bls24315.G1Affine
+		c.ScalarMultiplication(&g, tc.s)
+		witness := g1ScalarMulBaseComplete{
+			C: NewG1Affine(c),
+			R: tc.s,
+		}
+		err := test.IsSolved(&g1ScalarMulBaseComplete{}, &witness, ecc.BW6_633.ScalarField())
+		assert.NoError(err, tc.name)
+	}
+}
+
+type MultiScalarMulCompleteTest struct {
+	Points  []G1Affine
+	Scalars []emulated.Element[ScalarField]
+	Res     G1Affine
+}
+
+func (c *MultiScalarMulCompleteTest) Define(api frontend.API) error {
+	cr, err := NewCurve(api)
+	if err != nil {
+		return err
+	}
+	ps := make([]*G1Affine, len(c.Points))
+	for i := range c.Points {
+		ps[i] = &c.Points[i]
+	}
+	ss := make([]*emulated.Element[ScalarField], len(c.Scalars))
+	for i := range c.Scalars {
+		ss[i] = &c.Scalars[i]
+	}
+	res, err := cr.MultiScalarMul(ps, ss, algopts.WithCompleteArithmetic())
+	if err != nil {
+		return err
+	}
+	cr.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestMultiScalarMulComplete(t *testing.T) {
+	assert := test.NewAssert(t)
+	// the points are P, P, (0,0), -P and the scalars are s, s, random, 0 so
+	// that the intermediate sums hit the edge cases.
+	var s, sInf fr.Element
+	s.SetRandom()
+	sInf.SetRandom()
+	_p := randomPointG1()
+	var p, negP bls24315.G1Affine
+	p.FromJacobian(&_p)
+	negP.Neg(&p)
+	P := []bls24315.G1Affine{p, p, {}, negP}
+	S := []fr.Element{s, s, sInf, {}}
+	var res bls24315.G1Affine
+	res.ScalarMultiplication(&p, s.BigInt(new(big.Int)))
+	res.Double(&res)
+	cP := make([]G1Affine, len(P))
+	for i := range cP {
+		cP[i] = NewG1Affine(P[i])
+	}
+	cS := make([]emulated.Element[ScalarField], len(S))
+	for i := range cS {
+		cS[i] = NewScalar(S[i])
+	}
+	assignment := MultiScalarMulCompleteTest{
+		Points:  cP,
+		Scalars: cS,
+		Res:     NewG1Affine(res),
+	}
+	err := test.IsSolved(&MultiScalarMulCompleteTest{
+		Points:  make([]G1Affine, len(P)),
+		Scalars: make([]emulated.Element[ScalarField], len(S)),
+	}, &assignment, ecc.BW6_633.ScalarField())
+	assert.NoError(err)
+}
+
 func randomPointG1() bls24315.G1Jac {
 
 	p1, _, _, _ := bls24315.Generators()
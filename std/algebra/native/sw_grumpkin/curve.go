@@ -0,0 +1,215 @@
+package sw_grumpkin
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fp_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/algopts"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/emulated/emparams"
+)
+
+// Curve allows G1 operations in Grumpkin.
+type Curve struct {
+	api frontend.API
+	fr  *emulated.Field[ScalarField]
+}
+
+// NewCurve initializes a new [Curve] instance.
+func NewCurve(api frontend.API) (*Curve, error) {
+	if api.Compiler().Field().Cmp(ecc.BN254.ScalarField()) != 0 {
+		return nil, fmt.Errorf("grumpkin is defined only over the scalar field of BN254")
+	}
+	f, err := emulated.NewField[ScalarField](api)
+	if err != nil {
+		return nil, fmt.Errorf("scalar field: %w", err)
+	}
+	return &Curve{
+		api: api,
+		fr:  f,
+	}, nil
+}
+
+// MarshalScalar returns the big-endian binary decomposition of the scalar.
+func (c *Curve) MarshalScalar(s Scalar) []frontend.Variable {
+	nbBits := 8 * ((ScalarField{}.Modulus().BitLen() + 7) / 8)
+	ss := c.fr.Reduce(&s)
+	x := c.fr.ToBits(ss)
+	for i, j := 0, nbBits-1; i < j; {
+		x[i], x[j] = x[j], x[i]
+		i++
+		j--
+	}
+	return x
+}
+
+// MarshalG1 returns [P.X || P.Y] in binary. Both P.X and P.Y are in big
+// endian. As for the other curves, the second most significant bit is set when
+// P is the point at infinity (0,0).
+func (c *Curve) MarshalG1(P G1Affine) []frontend.Variable {
+	nbBits := 8 * ((ecc.BN254.ScalarField().BitLen() + 7) / 8)
+	res := make([]frontend.Variable, 2*nbBits)
+	x := bits.ToBinary(c.api, P.X, bits.WithNbDigits(nbBits))
+	y := bits.ToBinary(c.api, P.Y, bits.WithNbDigits(nbBits))
+	for i := 0; i < nbBits; i++ {
+		res[i] = x[nbBits-1-i]
+		res[i+nbBits] = y[nbBits-1-i]
+	}
+	xZ := c.api.IsZero(P.X)
+	yZ := c.api.IsZero(P.Y)
+	res[1] = c.api.Mul(xZ, yZ)
+	return res
+}
+
+// Add points P and Q and return the result. Does not modify the inputs.
+func (c *Curve) Add(P, Q *G1Affine) *G1Affine {
+	res := &G1Affine{
+		X: P.X,
+		Y: P.Y,
+	}
+	res.AddAssign(c.api, *Q)
+	return res
+}
+
+// AssertIsEqual asserts the equality of P and Q.
+func (c *Curve) AssertIsEqual(P, Q *G1Affine) {
+	P.AssertIsEqual(c.api, *Q)
+}
+
+// AssertIsOnCurve asserts that P is on the curve. As the curve has prime
+// order, this also asserts that P is in the group.
+func (c *Curve) AssertIsOnCurve(P *G1Affine) {
+	P.AssertIsOnCurve(c.api)
+}
+
+// Neg negates P and returns the result. Does not modify P.
+func (c *Curve) Neg(P *G1Affine) *G1Affine {
+	res := &G1Affine{
+		X: P.X,
+		Y: P.Y,
+	}
+	res.Neg(c.api, *P)
+	return res
+}
+
+// ScalarMul computes scalar*P and returns the result. It doesn't modify the
+// inputs.
+//
+// If [algopts.WithCompleteArithmetic] is given, then the method uses complete
+// formulas and P can be (0,0) and s can be 0. This is more expensive.
+func (c *Curve) ScalarMul(P *G1Affine, s *Scalar, opts ...algopts.AlgebraOption) *G1Affine {
+	cfg, err := algopts.NewConfig(opts...)
+	if err != nil {
+		panic(fmt.Sprintf("parse opts: %v", err))
+	}
+	if cfg.CompleteArithmetic {
+		return c.scalarMulComplete(P, s)
+	}
+	return c.scalarMulGLV(P, s)
+}
+
+// ScalarMulBase computes scalar*G where G is the standard base point of the
+// curve. It doesn't modify the scalar.
+//
+// If [algopts.WithCompleteArithmetic] is given, then the method uses complete
+// formulas and s can be 0.
+func (c *Curve) ScalarMulBase(s *Scalar, opts ...algopts.AlgebraOption) *G1Affine {
+	cfg, err := algopts.NewConfig(opts...)
+	if err != nil {
+		panic(fmt.Sprintf("parse opts: %v", err))
+	}
+	cc := getCurveConfig()
+	G := &G1Affine{
+		X: cc.generator[0],
+		Y: cc.generator[1],
+	}
+	if cfg.CompleteArithmetic {
+		return c.scalarMulComplete(G, s)
+	}
+	return c.scalarMulGLV(G, s)
+}
+
+// MultiScalarMul computes ∑scalars_i * P_i and returns it. It doesn't modify
+// the inputs. It returns an error if there is a mismatch in the lengths of the
+// inputs.
+func (c *Curve) MultiScalarMul(P []*G1Affine, scalars []*Scalar, opts ...algopts.AlgebraOption) (*G1Affine, error) {
+	if len(P) == 0 {
+		return &G1Affine{
+			X: 0,
+			Y: 0,
+		}, nil
+	}
+	cfg, err := algopts.NewConfig(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("new config: %w", err)
+	}
+	if !cfg.FoldMulti {
+		if len(P) != len(scalars) {
+			return nil, fmt.Errorf("mismatching points and scalars slice lengths")
+		}
+		res := c.ScalarMul(P[0], scalars[0], opts...)
+		for i := 1; i < len(P); i++ {
+			q := c.ScalarMul(P[i], scalars[i], opts...)
+			if cfg.CompleteArithmetic {
+				res.AddUnified(c.api, *q)
+				continue
+			}
+
+			// check for infinity...
+			isInfinity := c.api.And(c.api.IsZero(P[i].X), c.api.IsZero(P[i].Y))
+			tmp := c.Add(res, q)
+			res.X = c.api.Select(isInfinity, res.X, tmp.X)
+			res.Y = c.api.Select(isInfinity, res.Y, tmp.Y)
+		}
+		return res, nil
+	} else {
+		// scalars are powers
+		if len(scalars) == 0 {
+			return nil, fmt.Errorf("need scalar for folding")
+		}
+		gamma := scalars[0]
+		res := c.ScalarMul(P[len(P)-1], gamma, opts...)
+		for i := len(P) - 2; i > 0; i-- {
+			if cfg.CompleteArithmetic {
+				res.AddUnified(c.api, *P[i])
+			} else {
+				isInfinity := c.api.And(c.api.IsZero(P[i].X), c.api.IsZero(P[i].Y))
+				tmp := c.Add(P[i], res)
+				res.X = c.api.Select(isInfinity, res.X, tmp.X)
+				res.Y = c.api.Select(isInfinity, res.Y, tmp.Y)
+			}
+			res = c.ScalarMul(res, gamma, opts...)
+		}
+		if cfg.CompleteArithmetic {
+			return res.AddUnified(c.api, *P[0]), nil
+		}
+		res = c.Add(P[0], res)
+		return res, nil
+	}
+}
+
+// NewG1Affine allocates a witness from the coordinates of the point and
+// returns it. The coordinates are elements of the scalar field of BN254.
+func NewG1Affine(x, y fr_bn254.Element) G1Affine {
+	return G1Affine{
+		X: x,
+		Y: y,
+	}
+}
+
+// Scalar is a scalar in the group. The group order is the base field modulus
+// of BN254 which is larger than the native field, so the scalar is emulated.
+type Scalar = emulated.Element[ScalarField]
+
+// NewScalar allocates a witness from the native scalar and returns it.
+func NewScalar(v fp_bn254.Element) Scalar {
+	return emulated.ValueOf[ScalarField](v)
+}
+
+// ScalarField defines the [emulated.FieldParams] implementation of the scalar
+// field of Grumpkin.
+type ScalarField = emparams.BN254Fp
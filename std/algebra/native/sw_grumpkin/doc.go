@@ -0,0 +1,17 @@
+// Package sw_grumpkin implements the arithmetics of the Grumpkin curve as a
+// SNARK circuit over BN254.
+//
+// Grumpkin is the short Weierstrass curve y² = x³ - 17 defined over the scalar
+// field of BN254, and its group order is the base field modulus of BN254. The
+// two curves form a 2-cycle, so that the group operations use native field
+// arithmetic when the circuit is defined over BN254. The scalars of the curve
+// are emulated.
+//
+// The curve has j-invariant 0, so the scalar multiplication uses the GLV
+// decomposition with the endomorphism (x,y) → (ωx,y), where ω is a primitive
+// cube root of unity in the base field.
+//
+// References:
+// Grumpkin: https://hackmd.io/@aztec-network/ByzgNxBfd
+// GLV: https://www.iacr.org/archive/crypto2001/21390189.pdf
+package sw_grumpkin
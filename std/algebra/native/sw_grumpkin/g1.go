@@ -0,0 +1,412 @@
+package sw_grumpkin
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// G1Affine point in affine coords
+type G1Affine struct {
+	X, Y frontend.Variable
+}
+
+// Neg outputs -p
+func (p *G1Affine) Neg(api frontend.API, p1 G1Affine) *G1Affine {
+	p.X = p1.X
+	p.Y = api.Sub(0, p1.Y)
+	return p
+}
+
+// AddAssign adds p1 to p using the affine formulas with division, and return p
+func (p *G1Affine) AddAssign(api frontend.API, p1 G1Affine) *G1Affine {
+
+	// compute lambda = (p1.y-p.y)/(p1.x-p.x)
+	lambda := api.DivUnchecked(api.Sub(p1.Y, p.Y), api.Sub(p1.X, p.X))
+
+	// xr = lambda**2-p.x-p1.x
+	xr := api.Sub(api.Mul(lambda, lambda), api.Add(p.X, p1.X))
+
+	// p.y = lambda(p.x-xr) - p.y
+	p.Y = api.Sub(api.Mul(lambda, api.Sub(p.X, xr)), p.Y)
+
+	//p.x = xr
+	p.X = xr
+	return p
+}
+
+// AddUnified adds p1 to p and returns p. It uses the complete formulas: the
+// slope is computed with the tangent if p1 = p and with the chord otherwise.
+//
+// ✅ p can be equal to p1, and either or both can be (0,0).
+// (0,0) is not on the curve but we conventionally take it as the
+// neutral/infinity point.
+func (p *G1Affine) AddUnified(api frontend.API, p1 G1Affine) *G1Affine {
+
+	// selector1 = 1 when p is (0,0) and 0 otherwise
+	selector1 := api.And(api.IsZero(p.X), api.IsZero(p.Y))
+	// selector2 = 1 when p1 is (0,0) and 0 otherwise
+	selector2 := api.And(api.IsZero(p1.X), api.IsZero(p1.Y))
+	// selector3 = 1 when p.x = p1.x, i.e. p1 = ±p
+	selector3 := api.IsZero(api.Sub(p1.X, p.X))
+	// selector4 = 1 when p1 = -p and the sum is (0,0)
+	selector4 := api.And(selector3, api.IsZero(api.Add(p.Y, p1.Y)))
+
+	// lambda = 3*p.x**2/2*p.y if p1 = p and (p1.y-p.y)/(p1.x-p.x) otherwise.
+	// In the exceptional cases we set the denominator to 1 and discard the
+	// result.
+	num := api.Select(selector3, api.Mul(p.X, p.X, 3), api.Sub(p1.Y, p.Y))
+	den := api.Select(selector3, api.Mul(p.Y, 2), api.Sub(p1.X, p.X))
+	exceptional := api.Or(api.Or(selector1, selector2), selector4)
+	den = api.Select(exceptional, 1, den)
+	lambda := api.DivUnchecked(num, den)
+
+	// xr = lambda**2-p.x-p1.x
+	xr := api.Sub(api.Mul(lambda, lambda), api.Add(p.X, p1.X))
+
+	// yr = lambda(p.x-xr) - p.y
+	yr := api.Sub(api.Mul(lambda, api.Sub(p.X, xr)), p.Y)
+
+	// if p1 = -p return (0,0)
+	xr = api.Select(selector4, 0, xr)
+	yr = api.Select(selector4, 0, yr)
+	// if p1 = (0,0) return p
+	xr = api.Select(selector2, p.X, xr)
+	yr = api.Select(selector2, p.Y, yr)
+	// if p = (0,0) return p1
+	p.X = api.Select(selector1, p1.X, xr)
+	p.Y = api.Select(selector1, p1.Y, yr)
+
+	return p
+}
+
+// Double double a point in affine coords
+func (p *G1Affine) Double(api frontend.API, p1 G1Affine) *G1Affine {
+
+	var three, two big.Int
+	three.SetInt64(3)
+	two.SetInt64(2)
+
+	// compute lambda = (3*p1.x**2+a)/2*p1.y, here a=0 (j invariant 0 curve)
+	lambda := api.DivUnchecked(api.Mul(p1.X, p1.X, three), api.Mul(p1.Y, two))
+
+	// xr = lambda**2-p1.x-p1.x
+	xr := api.Sub(api.Mul(lambda, lambda), api.Mul(p1.X, two))
+
+	// p.y = lambda(p.x-xr) - p.y
+	p.Y = api.Sub(api.Mul(lambda, api.Sub(p1.X, xr)), p1.Y)
+
+	//p.x = xr
+	p.X = xr
+
+	return p
+}
+
+// doubleUnified doubles p1 and sets the result to p. Contrary to Double, it
+// handles the case p1 = (0,0). As the curve has prime order, there is no other
+// point with a zero y-coordinate.
+func (p *G1Affine) doubleUnified(api frontend.API, p1 G1Affine) *G1Affine {
+
+	// selector = 1 when p1.y = 0, i.e. p1 is (0,0)
+	selector := api.IsZero(p1.Y)
+
+	// compute lambda = (3*p1.x**2+a)/2*p1.y, here a=0 (j invariant 0 curve)
+	lambda := api.DivUnchecked(api.Mul(p1.X, p1.X, 3), api.Select(selector, 1, api.Mul(p1.Y, 2)))
+
+	// xr = lambda**2-p1.x-p1.x
+	xr := api.Sub(api.Mul(lambda, lambda), api.Mul(p1.X, 2))
+
+	// p.y = lambda(p.x-xr) - p.y
+	yr := api.Sub(api.Mul(lambda, api.Sub(p1.X, xr)), p1.Y)
+
+	p.X = api.Select(selector, 0, xr)
+	p.Y = api.Select(selector, 0, yr)
+
+	return p
+}
+
+// DoubleAndAdd computes 2*p1+p2 in affine coords
+func (p *G1Affine) DoubleAndAdd(api frontend.API, p1, p2 *G1Affine) *G1Affine {
+
+	// compute lambda1 = (y2-y1)/(x2-x1)
+	l1 := api.DivUnchecked(api.Sub(p1.Y, p2.Y), api.Sub(p1.X, p2.X))
+
+	// compute x3 = lambda1**2-x1-x2
+	x3 := api.Mul(l1, l1)
+	x3 = api.Sub(x3, p1.X)
+	x3 = api.Sub(x3, p2.X)
+
+	// omit y3 computation
+	// compute lambda2 = -lambda1-2*y1/(x3-x1)
+	l2 := api.DivUnchecked(api.Add(p1.Y, p1.Y), api.Sub(x3, p1.X))
+	l2 = api.Add(l2, l1)
+	l2 = api.Neg(l2)
+
+	// compute x4 =lambda2**2-x1-x3
+	x4 := api.Mul(l2, l2)
+	x4 = api.Sub(x4, p1.X)
+	x4 = api.Sub(x4, x3)
+
+	// compute y4 = lambda2*(x1 - x4)-y1
+	y4 := api.Sub(p1.X, x4)
+	y4 = api.Mul(l2, y4)
+	y4 = api.Sub(y4, p1.Y)
+
+	p.X = x4
+	p.Y = y4
+
+	return p
+}
+
+// Select sets p1 if b=1, p2 if b=0, and returns it. b must be boolean constrained
+func (p *G1Affine) Select(api frontend.API, b frontend.Variable, p1, p2 G1Affine) *G1Affine {
+
+	p.X = api.Select(b, p1.X, p2.X)
+	p.Y = api.Select(b, p1.Y, p2.Y)
+
+	return p
+
+}
+
+// AssertIsEqual constraint self to be equal to other into the given constraint system
+func (p *G1Affine) AssertIsEqual(api frontend.API, other G1Affine) {
+	api.AssertIsEqual(p.X, other.X)
+	api.AssertIsEqual(p.Y, other.Y)
+}
+
+// AssertIsOnCurve asserts that p satisfies the curve equation y² = x³ - 17.
+func (p *G1Affine) AssertIsOnCurve(api frontend.API) {
+	left := api.Mul(p.Y, p.Y)
+	right := api.Mul(p.X, api.Mul(p.X, p.X))
+	right = api.Sub(right, 17)
+	api.AssertIsEqual(left, right)
+}
+
+// phi sets res to the image of P by the endomorphism (x,y) → (ωx,y) and
+// returns res. It corresponds to the scalar multiplication by λ.
+func (cc *curveConfig) phi(api frontend.API, res, P *G1Affine) *G1Affine {
+	res.X = api.Mul(P.X, cc.thirdRootOne)
+	res.Y = P.Y
+	return res
+}
+
+type curveConfig struct {
+	thirdRootOne *big.Int
+	glvBasis     *ecc.Lattice
+	lambda       *big.Int
+	shift        [2]*big.Int
+	fr           *big.Int
+	generator    [2]*big.Int
+}
+
+var (
+	configOnce     sync.Once
+	grumpkinConfig curveConfig
+)
+
+// getCurveConfig returns the constants of the Grumpkin curve.
+func getCurveConfig() *curveConfig {
+	configOnce.Do(func() {
+		thirdRootOne, _ := new(big.Int).SetString("4407920970296243842393367215006156084916469457145843978461", 10)
+		lambda, _ := new(big.Int).SetString("2203960485148121921418603742825762020974279258880205651966", 10)
+		// (shift0, shift1) is a short vector of the GLV lattice, i.e.
+		// shift0 + λ * shift1 = 0 mod r, with both coordinates positive.
+		shift0, _ := new(big.Int).SetString("147946756881789319000765030803803410729", 10)
+		shift1, _ := new(big.Int).SetString("147946756881789319010696353538189108491", 10)
+		gy, _ := new(big.Int).SetString("17631683881184975370165255887551781615748388533673675138860", 10)
+		fr := ecc.BN254.BaseField()
+		glvBasis := new(ecc.Lattice)
+		ecc.PrecomputeLattice(fr, lambda, glvBasis)
+		grumpkinConfig = curveConfig{
+			thirdRootOne: thirdRootOne,
+			glvBasis:     glvBasis,
+			lambda:       lambda,
+			shift:        [2]*big.Int{shift0, shift1},
+			fr:           fr,
+			generator:    [2]*big.Int{big.NewInt(1), gy},
+		}
+	})
+	return &grumpkinConfig
+}
+
+// nbScalarBits is the bit length of the GLV sub-scalars. The sub-scalars
+// returned by the lattice reduction are of at most 127 bits, and the hint
+// shifts them so that they are positive and at least one of them has the bit
+// nbScalarBits-1 set.
+const nbScalarBits = 129
+
+// DecomposeScalarG1 is a hint which decomposes the scalar s (given as its
+// limbs) into s1 and s2 such that
+//
+//	s1 + λ * s2 == s mod r,
+//
+// where r is the order of the curve and 0 ≤ s1, s2 < 2^nbScalarBits.
+func DecomposeScalarG1(_ *big.Int, inputs []*big.Int, res []*big.Int) error {
+	cc := getCurveConfig()
+	var fr ScalarField
+	s := new(big.Int)
+	for i := len(inputs) - 1; i >= 0; i-- {
+		s.Lsh(s, fr.BitsPerLimb())
+		s.Add(s, inputs[i])
+	}
+	s.Mod(s, cc.fr)
+	sp := ecc.SplitScalar(s, cc.glvBasis)
+	res[0].Set(&(sp[0]))
+	res[1].Set(&(sp[1]))
+	// add the lattice vector (shift0, shift1) until both sub-scalars are
+	// positive and the high bit of one of them is set. This doesn't change
+	// s1 + λ * s2 mod r.
+	high := new(big.Int).Lsh(big.NewInt(1), nbScalarBits-1)
+	for res[0].Sign() < 0 || res[1].Sign() < 0 || (res[0].Cmp(high) < 0 && res[1].Cmp(high) < 0) {
+		res[0].Add(res[0], cc.shift[0])
+		res[1].Add(res[1], cc.shift[1])
+	}
+	return nil
+}
+
+func init() {
+	solver.RegisterHint(DecomposeScalarG1)
+}
+
+// decomposeScalar decomposes the scalar s into s1 and s2 such that
+// s1 + λ * s2 == s mod r and returns the nbScalarBits little-endian bits of
+// s1 and s2.
+func (c *Curve) decomposeScalar(s *Scalar) (s1bits, s2bits []frontend.Variable) {
+	cc := getCurveConfig()
+	api := c.api
+
+	// the hint allows to decompose the scalar s into s1 and s2 such that
+	//     s1 + λ * s2 == s mod r,
+	// where λ is third root of one in 𝔽_r.
+	sr := c.fr.Reduce(s)
+	sd, err := api.Compiler().NewHint(DecomposeScalarG1, 2, sr.Limbs...)
+	if err != nil {
+		// err is non-nil only for invalid number of inputs
+		panic(err)
+	}
+	s1, s2 := sd[0], sd[1]
+
+	s1bits = api.ToBinary(s1, nbScalarBits)
+	s2bits = api.ToBinary(s2, nbScalarBits)
+
+	// the scalar is emulated, so we check the decomposition in the emulated
+	// scalar field:
+	//     s1 + λ * s2 == s mod r
+	// the bits are padded so that the sub-scalars have as many limbs as the
+	// elements of the scalar field.
+	var fr ScalarField
+	padding := make([]frontend.Variable, int(fr.NbLimbs()*fr.BitsPerLimb())-nbScalarBits)
+	for i := range padding {
+		padding[i] = 0
+	}
+	e1 := c.fr.FromBits(append(s1bits, padding...)...)
+	e2 := c.fr.FromBits(append(s2bits, padding...)...)
+	lambda := emulated.ValueOf[ScalarField](cc.lambda)
+	c.fr.AssertIsEqual(c.fr.Add(e1, c.fr.Mul(e2, &lambda)), sr)
+
+	return s1bits, s2bits
+}
+
+// scalarMulGLV sets P = [s] Q and returns P. The point Q must be different
+// from the point at infinity. As the method uses incomplete formulas, the
+// circuit is not satisfiable for a few exceptional scalars, e.g. s ∈ {0, -1,
+// -λ, -1-λ}.
+//
+// The point accumulation follows the scalar multiplication in the native
+// BLS12-377 package: instead of the conditional addition, at every step we
+// either add or subtract Q and Φ(Q) from the doubled accumulator, which allows
+// to use the incomplete affine formulas.
+func (c *Curve) scalarMulGLV(Q *G1Affine, s *Scalar) *G1Affine {
+	cc := getCurveConfig()
+	api := c.api
+
+	s1bits, s2bits := c.decomposeScalar(s)
+
+	var Acc /*accumulator*/, B, B2 /*tmp vars*/ G1Affine
+	// precompute -Q, -Φ(Q), Φ(Q)
+	var tableQ, tablePhiQ [2]G1Affine
+	tableQ[1] = *Q
+	tableQ[0].Neg(api, *Q)
+	cc.phi(api, &tablePhiQ[1], Q)
+	tablePhiQ[0].Neg(api, tablePhiQ[1])
+
+	// We now initialize the accumulator. Due to the way the scalar is
+	// decomposed, either the high bits of s1 or s2 are set and we can use the
+	// incomplete addition laws.
+
+	//     Acc = Q + Φ(Q)
+	Acc = tableQ[1]
+	Acc.AddAssign(api, tablePhiQ[1])
+
+	// We either add or subtract step value from [2] Acc (instead of
+	// conditionally adding step value to Acc):
+	//     Acc = [2] (Q + Φ(Q)) ± Q ± Φ(Q)
+	// only y coordinate differs for negation, select on that instead.
+	B.X = tableQ[0].X
+	B.Y = api.Select(s1bits[nbScalarBits-1], tableQ[1].Y, tableQ[0].Y)
+	Acc.DoubleAndAdd(api, &Acc, &B)
+	B.X = tablePhiQ[0].X
+	B.Y = api.Select(s2bits[nbScalarBits-1], tablePhiQ[1].Y, tablePhiQ[0].Y)
+	Acc.AddAssign(api, B)
+
+	// second bit
+	B.X = tableQ[0].X
+	B.Y = api.Select(s1bits[nbScalarBits-2], tableQ[1].Y, tableQ[0].Y)
+	Acc.DoubleAndAdd(api, &Acc, &B)
+	B.X = tablePhiQ[0].X
+	B.Y = api.Select(s2bits[nbScalarBits-2], tablePhiQ[1].Y, tablePhiQ[0].Y)
+	Acc.AddAssign(api, B)
+
+	B2.X = tablePhiQ[0].X
+	for i := nbScalarBits - 3; i > 0; i-- {
+		B.X = Q.X
+		B.Y = api.Select(s1bits[i], tableQ[1].Y, tableQ[0].Y)
+		B2.Y = api.Select(s2bits[i], tablePhiQ[1].Y, tablePhiQ[0].Y)
+		B.AddAssign(api, B2)
+		Acc.DoubleAndAdd(api, &Acc, &B)
+	}
+
+	tableQ[0].AddAssign(api, Acc)
+	Acc.Select(api, s1bits[0], Acc, tableQ[0])
+	tablePhiQ[0].AddAssign(api, Acc)
+	Acc.Select(api, s2bits[0], Acc, tablePhiQ[0])
+
+	return &Acc
+}
+
+// scalarMulComplete sets P = [s] Q and returns P.
+//
+// ✅ Q can be (0,0) and s can be 0.
+//
+// It uses the same GLV decomposition as scalarMulGLV, but instead of the
+// signed-digit recoding with incomplete formulas it computes the unsigned
+// double-and-add algorithm on the table {0, Q, Φ(Q), Q+Φ(Q)} with complete
+// formulas. Thus, there are no exceptional cases for the accumulator.
+func (c *Curve) scalarMulComplete(Q *G1Affine, s *Scalar) *G1Affine {
+	cc := getCurveConfig()
+	api := c.api
+
+	s1bits, s2bits := c.decomposeScalar(s)
+
+	// the endomorphism maps (0,0) to (0,0), so the table is correct also when
+	// Q is (0,0).
+	var phiQ, QphiQ G1Affine
+	cc.phi(api, &phiQ, Q)
+	QphiQ = *Q
+	QphiQ.AddUnified(api, phiQ)
+
+	Acc := G1Affine{X: 0, Y: 0}
+	var B G1Affine
+	for i := nbScalarBits - 1; i >= 0; i-- {
+		Acc.doubleUnified(api, Acc)
+		B.X = api.Lookup2(s1bits[i], s2bits[i], 0, Q.X, phiQ.X, QphiQ.X)
+		B.Y = api.Lookup2(s1bits[i], s2bits[i], 0, Q.Y, phiQ.Y, QphiQ.Y)
+		Acc.AddUnified(api, B)
+	}
+
+	return &Acc
+}
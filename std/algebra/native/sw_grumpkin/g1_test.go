@@ -0,0 +1,339 @@
+package sw_grumpkin
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fp_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/algopts"
+	"github.com/consensys/gnark/test"
+)
+
+// gnark-crypto doesn't implement Grumpkin, so we use a minimal affine
+// implementation for computing the expected values.
+type refPoint struct {
+	x, y     fr_bn254.Element
+	infinity bool
+}
+
+func refGenerator() refPoint {
+	cc := getCurveConfig()
+	var p refPoint
+	p.x.SetBigInt(cc.generator[0])
+	p.y.SetBigInt(cc.generator[1])
+	return p
+}
+
+func refAdd(p, q refPoint) refPoint {
+	if p.infinity {
+		return q
+	}
+	if q.infinity {
+		return p
+	}
+	var l, t fr_bn254.Element
+	if p.x.Equal(&q.x) {
+		if t.Add(&p.y, &q.y); t.IsZero() {
+			return refPoint{infinity: true}
+		}
+		// l = 3x²/2y
+		l.Square(&p.x)
+		t.SetUint64(3)
+		l.Mul(&l, &t)
+		t.Double(&p.y).Inverse(&t)
+		l.Mul(&l, &t)
+	} else {
+		// l = (y2-y1)/(x2-x1)
+		l.Sub(&q.y, &p.y)
+		t.Sub(&q.x, &p.x).Inverse(&t)
+		l.Mul(&l, &t)
+	}
+	var r refPoint
+	r.x.Square(&l).Sub(&r.x, &p.x).Sub(&r.x, &q.x)
+	r.y.Sub(&p.x, &r.x).Mul(&r.y, &l).Sub(&r.y, &p.y)
+	return r
+}
+
+func refScalarMul(p refPoint, s *big.Int) refPoint {
+	res := refPoint{infinity: true}
+	for i := s.BitLen() - 1; i >= 0; i-- {
+		res = refAdd(res, res)
+		if s.Bit(i) == 1 {
+			res = refAdd(res, p)
+		}
+	}
+	return res
+}
+
+func (p refPoint) toG1Affine() G1Affine {
+	return NewG1Affine(p.x, p.y)
+}
+
+func randomPoint() refPoint {
+	s, err := rand.Int(rand.Reader, ecc.BN254.BaseField())
+	if err != nil {
+		panic(err)
+	}
+	return refScalarMul(refGenerator(), s)
+}
+
+func randomScalar() (fp_bn254.Element, *big.Int) {
+	var s fp_bn254.Element
+	s.SetRandom()
+	return s, s.BigInt(new(big.Int))
+}
+
+func TestGLVConstants(t *testing.T) {
+	assert := test.NewAssert(t)
+	cc := getCurveConfig()
+	g := refGenerator()
+	var phiG refPoint
+	phiG.x.SetBigInt(cc.thirdRootOne)
+	phiG.x.Mul(&phiG.x, &g.x)
+	phiG.y = g.y
+	assert.Equal(phiG, refScalarMul(g, cc.lambda))
+	assert.True(refScalarMul(g, ecc.BN254.BaseField()).infinity)
+	// the shift vector is in the GLV lattice
+	v := new(big.Int).Mul(cc.shift[1], cc.lambda)
+	v.Add(v, cc.shift[0]).Mod(v, cc.fr)
+	assert.Equal(0, v.Sign())
+}
+
+type g1AddAssign struct {
+	A, B G1Affine
+	C    G1Affine `gnark:",public"`
+}
+
+func (circuit *g1AddAssign) Define(api frontend.API) error {
+	expected := circuit.A
+	expected.AddAssign(api, circuit.B)
+	expected.AssertIsEqual(api, circuit.C)
+	return nil
+}
+
+func TestAddAssignAffineG1(t *testing.T) {
+	a, b := randomPoint(), randomPoint()
+	c := refAdd(a, b)
+	witness := g1AddAssign{
+		A: a.toG1Affine(),
+		B: b.toG1Affine(),
+		C: c.toG1Affine(),
+	}
+	assert := test.NewAssert(t)
+	assert.CheckCircuit(&g1AddAssign{}, test.WithValidAssignment(&witness), test.WithCurves(ecc.BN254))
+}
+
+type g1AddUnified struct {
+	A, B G1Affine
+	C    G1Affine `gnark:",public"`
+}
+
+func (circuit *g1AddUnified) Define(api frontend.API) error {
+	expected := circuit.A
+	expected.AddUnified(api, circuit.B)
+	expected.AssertIsEqual(api, circuit.C)
+	return nil
+}
+
+func TestAddUnifiedAffineG1(t *testing.T) {
+	assert := test.NewAssert(t)
+	a, b := randomPoint(), randomPoint()
+	var negA refPoint
+	negA.x = a.x
+	negA.y.Neg(&a.y)
+	infinity := refPoint{infinity: true}
+	for _, tc := range []struct {
+		name string
+		a, b refPoint
+	}{
+		{"distinct", a, b},
+		{"equal", a, a},
+		{"opposite", a, negA},
+		{"first-infinity", infinity, b},
+		{"second-infinity", a, infinity},
+		{"both-infinity", infinity, infinity},
+	} {
+		witness := g1AddUnified{
+			A: tc.a.toG1Affine(),
+			B: tc.b.toG1Affine(),
+			C: refAdd(tc.a, tc.b).toG1Affine(),
+		}
+		err := test.IsSolved(&g1AddUnified{}, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, tc.name)
+	}
+}
+
+type g1DoubleAndAdd struct {
+	A, B G1Affine
+	C    G1Affine `gnark:",public"`
+}
+
+func (circuit *g1DoubleAndAdd) Define(api frontend.API) error {
+	var expected, doubled G1Affine
+	expected.DoubleAndAdd(api, &circuit.A, &circuit.B)
+	expected.AssertIsEqual(api, circuit.C)
+	doubled.Double(api, circuit.A)
+	doubled.AddAssign(api, circuit.B)
+	doubled.AssertIsEqual(api, circuit.C)
+	circuit.C.AssertIsOnCurve(api)
+	return nil
+}
+
+func TestDoubleAndAddAffineG1(t *testing.T) {
+	a, b := randomPoint(), randomPoint()
+	c := refAdd(refAdd(a, a), b)
+	witness := g1DoubleAndAdd{
+		A: a.toG1Affine(),
+		B: b.toG1Affine(),
+		C: c.toG1Affine(),
+	}
+	assert := test.NewAssert(t)
+	assert.CheckCircuit(&g1DoubleAndAdd{}, test.WithValidAssignment(&witness), test.WithCurves(ecc.BN254))
+}
+
+type scalarMulCircuit struct {
+	A        G1Affine
+	S        Scalar
+	C        G1Affine `gnark:",public"`
+	complete bool
+}
+
+func (circuit *scalarMulCircuit) Define(api frontend.API) error {
+	cr, err := NewCurve(api)
+	if err != nil {
+		return err
+	}
+	var opts []algopts.AlgebraOption
+	if circuit.complete {
+		opts = append(opts, algopts.WithCompleteArithmetic())
+	}
+	res := cr.ScalarMul(&circuit.A, &circuit.S, opts...)
+	cr.AssertIsEqual(res, &circuit.C)
+	return nil
+}
+
+func TestScalarMulG1(t *testing.T) {
+	assert := test.NewAssert(t)
+	a := randomPoint()
+	for _, s := range []string{"random", "one", "small"} {
+		var sc fp_bn254.Element
+		switch s {
+		case "random":
+			sc.SetRandom()
+		case "one":
+			sc.SetOne()
+		case "small":
+			sc.SetUint64(12345)
+		}
+		c := refScalarMul(a, sc.BigInt(new(big.Int)))
+		witness := scalarMulCircuit{
+			A: a.toG1Affine(),
+			S: NewScalar(sc),
+			C: c.toG1Affine(),
+		}
+		err := test.IsSolved(&scalarMulCircuit{}, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, s)
+	}
+}
+
+func TestScalarMulG1Complete(t *testing.T) {
+	assert := test.NewAssert(t)
+	a := randomPoint()
+	infinity := refPoint{infinity: true}
+	var minusOne fp_bn254.Element
+	minusOne.SetOne().Neg(&minusOne)
+	for _, tc := range []struct {
+		name string
+		p    refPoint
+		s    fp_bn254.Element
+	}{
+		{"random", a, fp_bn254.NewElement(0)},
+		{"zero", a, fp_bn254.NewElement(0)},
+		{"one", a, fp_bn254.One()},
+		{"minus-one", a, minusOne},
+		{"infinity", infinity, fp_bn254.NewElement(12345)},
+	} {
+		if tc.name == "random" {
+			tc.s.SetRandom()
+		}
+		c := refScalarMul(tc.p, tc.s.BigInt(new(big.Int)))
+		witness := scalarMulCircuit{
+			A: tc.p.toG1Affine(),
+			S: NewScalar(tc.s),
+			C: c.toG1Affine(),
+		}
+		err := test.IsSolved(&scalarMulCircuit{complete: true}, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, tc.name)
+	}
+}
+
+type scalarMulBaseCircuit struct {
+	S Scalar
+	C G1Affine `gnark:",public"`
+}
+
+func (circuit *scalarMulBaseCircuit) Define(api frontend.API) error {
+	cr, err := NewCurve(api)
+	if err != nil {
+		return err
+	}
+	res := cr.ScalarMulBase(&circuit.S)
+	cr.AssertIsEqual(res, &circuit.C)
+	return nil
+}
+
+func TestScalarMulBaseG1(t *testing.T) {
+	assert := test.NewAssert(t)
+	s, sb := randomScalar()
+	c := refScalarMul(refGenerator(), sb)
+	witness := scalarMulBaseCircuit{
+		S: NewScalar(s),
+		C: c.toG1Affine(),
+	}
+	assert.CheckCircuit(&scalarMulBaseCircuit{}, test.WithValidAssignment(&witness), test.WithCurves(ecc.BN254))
+}
+
+type multiScalarMulCircuit struct {
+	Points  [3]G1Affine
+	Scalars [3]Scalar
+	Res     G1Affine
+}
+
+func (circuit *multiScalarMulCircuit) Define(api frontend.API) error {
+	cr, err := NewCurve(api)
+	if err != nil {
+		return err
+	}
+	ps := make([]*G1Affine, len(circuit.Points))
+	ss := make([]*Scalar, len(circuit.Scalars))
+	for i := range circuit.Points {
+		ps[i] = &circuit.Points[i]
+		ss[i] = &circuit.Scalars[i]
+	}
+	res, err := cr.MultiScalarMul(ps, ss)
+	if err != nil {
+		return err
+	}
+	cr.AssertIsEqual(res, &circuit.Res)
+	return nil
+}
+
+func TestMultiScalarMulG1(t *testing.T) {
+	assert := test.NewAssert(t)
+	var witness multiScalarMulCircuit
+	res := refPoint{infinity: true}
+	for i := range witness.Points {
+		p := randomPoint()
+		s, sb := randomScalar()
+		res = refAdd(res, refScalarMul(p, sb))
+		witness.Points[i] = p.toG1Affine()
+		witness.Scalars[i] = NewScalar(s)
+	}
+	witness.Res = res.toG1Affine()
+	err := test.IsSolved(&multiScalarMulCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
@@ -201,6 +201,59 @@ func TestCurve(t *testing.T) {
 	}
 }
 
+type edgeCasesCircuit struct {
+	curveID twistededwards.ID
+	P       Point
+	S       frontend.Variable
+}
+
+func (circuit *edgeCasesCircuit) Define(api frontend.API) error {
+	curve, err := NewEdCurve(api, circuit.curveID)
+	if err != nil {
+		return err
+	}
+	identity := Point{X: 0, Y: 1}
+	assertIsEqual := func(p, q Point) {
+		api.AssertIsEqual(p.X, q.X)
+		api.AssertIsEqual(p.Y, q.Y)
+	}
+
+	// the addition and doubling formulas are complete for P in the prime-order
+	// subgroup. For Bandersnatch they are not complete outside of it.
+	assertIsEqual(curve.Add(circuit.P, identity), circuit.P)
+	assertIsEqual(curve.Add(identity, identity), identity)
+	assertIsEqual(curve.Add(circuit.P, curve.Neg(circuit.P)), identity)
+	assertIsEqual(curve.Add(circuit.P, circuit.P), curve.Double(circuit.P))
+	assertIsEqual(curve.Double(identity), identity)
+
+	// so the scalar multiplications handle zero scalars and the identity
+	assertIsEqual(curve.ScalarMul(circuit.P, 0), identity)
+	assertIsEqual(curve.ScalarMul(circuit.P, circuit.S), identity)
+	assertIsEqual(curve.ScalarMul(identity, 12345), identity)
+	assertIsEqual(curve.DoubleBaseScalarMul(circuit.P, identity, circuit.S, 12345), identity)
+	assertIsEqual(curve.DoubleBaseScalarMul(circuit.P, circuit.P, 1, circuit.S), circuit.P)
+
+	return nil
+}
+
+func TestEdgeCases(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, curve := range curves {
+		snarkField, err := GetSnarkField(curve)
+		assert.NoError(err)
+		params, err := GetCurveParams(curve)
+		assert.NoError(err)
+
+		// the base point generates the prime-order subgroup
+		witness := edgeCasesCircuit{
+			P: Point{X: params.Base[0], Y: params.Base[1]},
+			S: 0,
+		}
+		err = test.IsSolved(&edgeCasesCircuit{curveID: curve}, &witness, snarkField)
+		assert.NoError(err, curve)
+	}
+}
+
 // testData generates random test data for given curve
 // returns p1, p2 and r, d such that p1 + p2 == r and p1 + p1 == d
 // returns rs1, rs12, s1, s2 such that rs1 = p2 * s2 and rs12 = p1*s1 + p2 * s2
@@ -5,4 +5,11 @@
 // Examples:
 // Jubjub, Bandersnatch (a twisted Edwards) is defined over BLS12-381's scalar field
 // Baby-Jubjub (a twisted Edwards) is defined over BN254's salar fields
+//
+// Except for Bandersnatch, the curves have a square a and a non-square d, so
+// the addition and doubling formulas are complete: they hold for the identity
+// (0,1) and for equal or opposite points. On Bandersnatch both a and d are
+// non-squares and the formulas are only complete on the prime-order subgroup,
+// so the points must be in the subgroup. In both cases the scalar
+// multiplications handle zero scalars and the identity without special cases.
 package twistededwards
@@ -0,0 +1,238 @@
+// Package buffer implements variable-length buffers.
+//
+// A [Buffer] is an array with fixed capacity (known at compile time) and a
+// length which is only known at solving time. The elements of the buffer at
+// positions not less than the length are zero. This allows comparing buffers
+// element-wise without considering the lengths.
+//
+// The operations use lookup tables from [logderivlookup] for accessing the
+// elements at variable positions. The cost of an operation is linear in the
+// capacity of the buffers.
+package buffer
+
+import (
+	"math/bits"
+
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
+	"github.com/consensys/gnark/std/rangecheck"
+)
+
+// Buffer is a fixed-capacity array with a variable length.
+type Buffer struct {
+	api    frontend.API
+	data   []frontend.Variable
+	length frontend.Variable
+}
+
+// New returns a new buffer with the elements data and length length. The
+// capacity of the buffer is len(data). The elements at positions not less than
+// length are set to zero. The length must be in range [0, len(data)],
+// otherwise the circuit is not satisfiable. It panics if data is empty.
+func New(api frontend.API, data []frontend.Variable, length frontend.Variable) *Buffer {
+	if len(data) == 0 {
+		panic("empty buffer")
+	}
+	b := &Buffer{api: api, data: data, length: length}
+	b.data = b.prefix(length)
+	return b
+}
+
+// Len returns the length of the buffer.
+func (b *Buffer) Len() frontend.Variable {
+	return b.length
+}
+
+// Cap returns the capacity of the buffer.
+func (b *Buffer) Cap() int {
+	return len(b.data)
+}
+
+// Data returns the elements of the buffer. The elements at positions not less
+// than the length of the buffer are zero.
+func (b *Buffer) Data() []frontend.Variable {
+	res := make([]frontend.Variable, len(b.data))
+	copy(res, b.data)
+	return res
+}
+
+// Shift returns a buffer with the first k elements removed. The capacity of the
+// returned buffer is the same as the capacity of b. The value k must be in
+// range [0, b.Len()], otherwise the circuit is not satisfiable.
+func (b *Buffer) Shift(k frontend.Variable) *Buffer {
+	api := b.api
+	// the lookup bounds k to [0, cap] and then we check that the length does
+	// not underflow. As the values are small, the difference wraps around the
+	// modulus if k > length and the check fails.
+	tbl := logderivlookup.New(api)
+	for i := range b.data {
+		tbl.Insert(b.data[i])
+	}
+	for range b.data {
+		tbl.Insert(0)
+	}
+	inds := make([]frontend.Variable, len(b.data))
+	for i := range inds {
+		inds[i] = api.Add(i, k)
+	}
+	length := api.Sub(b.length, k)
+	rangecheck.New(api).Check(length, bits.Len(uint(len(b.data))))
+	// as the buffer is zero-padded, then the elements after the new length are
+	// also zero.
+	return &Buffer{api: api, data: tbl.Lookup(inds...), length: length}
+}
+
+// Slice returns a buffer with the elements at positions [start, end). The
+// capacity of the returned buffer is the same as the capacity of b. We must
+// have 0 <= start <= end <= b.Len(), otherwise the circuit is not satisfiable.
+func (b *Buffer) Slice(start, end frontend.Variable) *Buffer {
+	api := b.api
+	shifted := b.Shift(start)
+	length := api.Sub(end, start)
+	// the new length must not exceed the length of the shifted buffer.
+	rangecheck.New(api).Check(api.Sub(shifted.length, length), bits.Len(uint(len(b.data))))
+	return &Buffer{api: api, data: shifted.prefix(length), length: length}
+}
+
+// Concat returns a buffer with the elements of b followed by the elements of
+// other. The capacity of the returned buffer is the sum of the capacities.
+func (b *Buffer) Concat(other *Buffer) *Buffer {
+	api := b.api
+	// the element at position i is b[i] + other[i - b.Len()]. We put the
+	// elements of other in a zero-padded table so that the lookup index is
+	// always in range.
+	tbl := logderivlookup.New(api)
+	for range b.data {
+		tbl.Insert(0)
+	}
+	for i := range other.data {
+		tbl.Insert(other.data[i])
+	}
+	for range b.data {
+		tbl.Insert(0)
+	}
+	capacity := len(b.data) + len(other.data)
+	inds := make([]frontend.Variable, capacity)
+	for i := range inds {
+		inds[i] = api.Sub(i+len(b.data), b.length)
+	}
+	vals := tbl.Lookup(inds...)
+	for i := range b.data {
+		vals[i] = api.Add(vals[i], b.data[i])
+	}
+	return &Buffer{api: api, data: vals, length: api.Add(b.length, other.length)}
+}
+
+// Equal returns 1 if b and other have the same length and elements, and 0
+// otherwise. The capacities of the buffers may differ.
+func (b *Buffer) Equal(other *Buffer) frontend.Variable {
+	api := b.api
+	res := api.IsZero(api.Sub(b.length, other.length))
+	n := len(b.data)
+	if len(other.data) > n {
+		n = len(other.data)
+	}
+	for i := 0; i < n; i++ {
+		var x, y frontend.Variable = 0, 0
+		if i < len(b.data) {
+			x = b.data[i]
+		}
+		if i < len(other.data) {
+			y = other.data[i]
+		}
+		res = api.Mul(res, api.IsZero(api.Sub(x, y)))
+	}
+	return res
+}
+
+// IndexOf returns the position of the first occurrence of sub in b. The
+// position is computed out-of-circuit and the circuit asserts that sub occurs
+// in b at the returned position and that it does not occur at any earlier
+// position. If sub does not occur in b, then the circuit is not satisfiable.
+//
+// Checking the earlier positions compares sub at every position of b, so the
+// cost is proportional to b.Cap()*sub.Cap().
+func (b *Buffer) IndexOf(sub *Buffer) frontend.Variable {
+	return b.indexOf(sub, indexOfHint)
+}
+
+// indexOf implements [Buffer.IndexOf] with the given hint for computing the
+// position. It allows testing the circuit against a misbehaving prover.
+func (b *Buffer) indexOf(sub *Buffer, hint solver.Hint) frontend.Variable {
+	api := b.api
+	hintInputs := make([]frontend.Variable, 0, 4+len(b.data)+len(sub.data))
+	hintInputs = append(hintInputs, b.length, sub.length, len(b.data), len(sub.data))
+	hintInputs = append(hintInputs, b.data...)
+	hintInputs = append(hintInputs, sub.data...)
+	res, err := api.Compiler().NewHint(hint, 1, hintInputs...)
+	if err != nil {
+		panic(err)
+	}
+	idx := res[0]
+	// the suffix starting at idx must begin with sub. As sub is zero-padded,
+	// we only compare the prefix of the suffix of the same length.
+	suffix := b.Shift(idx)
+	rangecheck.New(api).Check(api.Sub(suffix.length, sub.length), bits.Len(uint(len(b.data))))
+	if len(sub.data) > len(suffix.data) {
+		// sub may still fit if its length is small. The extra elements of sub
+		// must be zero.
+		for i := len(suffix.data); i < len(sub.data); i++ {
+			api.AssertIsEqual(sub.data[i], 0)
+		}
+	}
+	prefix := suffix.prefix(sub.length)
+	for i := 0; i < len(prefix) && i < len(sub.data); i++ {
+		api.AssertIsEqual(prefix[i], sub.data[i])
+	}
+	// sub must not occur at any position i < idx. For such positions we have
+	// i + sub.Len() < idx + sub.Len() <= b.Len(), so the compared elements are
+	// within the buffer and we do not need to check the bounds. For the same
+	// reason, we can skip the elements outside the capacity as then i >= idx.
+	before := b.mask(idx)
+	subMask := sub.mask(sub.length)
+	for i := range b.data {
+		var occurs frontend.Variable = 1
+		for j := 0; j < len(sub.data) && i+j < len(b.data); j++ {
+			// the element matches if it is equal or after the end of sub
+			neq := api.Sub(1, api.IsZero(api.Sub(b.data[i+j], sub.data[j])))
+			occurs = api.Mul(occurs, api.Sub(1, api.Mul(subMask[j], neq)))
+		}
+		api.AssertIsEqual(api.Mul(before[i], occurs), 0)
+	}
+	return idx
+}
+
+// prefix returns the elements of b where the elements at positions not less
+// than n are set to zero. The value n must be in range [0, b.Cap()], otherwise
+// the circuit is not satisfiable.
+func (b *Buffer) prefix(n frontend.Variable) []frontend.Variable {
+	mask := b.mask(n)
+	res := make([]frontend.Variable, len(b.data))
+	for i := range res {
+		res[i] = b.api.Mul(mask[i], b.data[i])
+	}
+	return res
+}
+
+// mask returns for every position of b 1 if the position is less than n and 0
+// otherwise. The value n must be in range [0, b.Cap()], otherwise the circuit
+// is not satisfiable.
+func (b *Buffer) mask(n frontend.Variable) []frontend.Variable {
+	api := b.api
+	// the mask table is [1, ..., 1, 0, ..., 0] with cap ones and cap zeros. The
+	// mask for position i is at index i - n + cap. The lookup bounds n to
+	// [0, cap].
+	tbl := logderivlookup.New(api)
+	for range b.data {
+		tbl.Insert(1)
+	}
+	for range b.data {
+		tbl.Insert(0)
+	}
+	inds := make([]frontend.Variable, len(b.data))
+	for i := range inds {
+		inds[i] = api.Sub(i+len(b.data), n)
+	}
+	return tbl.Lookup(inds...)
+}
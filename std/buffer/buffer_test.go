@@ -0,0 +1,243 @@
+package buffer
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+func toVars(s string, capacity int) []frontend.Variable {
+	res := make([]frontend.Variable, capacity)
+	for i := range res {
+		if i < len(s) {
+			res[i] = s[i]
+		} else {
+			// garbage after the length is ignored
+			res[i] = 0xff
+		}
+	}
+	return res
+}
+
+type sliceCircuit struct {
+	Data          [10]frontend.Variable
+	Len           frontend.Variable
+	Start, End    frontend.Variable
+	Expected      [10]frontend.Variable
+	ExpectedLen   frontend.Variable
+	ShiftExpected [10]frontend.Variable
+}
+
+func (c *sliceCircuit) Define(api frontend.API) error {
+	b := New(api, c.Data[:], c.Len)
+	s := b.Slice(c.Start, c.End)
+	api.AssertIsEqual(s.Len(), c.ExpectedLen)
+	data := s.Data()
+	for i := range data {
+		api.AssertIsEqual(data[i], c.Expected[i])
+	}
+	sh := b.Shift(c.Start).Data()
+	for i := range sh {
+		api.AssertIsEqual(sh[i], c.ShiftExpected[i])
+	}
+	return nil
+}
+
+func TestSlice(t *testing.T) {
+	assert := test.NewAssert(t)
+	assignment := func(start, end int) *sliceCircuit {
+		s := "hello world"[:8]
+		var w sliceCircuit
+		copy(w.Data[:], toVars(s, 10))
+		w.Len = len(s)
+		w.Start, w.End = start, end
+		for i := range w.Expected {
+			w.Expected[i] = 0
+			w.ShiftExpected[i] = 0
+			if i < end-start {
+				w.Expected[i] = s[start+i]
+			}
+			if start+i < len(s) {
+				w.ShiftExpected[i] = s[start+i]
+			}
+		}
+		w.ExpectedLen = end - start
+		return &w
+	}
+	invalid := assignment(2, 5)
+	invalid.Start, invalid.End = 5, 9
+	assert.CheckCircuit(&sliceCircuit{},
+		test.WithValidAssignment(assignment(2, 5)),
+		test.WithValidAssignment(assignment(0, 8)),
+		test.WithValidAssignment(assignment(8, 8)),
+		test.WithValidAssignment(assignment(3, 3)),
+		test.WithInvalidAssignment(invalid),
+		test.WithCurves(ecc.BN254))
+}
+
+type concatCircuit struct {
+	A           [6]frontend.Variable
+	B           [4]frontend.Variable
+	LenA, LenB  frontend.Variable
+	Expected    [10]frontend.Variable
+	ExpectedLen frontend.Variable
+}
+
+func (c *concatCircuit) Define(api frontend.API) error {
+	a := New(api, c.A[:], c.LenA)
+	b := New(api, c.B[:], c.LenB)
+	res := a.Concat(b)
+	api.AssertIsEqual(res.Len(), c.ExpectedLen)
+	expected := New(api, c.Expected[:], c.ExpectedLen)
+	api.AssertIsEqual(res.Equal(expected), 1)
+	return nil
+}
+
+func TestConcat(t *testing.T) {
+	assert := test.NewAssert(t)
+	assignment := func(a, b, expected string) *concatCircuit {
+		var w concatCircuit
+		copy(w.A[:], toVars(a, 6))
+		copy(w.B[:], toVars(b, 4))
+		copy(w.Expected[:], toVars(expected, 10))
+		w.LenA, w.LenB, w.ExpectedLen = len(a), len(b), len(expected)
+		return &w
+	}
+	assert.CheckCircuit(&concatCircuit{},
+		test.WithValidAssignment(assignment("abc", "de", "abcde")),
+		test.WithValidAssignment(assignment("", "de", "de")),
+		test.WithValidAssignment(assignment("abcdef", "ghij", "abcdefghij")),
+		test.WithValidAssignment(assignment("", "", "")),
+		test.WithInvalidAssignment(assignment("abc", "de", "abced")),
+		test.WithCurves(ecc.BN254))
+}
+
+type equalCircuit struct {
+	A, B       [5]frontend.Variable
+	LenA, LenB frontend.Variable
+	Expected   frontend.Variable
+}
+
+func (c *equalCircuit) Define(api frontend.API) error {
+	a := New(api, c.A[:], c.LenA)
+	b := New(api, c.B[:3], c.LenB)
+	api.AssertIsEqual(a.Equal(b), c.Expected)
+	return nil
+}
+
+func TestEqual(t *testing.T) {
+	assert := test.NewAssert(t)
+	assignment := func(a, b string, expected int) *equalCircuit {
+		var w equalCircuit
+		copy(w.A[:], toVars(a, 5))
+		copy(w.B[:], toVars(b, 5))
+		w.LenA, w.LenB, w.Expected = len(a), len(b), expected
+		return &w
+	}
+	assert.CheckCircuit(&equalCircuit{},
+		test.WithValidAssignment(assignment("abc", "abc", 1)),
+		test.WithValidAssignment(assignment("ab", "abc", 0)),
+		test.WithValidAssignment(assignment("abd", "abc", 0)),
+		test.WithValidAssignment(assignment("", "", 1)),
+		test.WithInvalidAssignment(assignment("abc", "abc", 0)),
+		test.WithCurves(ecc.BN254))
+}
+
+type indexOfCircuit struct {
+	Data     [12]frontend.Variable
+	Sub      [4]frontend.Variable
+	Len      frontend.Variable
+	SubLen   frontend.Variable
+	Expected frontend.Variable
+}
+
+func (c *indexOfCircuit) Define(api frontend.API) error {
+	b := New(api, c.Data[:], c.Len)
+	sub := New(api, c.Sub[:], c.SubLen)
+	api.AssertIsEqual(b.IndexOf(sub), c.Expected)
+	return nil
+}
+
+func TestIndexOf(t *testing.T) {
+	assert := test.NewAssert(t)
+	assignment := func(s, sub string, expected int) *indexOfCircuit {
+		var w indexOfCircuit
+		copy(w.Data[:], toVars(s, 12))
+		copy(w.Sub[:], toVars(sub, 4))
+		w.Len, w.SubLen, w.Expected = len(s), len(sub), expected
+		return &w
+	}
+	assert.CheckCircuit(&indexOfCircuit{},
+		test.WithValidAssignment(assignment("from: alice", "al", 6)),
+		test.WithValidAssignment(assignment("from: alice", "from", 0)),
+		test.WithValidAssignment(assignment("from: alice", "ice", 8)),
+		test.WithValidAssignment(assignment("from: alice", "", 0)),
+		test.WithInvalidAssignment(assignment("from: alice", "bob", 0)),
+		// the substring is cut by the length of the buffer
+		test.WithInvalidAssignment(assignment("from: alice", "cex", 9)),
+		test.WithCurves(ecc.BN254))
+}
+
+// lastIndexOfHint returns the position of the last occurrence of a substring
+// for simulating a prover which does not return the first occurrence.
+func lastIndexOfHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	length, subLength := int(inputs[0].Int64()), int(inputs[1].Int64())
+	capacity := int(inputs[2].Int64())
+	data, sub := inputs[4:4+capacity], inputs[4+capacity:]
+	for i := length - subLength; i >= 0; i-- {
+		found := true
+		for j := 0; j < subLength; j++ {
+			if data[i+j].Cmp(sub[j]) != 0 {
+				found = false
+				break
+			}
+		}
+		if found {
+			outputs[0].SetInt64(int64(i))
+			return nil
+		}
+	}
+	return fmt.Errorf("substring not found")
+}
+
+func init() {
+	solver.RegisterHint(lastIndexOfHint)
+}
+
+type lastIndexOfCircuit struct {
+	Data     [12]frontend.Variable
+	Sub      [4]frontend.Variable
+	Len      frontend.Variable
+	SubLen   frontend.Variable
+	Expected frontend.Variable
+}
+
+func (c *lastIndexOfCircuit) Define(api frontend.API) error {
+	b := New(api, c.Data[:], c.Len)
+	sub := New(api, c.Sub[:], c.SubLen)
+	api.AssertIsEqual(b.indexOf(sub, lastIndexOfHint), c.Expected)
+	return nil
+}
+
+func TestIndexOfNotFirst(t *testing.T) {
+	assert := test.NewAssert(t)
+	assignment := func(s, sub string, expected int) *lastIndexOfCircuit {
+		var w lastIndexOfCircuit
+		copy(w.Data[:], toVars(s, 12))
+		copy(w.Sub[:], toVars(sub, 4))
+		w.Len, w.SubLen, w.Expected = len(s), len(sub), expected
+		return &w
+	}
+	assert.CheckCircuit(&lastIndexOfCircuit{},
+		// the last occurrence is also the first one
+		test.WithValidAssignment(assignment("from: alice", "al", 6)),
+		// there is an earlier occurrence
+		test.WithInvalidAssignment(assignment("abcabcabc", "bc", 7)),
+		test.WithInvalidAssignment(assignment("aaaa", "aa", 2)),
+		test.WithCurves(ecc.BN254))
+}
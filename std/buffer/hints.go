@@ -0,0 +1,49 @@
+package buffer
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/constraint/solver"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hint functions used in the package.
+func GetHints() []solver.Hint {
+	return []solver.Hint{indexOfHint}
+}
+
+// indexOfHint returns the position of the first occurrence of a substring. The
+// inputs are the lengths and capacities of the buffer and the substring, and
+// then the elements of the buffer and the substring.
+func indexOfHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) < 4 || len(outputs) != 1 {
+		return fmt.Errorf("expecting at least four inputs and a single output")
+	}
+	if !inputs[0].IsInt64() || !inputs[1].IsInt64() {
+		return fmt.Errorf("invalid lengths")
+	}
+	length, subLength := int(inputs[0].Int64()), int(inputs[1].Int64())
+	capacity, subCapacity := int(inputs[2].Int64()), int(inputs[3].Int64())
+	if len(inputs) != 4+capacity+subCapacity || length > capacity || subLength > subCapacity {
+		return fmt.Errorf("invalid number of inputs")
+	}
+	data, sub := inputs[4:4+capacity], inputs[4+capacity:]
+	for i := 0; i+subLength <= length; i++ {
+		found := true
+		for j := 0; j < subLength; j++ {
+			if data[i+j].Cmp(sub[j]) != 0 {
+				found = false
+				break
+			}
+		}
+		if found {
+			outputs[0].SetInt64(int64(i))
+			return nil
+		}
+	}
+	return fmt.Errorf("substring not found")
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/consensys/gnark/backend"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/std/algebra"
+	emulated_bls12377 "github.com/consensys/gnark/std/algebra/emulated/sw_bls12377"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bw6761"
@@ -187,6 +188,49 @@ func TestKZGVerificationEmulated3(t *testing.T) {
 	assert.CheckCircuit(&KZGVerificationCircuit[sw_bw6761.ScalarField, sw_bw6761.G1Affine, sw_bw6761.G2Affine, sw_bw6761.GTEl]{}, test.WithValidAssignment(&assignment), test.WithCurves(ecc.BN254))
 }
 
+func TestKZGVerificationEmulated4(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BLS12_377.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bls12377.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bls12377.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+
+	com, err := kzg_bls12377.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	var point fr_bls12377.Element
+	point.SetRandom()
+	proof, err := kzg_bls12377.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	if err = kzg_bls12377.Verify(&com, &proof, point, srs.Vk); err != nil {
+		t.Fatal("verify proof", err)
+	}
+
+	wCmt, err := ValueOfCommitment[emulated_bls12377.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine](proof)
+	assert.NoError(err)
+	wVk, err := ValueOfVerifyingKey[emulated_bls12377.G1Affine, emulated_bls12377.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wPt, err := ValueOfScalar[emulated_bls12377.ScalarField](point)
+	assert.NoError(err)
+
+	assignment := KZGVerificationCircuit[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCmt,
+		OpeningProof: wProof,
+		Point:        wPt,
+	}
+	assert.CheckCircuit(&KZGVerificationCircuit[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]{}, test.WithValidAssignment(&assignment), test.WithCurves(ecc.BN254))
+}
+
 func TestKZGVerificationTwoChain(t *testing.T) {
 	assert := test.NewAssert(t)
 
@@ -803,3 +847,94 @@ func TestKZGVerificationEmulated3Precomputed(t *testing.T) {
 	}
 	assert.CheckCircuit(&circuit, test.WithValidAssignment(&assignment), test.WithCurves(ecc.BN254))
 }
+
+func TestKZGVerificationEmulated4Precomputed(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BLS12_377.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bls12377.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bls12377.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+
+	com, err := kzg_bls12377.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	var point fr_bls12377.Element
+	point.SetRandom()
+	proof, err := kzg_bls12377.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	if err = kzg_bls12377.Verify(&com, &proof, point, srs.Vk); err != nil {
+		t.Fatal("verify proof", err)
+	}
+
+	wCmt, err := ValueOfCommitment[emulated_bls12377.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine](proof)
+	assert.NoError(err)
+	wVk, err := ValueOfVerifyingKeyFixed[emulated_bls12377.G1Affine, emulated_bls12377.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wPt, err := ValueOfScalar[emulated_bls12377.ScalarField](point)
+	assert.NoError(err)
+
+	assignment := KZGVerificationCircuit[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCmt,
+		OpeningProof: wProof,
+		Point:        wPt,
+	}
+	circuit := KZGVerificationCircuit[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]{
+		VerifyingKey: PlaceholderVerifyingKey[emulated_bls12377.G1Affine, emulated_bls12377.G2Affine](),
+	}
+	assert.CheckCircuit(&circuit, test.WithValidAssignment(&assignment), test.WithCurves(ecc.BN254))
+}
+
+func TestKZGVerificationEmulatedConstantVk(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	if err = kzg_bn254.Verify(&com, &proof, point, srs.Vk); err != nil {
+		t.Fatal("verify proof", err)
+	}
+
+	wCmt, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wVk, err := ValueOfVerifyingKeyFixed[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wPt, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	assignment := KZGVerificationConstantVkCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Commitment:   wCmt,
+		OpeningProof: wProof,
+		Point:        wPt,
+	}
+	circuit := KZGVerificationConstantVkCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		vk: wVk,
+	}
+	assert.CheckCircuit(&circuit, test.WithValidAssignment(&assignment), test.WithCurves(ecc.BN254))
+}
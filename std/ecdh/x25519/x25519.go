@@ -0,0 +1,132 @@
+// Package x25519 implements the X25519 Diffie-Hellman function as defined in
+// RFC 7748 in-circuit.
+//
+// The gadget matches the behaviour of [golang.org/x/crypto/curve25519]: the
+// scalars are clamped, the most significant bit of the input coordinate is
+// masked and the inputs and outputs are 32-byte little-endian encodings. As
+// [curve25519.X25519] returns an error when the result is the all-zero value
+// (the input point is of small order), the gadget asserts that the result is
+// not zero.
+//
+// The arithmetic is performed using the x-only Montgomery ladder over the
+// emulated base field of Curve25519, see
+// [github.com/consensys/gnark/std/algebra/emulated/montgomery].
+package x25519
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/montgomery"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+const (
+	// ScalarSize is the size of the scalar input in bytes.
+	ScalarSize = 32
+	// PointSize is the size of the point input and output in bytes.
+	PointSize = 32
+)
+
+// X25519 computes the X25519 function in-circuit.
+type X25519 struct {
+	api   frontend.API
+	uapi  *uints.BinaryField[uints.U32]
+	f     *emulated.Field[emulated.Curve25519Fp]
+	curve *montgomery.Curve[emulated.Curve25519Fp]
+}
+
+// New returns a new X25519 gadget.
+func New(api frontend.API) (*X25519, error) {
+	uapi, err := uints.New[uints.U32](api)
+	if err != nil {
+		return nil, fmt.Errorf("new uints api: %w", err)
+	}
+	f, err := emulated.NewField[emulated.Curve25519Fp](api)
+	if err != nil {
+		return nil, fmt.Errorf("new base api: %w", err)
+	}
+	curve, err := montgomery.New[emulated.Curve25519Fp](api, montgomery.GetCurve25519Params())
+	if err != nil {
+		return nil, fmt.Errorf("new curve: %w", err)
+	}
+	return &X25519{api: api, uapi: uapi, f: f, curve: curve}, nil
+}
+
+// X25519 returns the result of the scalar multiplication (scalar * point),
+// according to RFC 7748, Section 5. scalar and point are little-endian
+// encodings of length [ScalarSize] and [PointSize]. The result is the
+// little-endian encoding of the canonical u-coordinate of the result.
+//
+// It returns an error if the lengths of the inputs are invalid and asserts
+// that the result is not all zeros.
+func (x *X25519) X25519(scalar, point []uints.U8) ([]uints.U8, error) {
+	if len(scalar) != ScalarSize {
+		return nil, fmt.Errorf("bad scalar length: %d, expected %d", len(scalar), ScalarSize)
+	}
+	if len(point) != PointSize {
+		return nil, fmt.Errorf("bad point length: %d, expected %d", len(point), PointSize)
+	}
+	u := x.decodeU(point)
+	res := x.curve.ScalarMulX(u, x.decodeScalar(scalar))
+	return x.encodeU(res), nil
+}
+
+// ScalarBaseMult returns the result of the scalar multiplication (scalar *
+// base point), where the base point is the canonical generator u = 9. It is
+// equivalent to calling [X25519.X25519] with the encoded base point.
+func (x *X25519) ScalarBaseMult(scalar []uints.U8) ([]uints.U8, error) {
+	if len(scalar) != ScalarSize {
+		return nil, fmt.Errorf("bad scalar length: %d, expected %d", len(scalar), ScalarSize)
+	}
+	res := x.curve.ScalarMulBaseX(x.decodeScalar(scalar))
+	return x.encodeU(res), nil
+}
+
+// decodeScalar returns the bits of the clamped scalar, least significant
+// first. The three least significant bits are cleared, the most significant
+// bit is cleared and the second most significant bit is set.
+func (x *X25519) decodeScalar(scalar []uints.U8) []frontend.Variable {
+	bits := x.toBits(scalar)
+	bits[0], bits[1], bits[2] = 0, 0, 0
+	bits[254] = 1
+	return bits[:255]
+}
+
+// decodeU returns the u-coordinate given by its little-endian encoding with
+// the most significant bit masked. The value is not necessarily reduced, as
+// the non-canonical encodings are accepted.
+func (x *X25519) decodeU(point []uints.U8) *emulated.Element[emulated.Curve25519Fp] {
+	bits := x.toBits(point)
+	return x.f.FromBits(bits[:255]...)
+}
+
+// encodeU returns the little-endian encoding of the canonical representation
+// of u. It asserts that u is not zero.
+func (x *X25519) encodeU(u *emulated.Element[emulated.Curve25519Fp]) []uints.U8 {
+	x.api.AssertIsEqual(x.f.IsZero(u), 0)
+	bits := x.f.ToBitsCanonical(u)
+	res := make([]uints.U8, PointSize)
+	for i := range res {
+		var v frontend.Variable = 0
+		for j := 7; j >= 0; j-- {
+			v = x.api.Mul(v, 2)
+			if idx := 8*i + j; idx < len(bits) {
+				v = x.api.Add(v, bits[idx])
+			}
+		}
+		res[i] = x.uapi.ByteValueOf(v)
+	}
+	return res
+}
+
+// toBits returns the bits of the little-endian encoding in, least significant
+// first.
+func (x *X25519) toBits(in []uints.U8) []frontend.Variable {
+	res := make([]frontend.Variable, 0, 8*len(in))
+	for i := range in {
+		res = append(res, x.api.ToBinary(in[i].Val, 8)...)
+	}
+	return res
+}
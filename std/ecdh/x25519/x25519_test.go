@@ -0,0 +1,136 @@
+package x25519
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+	"golang.org/x/crypto/curve25519"
+)
+
+type x25519Circuit struct {
+	Scalar [ScalarSize]uints.U8
+	Point  [PointSize]uints.U8
+	Shared [PointSize]uints.U8
+}
+
+func (c *x25519Circuit) Define(api frontend.API) error {
+	x, err := New(api)
+	if err != nil {
+		return err
+	}
+	uapi, err := uints.New[uints.U32](api)
+	if err != nil {
+		return err
+	}
+	res, err := x.X25519(c.Scalar[:], c.Point[:])
+	if err != nil {
+		return err
+	}
+	for i := range res {
+		uapi.ByteAssertEq(res[i], c.Shared[i])
+	}
+	return nil
+}
+
+type scalarBaseMultCircuit struct {
+	Scalar [ScalarSize]uints.U8
+	Public [PointSize]uints.U8
+}
+
+func (c *scalarBaseMultCircuit) Define(api frontend.API) error {
+	x, err := New(api)
+	if err != nil {
+		return err
+	}
+	uapi, err := uints.New[uints.U32](api)
+	if err != nil {
+		return err
+	}
+	res, err := x.ScalarBaseMult(c.Scalar[:])
+	if err != nil {
+		return err
+	}
+	for i := range res {
+		uapi.ByteAssertEq(res[i], c.Public[i])
+	}
+	return nil
+}
+
+func randomBytes(t *testing.T, n int) []byte {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestX25519(t *testing.T) {
+	assert := test.NewAssert(t)
+	// shared secret from the point of view of Alice.
+	alice, bob := randomBytes(t, ScalarSize), randomBytes(t, ScalarSize)
+	bobPub, err := curve25519.X25519(bob, curve25519.Basepoint)
+	assert.NoError(err)
+	shared, err := curve25519.X25519(alice, bobPub)
+	assert.NoError(err)
+	var witness x25519Circuit
+	copy(witness.Scalar[:], uints.NewU8Array(alice))
+	copy(witness.Point[:], uints.NewU8Array(bobPub))
+	copy(witness.Shared[:], uints.NewU8Array(shared))
+	err = test.IsSolved(&x25519Circuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+func TestX25519NonCanonical(t *testing.T) {
+	assert := test.NewAssert(t)
+	// the most significant bit of the point is masked and the encodings of
+	// values larger than the modulus are accepted. u = 2^255 - 19 + 9 is a
+	// non-canonical encoding of the base point.
+	point := make([]byte, PointSize)
+	point[0] = 0xf6
+	for i := 1; i < PointSize; i++ {
+		point[i] = 0xff
+	}
+	scalar := randomBytes(t, ScalarSize)
+	shared, err := curve25519.X25519(scalar, point)
+	assert.NoError(err)
+	expected, err := curve25519.X25519(scalar, curve25519.Basepoint)
+	assert.NoError(err)
+	assert.Equal(expected, shared)
+	var witness x25519Circuit
+	copy(witness.Scalar[:], uints.NewU8Array(scalar))
+	copy(witness.Point[:], uints.NewU8Array(point))
+	copy(witness.Shared[:], uints.NewU8Array(shared))
+	err = test.IsSolved(&x25519Circuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+func TestX25519LowOrder(t *testing.T) {
+	assert := test.NewAssert(t)
+	// u = 0 is of order 2 and the result is all zeros.
+	scalar := randomBytes(t, ScalarSize)
+	point := make([]byte, PointSize)
+	_, err := curve25519.X25519(scalar, point)
+	assert.Error(err)
+	var witness x25519Circuit
+	copy(witness.Scalar[:], uints.NewU8Array(scalar))
+	copy(witness.Point[:], uints.NewU8Array(point))
+	copy(witness.Shared[:], uints.NewU8Array(make([]byte, PointSize)))
+	err = test.IsSolved(&x25519Circuit{}, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+func TestScalarBaseMult(t *testing.T) {
+	assert := test.NewAssert(t)
+	scalar := randomBytes(t, ScalarSize)
+	pub, err := curve25519.X25519(scalar, curve25519.Basepoint)
+	assert.NoError(err)
+	var witness scalarBaseMultCircuit
+	copy(witness.Scalar[:], uints.NewU8Array(scalar))
+	copy(witness.Public[:], uints.NewU8Array(pub))
+	err = test.IsSolved(&scalarBaseMultCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
@@ -0,0 +1,281 @@
+// Package base64 implements base64 encoding and decoding of byte strings as
+// specified by RFC 4648.
+//
+// The package provides the standard and URL-safe encodings with and without
+// padding, similarly to the standard library package [encoding/base64]. The
+// ASCII characters are mapped to their values using lookup tables from
+// [logderivlookup]. The lookup tables are shared between all calls in a
+// circuit with the same encoding.
+//
+// Same as the standard library, the decoding is not strict, i.e. the unused
+// bits of the last encoded block are ignored.
+package base64
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/internal/kvstore"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
+	"github.com/consensys/gnark/std/math/bitslice"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/std/rangecheck"
+	"github.com/consensys/gnark/std/selector"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hint functions used in the package.
+func GetHints() []solver.Hint {
+	return []solver.Hint{paddingHint}
+}
+
+const (
+	encodeStd = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+	encodeURL = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+
+	// padChar is the padding character.
+	padChar = '='
+	// invalid is the value of the characters not in the alphabet in the
+	// decoding table. It does not fit into 6 bits.
+	invalid = 64
+)
+
+// Encoding is a radix 64 encoding scheme defined by a 64-character alphabet.
+type Encoding struct {
+	alphabet string
+	padding  bool
+}
+
+var (
+	// StdEncoding is the standard base64 encoding with padding.
+	StdEncoding = &Encoding{alphabet: encodeStd, padding: true}
+	// URLEncoding is the URL-safe base64 encoding with padding. It is
+	// typically used in URLs and file names.
+	URLEncoding = &Encoding{alphabet: encodeURL, padding: true}
+	// RawStdEncoding is the standard base64 encoding without padding.
+	RawStdEncoding = &Encoding{alphabet: encodeStd}
+	// RawURLEncoding is the URL-safe base64 encoding without padding. It is
+	// used for example in JWT and WebAuthn.
+	RawURLEncoding = &Encoding{alphabet: encodeURL}
+)
+
+type ctxDecodeTableKey struct{ alphabet string }
+type ctxEncodeTableKey struct{ alphabet string }
+
+// EncodedLen returns the length of the base64 encoding of n bytes.
+func (enc *Encoding) EncodedLen(n int) int {
+	if enc.padding {
+		return (n + 2) / 3 * 4
+	}
+	return (n*8 + 5) / 6
+}
+
+// DecodedLen returns the maximum length of the decoded data corresponding to
+// n characters of base64-encoded data.
+func (enc *Encoding) DecodedLen(n int) int {
+	return (n + 3) / 4 * 3
+}
+
+// Encode returns the base64 encoding of in. The length of the output is
+// [Encoding.EncodedLen] of the input length. The bytes of the input are range
+// checked.
+func (enc *Encoding) Encode(api frontend.API, in []uints.U8) []uints.U8 {
+	tbl := enc.encodeTable(api)
+	rchecker := rangecheck.New(api)
+	sextets := make([]frontend.Variable, 0, len(in)/3*4+4)
+	for i := 0; i < len(in); i += 3 {
+		// n = b₀ 2¹⁶ + b₁ 2⁸ + b₂, where missing bytes are zero
+		var n frontend.Variable = 0
+		for j := 0; j < 3; j++ {
+			n = api.Mul(n, 256)
+			if i+j < len(in) {
+				rchecker.Check(in[i+j].Val, 8)
+				n = api.Add(n, in[i+j].Val)
+			}
+		}
+		lo, s0 := bitslice.Partition(api, n, 18, bitslice.WithNbDigits(24))
+		lo, s1 := bitslice.Partition(api, lo, 12, bitslice.WithNbDigits(18))
+		s3, s2 := bitslice.Partition(api, lo, 6, bitslice.WithNbDigits(12))
+		sextets = append(sextets, s0, s1, s2, s3)
+	}
+	nbChars := (len(in)*8 + 5) / 6
+	chars := tbl.Lookup(sextets[:nbChars]...)
+	res := make([]uints.U8, enc.EncodedLen(len(in)))
+	for i := range res {
+		if i < len(chars) {
+			res[i] = uints.U8{Val: chars[i]}
+		} else {
+			res[i] = uints.NewU8(padChar)
+		}
+	}
+	return res
+}
+
+// Decode decodes the first length characters of the base64 string in. It
+// returns the decoded bytes and the number of decoded bytes. The length of the
+// output is [Encoding.DecodedLen] of the input length. The characters at
+// positions not less than length are ignored and the output bytes after the
+// decoded length are zero.
+//
+// The length must be in range [0, len(in)] and the characters must be valid,
+// otherwise the circuit is not satisfiable. For encodings with padding, the
+// length must be a multiple of four and the encoded string may end with up to
+// two padding characters. For encodings without padding, the length modulo
+// four must not be one.
+func (enc *Encoding) Decode(api frontend.API, in []uints.U8, length frontend.Variable) ([]uints.U8, frontend.Variable, error) {
+	if len(in) == 0 {
+		api.AssertIsEqual(length, 0)
+		return nil, 0, nil
+	}
+	tbl := enc.decodeTable(api)
+	rchecker := rangecheck.New(api)
+	nbBits := bits.Len(uint(len(in)))
+	rem, nbBlocks := bitslice.Partition(api, length, 2, bitslice.WithNbDigits(nbBits))
+	// the masks below allow length to be len(in)+1. As length is small, the
+	// difference wraps around the modulus if length > len(in) and the check
+	// fails.
+	rchecker.Check(api.Sub(len(in), length), nbBits)
+
+	inRange := prefixMask(api, length, len(in))
+	// the number of data characters. The characters after that (but before
+	// length) are padding characters.
+	dataLength := length
+	var outLength frontend.Variable
+	if enc.padding {
+		api.AssertIsEqual(rem, 0)
+		vals := make([]frontend.Variable, len(in)+1)
+		vals[0] = length
+		for i := range in {
+			vals[i+1] = in[i].Val
+		}
+		res, err := api.Compiler().NewHint(paddingHint, 1, vals...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("hint: %w", err)
+		}
+		nbPad := res[0]
+		// nbPad ∈ {0, 1, 2}
+		api.AssertIsEqual(api.Mul(nbPad, api.Sub(nbPad, 1), api.Sub(nbPad, 2)), 0)
+		dataLength = api.Sub(length, nbPad)
+		outLength = api.Sub(api.Mul(nbBlocks, 3), nbPad)
+	} else {
+		// rem ∈ {0, 2, 3} and the last block gives rem-1 bytes
+		api.AssertIsDifferent(rem, 1)
+		outLength = api.Add(api.Mul(nbBlocks, 3), rem, api.IsZero(rem), -1)
+	}
+	isData := prefixMask(api, dataLength, len(in))
+
+	// we replace all characters after the data by the first character of the
+	// alphabet which has value 0. The padding characters must be padChar.
+	chars := make([]frontend.Variable, len(in))
+	for i := range in {
+		chars[i] = api.Add(api.Mul(isData[i], api.Sub(in[i].Val, enc.alphabet[0])), enc.alphabet[0])
+		if enc.padding {
+			isPad := api.Sub(inRange[i], isData[i])
+			api.AssertIsEqual(api.Mul(isPad, api.Sub(in[i].Val, padChar)), 0)
+		}
+	}
+	sextets := tbl.Lookup(chars...)
+	for i := range sextets {
+		rchecker.Check(sextets[i], 6)
+	}
+	for len(sextets)%4 != 0 {
+		sextets = append(sextets, 0)
+	}
+
+	res := make([]frontend.Variable, 0, enc.DecodedLen(len(in)))
+	for i := 0; i < len(sextets); i += 4 {
+		// n = s₀ 2¹⁸ + s₁ 2¹² + s₂ 2⁶ + s₃
+		var n frontend.Variable = 0
+		for j := 0; j < 4; j++ {
+			n = api.Add(api.Mul(n, 64), sextets[i+j])
+		}
+		lo, b0 := bitslice.Partition(api, n, 16, bitslice.WithNbDigits(24))
+		b2, b1 := bitslice.Partition(api, lo, 8, bitslice.WithNbDigits(16))
+		res = append(res, b0, b1, b2)
+	}
+	// zero the unused bits of the last block
+	outMask := prefixMask(api, outLength, len(res))
+	out := make([]uints.U8, len(res))
+	for i := range res {
+		out[i] = uints.U8{Val: api.Mul(outMask[i], res[i])}
+	}
+	return out, outLength, nil
+}
+
+// prefixMask returns a vector of size n where the first length elements are 1
+// and the rest are 0.
+func prefixMask(api frontend.API, length frontend.Variable, n int) []frontend.Variable {
+	// partitioning requires at least two elements, we add an extra element and
+	// ignore it.
+	ones := make([]frontend.Variable, n+1)
+	for i := range ones {
+		ones[i] = 1
+	}
+	return selector.Partition(api, length, false, ones)[:n]
+}
+
+func (enc *Encoding) decodeTable(api frontend.API) *logderivlookup.Table {
+	kv, ok := api.Compiler().(kvstore.Store)
+	if !ok {
+		panic("builder should implement key-value store")
+	}
+	key := ctxDecodeTableKey{alphabet: enc.alphabet}
+	if tbl, ok := kv.GetKeyValue(key).(*logderivlookup.Table); ok {
+		return tbl
+	}
+	var vals [256]int
+	for i := range vals {
+		vals[i] = invalid
+	}
+	for i := range enc.alphabet {
+		vals[enc.alphabet[i]] = i
+	}
+	tbl := logderivlookup.New(api)
+	for i := range vals {
+		tbl.Insert(vals[i])
+	}
+	kv.SetKeyValue(key, tbl)
+	return tbl
+}
+
+func (enc *Encoding) encodeTable(api frontend.API) *logderivlookup.Table {
+	kv, ok := api.Compiler().(kvstore.Store)
+	if !ok {
+		panic("builder should implement key-value store")
+	}
+	key := ctxEncodeTableKey{alphabet: enc.alphabet}
+	if tbl, ok := kv.GetKeyValue(key).(*logderivlookup.Table); ok {
+		return tbl
+	}
+	tbl := logderivlookup.New(api)
+	for i := range enc.alphabet {
+		tbl.Insert(enc.alphabet[i])
+	}
+	kv.SetKeyValue(key, tbl)
+	return tbl
+}
+
+// paddingHint returns the number of trailing padding characters. The inputs
+// are the length of the encoded string and the characters.
+func paddingHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) < 1 || len(outputs) != 1 {
+		return fmt.Errorf("expecting at least one input and a single output")
+	}
+	length := int(inputs[0].Int64())
+	chars := inputs[1:]
+	if !inputs[0].IsInt64() || length > len(chars) {
+		return fmt.Errorf("invalid length")
+	}
+	nbPad := 0
+	for i := length - 1; i >= 0 && chars[i].Cmp(big.NewInt(padChar)) == 0; i-- {
+		nbPad++
+	}
+	outputs[0].SetInt64(int64(nbPad))
+	return nil
+}
@@ -0,0 +1,149 @@
+package base64
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+var encodings = []struct {
+	name string
+	enc  *Encoding
+	std  *base64.Encoding
+}{
+	{"std", StdEncoding, base64.StdEncoding},
+	{"url", URLEncoding, base64.URLEncoding},
+	{"rawstd", RawStdEncoding, base64.RawStdEncoding},
+	{"rawurl", RawURLEncoding, base64.RawURLEncoding},
+}
+
+type encodeCircuit struct {
+	enc      *Encoding
+	In       []uints.U8
+	Expected []uints.U8
+}
+
+func (c *encodeCircuit) Define(api frontend.API) error {
+	res := c.enc.Encode(api, c.In)
+	if len(res) != len(c.Expected) {
+		return fmt.Errorf("length mismatch %d != %d", len(res), len(c.Expected))
+	}
+	for i := range res {
+		api.AssertIsEqual(res[i].Val, c.Expected[i].Val)
+	}
+	return nil
+}
+
+func TestEncode(t *testing.T) {
+	assert := test.NewAssert(t)
+	data := []byte("\xfb\xff\xbe hello, gnark!")
+	for _, tc := range encodings {
+		for _, n := range []int{1, 2, 3, 4, 5, 16} {
+			tc, n := tc, n
+			assert.Run(func(assert *test.Assert) {
+				expected := tc.std.EncodeToString(data[:n])
+				circuit := &encodeCircuit{enc: tc.enc, In: make([]uints.U8, n), Expected: make([]uints.U8, len(expected))}
+				assignment := &encodeCircuit{In: uints.NewU8Array(data[:n]), Expected: uints.NewU8Array([]byte(expected))}
+				assert.CheckCircuit(circuit, test.WithValidAssignment(assignment), test.WithCurves(ecc.BN254), test.NoFuzzing())
+			}, tc.name, fmt.Sprintf("len=%d", n))
+		}
+	}
+}
+
+type decodeCircuit struct {
+	enc         *Encoding
+	In          []uints.U8
+	Length      frontend.Variable
+	Expected    []uints.U8
+	ExpectedLen frontend.Variable
+}
+
+func (c *decodeCircuit) Define(api frontend.API) error {
+	res, length, err := c.enc.Decode(api, c.In, c.Length)
+	if err != nil {
+		return err
+	}
+	if len(res) != len(c.Expected) {
+		return fmt.Errorf("length mismatch %d != %d", len(res), len(c.Expected))
+	}
+	api.AssertIsEqual(length, c.ExpectedLen)
+	for i := range res {
+		api.AssertIsEqual(res[i].Val, c.Expected[i].Val)
+	}
+	return nil
+}
+
+func decodeAssignment(enc *Encoding, encoded string, capacity int, decoded []byte) *decodeCircuit {
+	in := make([]byte, capacity)
+	copy(in, encoded)
+	for i := len(encoded); i < capacity; i++ {
+		// garbage after the length
+		in[i] = '?'
+	}
+	expected := make([]byte, enc.DecodedLen(capacity))
+	copy(expected, decoded)
+	return &decodeCircuit{
+		In:          uints.NewU8Array(in),
+		Length:      len(encoded),
+		Expected:    uints.NewU8Array(expected),
+		ExpectedLen: len(decoded),
+	}
+}
+
+func TestDecode(t *testing.T) {
+	assert := test.NewAssert(t)
+	const capacity = 12
+	data := []byte("\xfb\xff\xbe gnark")
+	for _, tc := range encodings {
+		tc := tc
+		assert.Run(func(assert *test.Assert) {
+			circuit := &decodeCircuit{enc: tc.enc, In: make([]uints.U8, capacity), Expected: make([]uints.U8, tc.enc.DecodedLen(capacity))}
+			opts := []test.TestingOption{test.WithCurves(ecc.BN254), test.NoFuzzing()}
+			for n := 0; n <= 8; n++ {
+				encoded := tc.std.EncodeToString(data[:n])
+				opts = append(opts, test.WithValidAssignment(decodeAssignment(tc.enc, encoded, capacity, data[:n])))
+			}
+			// invalid character
+			opts = append(opts, test.WithInvalidAssignment(decodeAssignment(tc.enc, "Zm9v!mFy", capacity, []byte("foo\x00ar"))))
+			// wrong decoded value
+			opts = append(opts, test.WithInvalidAssignment(decodeAssignment(tc.enc, "Zm9vYmFy", capacity, []byte("foobaz"))))
+			if tc.enc.padding {
+				// padding in the middle
+				opts = append(opts, test.WithInvalidAssignment(decodeAssignment(tc.enc, "Zg==Zm9v", capacity, []byte("ffoo"))))
+				// missing padding
+				opts = append(opts, test.WithInvalidAssignment(decodeAssignment(tc.enc, "Zm8", capacity, []byte("fo"))))
+			} else {
+				// padding not allowed
+				opts = append(opts, test.WithInvalidAssignment(decodeAssignment(tc.enc, "Zm8=", capacity, []byte("fo"))))
+				// invalid length
+				opts = append(opts, test.WithInvalidAssignment(decodeAssignment(tc.enc, "Zm9vY", capacity, []byte("foo"))))
+			}
+			assert.CheckCircuit(circuit, opts...)
+		}, tc.name)
+	}
+}
+
+func TestDecodeLengthOutOfRange(t *testing.T) {
+	assert := test.NewAssert(t)
+	const capacity = 11
+	data := []byte("\xfb\xff\xbe gnark")
+	for _, tc := range encodings {
+		tc := tc
+		assert.Run(func(assert *test.Assert) {
+			circuit := &decodeCircuit{enc: tc.enc, In: make([]uints.U8, capacity), Expected: make([]uints.U8, tc.enc.DecodedLen(capacity))}
+			// the missing last character would be decoded as the first
+			// character of the alphabet.
+			encoded := tc.std.EncodeToString(data[:8])[:capacity]
+			decoded, err := tc.std.DecodeString(encoded + tc.enc.alphabet[:1])
+			assert.NoError(err)
+			assignment := decodeAssignment(tc.enc, encoded, capacity, decoded)
+			assignment.Length = capacity + 1
+			assert.CheckCircuit(circuit, test.WithInvalidAssignment(assignment), test.WithCurves(ecc.BN254), test.NoFuzzing())
+		}, tc.name)
+	}
+}
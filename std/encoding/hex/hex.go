@@ -0,0 +1,134 @@
+// Package hex implements hexadecimal encoding and decoding of byte strings.
+//
+// The ASCII characters are mapped to their values using lookup tables from
+// [logderivlookup]. The lookup tables are shared between all calls in a
+// circuit. When decoding, the characters are validated to be in the alphabet
+// [0-9a-fA-F]. When encoding, we use lower-case characters.
+package hex
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/internal/kvstore"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
+	"github.com/consensys/gnark/std/math/bitslice"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/std/rangecheck"
+	"github.com/consensys/gnark/std/selector"
+)
+
+const alphabet = "0123456789abcdef"
+
+// invalid is the value of the characters not in the alphabet in the decoding
+// table. It does not fit into 4 bits.
+const invalid = 16
+
+type ctxDecodeTableKey struct{}
+type ctxEncodeTableKey struct{}
+
+// Encode returns the hexadecimal encoding of in. The length of the output is
+// twice the length of the input. The bytes of the input are range checked.
+func Encode(api frontend.API, in []uints.U8) []uints.U8 {
+	tbl := encodeTable(api)
+	nibbles := make([]frontend.Variable, 0, 2*len(in))
+	for i := range in {
+		lo, hi := bitslice.Partition(api, in[i].Val, 4, bitslice.WithNbDigits(8))
+		nibbles = append(nibbles, hi, lo)
+	}
+	chars := tbl.Lookup(nibbles...)
+	res := make([]uints.U8, len(chars))
+	for i := range chars {
+		res[i] = uints.U8{Val: chars[i]}
+	}
+	return res
+}
+
+// Decode decodes the first length characters of the hexadecimal string in. It
+// returns the decoded bytes and the number of decoded bytes, which is length/2.
+// The characters at positions not less than length are ignored and the
+// corresponding output bytes are zero. The length must be even and in range
+// [0, len(in)] and the characters must be valid, otherwise the circuit is not
+// satisfiable. It returns an error if len(in) is odd.
+func Decode(api frontend.API, in []uints.U8, length frontend.Variable) ([]uints.U8, frontend.Variable, error) {
+	if len(in)%2 != 0 {
+		return nil, nil, fmt.Errorf("input length %d is odd", len(in))
+	}
+	if len(in) == 0 {
+		api.AssertIsEqual(length, 0)
+		return nil, 0, nil
+	}
+	tbl := decodeTable(api)
+	rchecker := rangecheck.New(api)
+	// replace the characters after the length with '0'
+	mask := prefixMask(api, length, len(in))
+	chars := make([]frontend.Variable, len(in))
+	for i := range in {
+		chars[i] = api.Add(api.Mul(mask[i], api.Sub(in[i].Val, '0')), '0')
+	}
+	nibbles := tbl.Lookup(chars...)
+	for i := range nibbles {
+		rchecker.Check(nibbles[i], 4)
+	}
+	res := make([]uints.U8, len(in)/2)
+	for i := range res {
+		res[i] = uints.U8{Val: api.Add(api.Mul(nibbles[2*i], 16), nibbles[2*i+1])}
+	}
+	odd, outLength := bitslice.Partition(api, length, 1, bitslice.WithNbDigits(bits.Len(uint(len(in)))))
+	api.AssertIsEqual(odd, 0)
+	return res, outLength, nil
+}
+
+// prefixMask returns a vector of size n where the first length elements are 1
+// and the rest are 0.
+func prefixMask(api frontend.API, length frontend.Variable, n int) []frontend.Variable {
+	// partitioning requires at least two elements, we add an extra element and
+	// ignore it.
+	ones := make([]frontend.Variable, n+1)
+	for i := range ones {
+		ones[i] = 1
+	}
+	return selector.Partition(api, length, false, ones)[:n]
+}
+
+func decodeTable(api frontend.API) *logderivlookup.Table {
+	kv, ok := api.Compiler().(kvstore.Store)
+	if !ok {
+		panic("builder should implement key-value store")
+	}
+	if tbl, ok := kv.GetKeyValue(ctxDecodeTableKey{}).(*logderivlookup.Table); ok {
+		return tbl
+	}
+	tbl := logderivlookup.New(api)
+	for c := 0; c < 256; c++ {
+		switch {
+		case c >= '0' && c <= '9':
+			tbl.Insert(c - '0')
+		case c >= 'a' && c <= 'f':
+			tbl.Insert(c - 'a' + 10)
+		case c >= 'A' && c <= 'F':
+			tbl.Insert(c - 'A' + 10)
+		default:
+			tbl.Insert(invalid)
+		}
+	}
+	kv.SetKeyValue(ctxDecodeTableKey{}, tbl)
+	return tbl
+}
+
+func encodeTable(api frontend.API) *logderivlookup.Table {
+	kv, ok := api.Compiler().(kvstore.Store)
+	if !ok {
+		panic("builder should implement key-value store")
+	}
+	if tbl, ok := kv.GetKeyValue(ctxEncodeTableKey{}).(*logderivlookup.Table); ok {
+		return tbl
+	}
+	tbl := logderivlookup.New(api)
+	for i := range alphabet {
+		tbl.Insert(alphabet[i])
+	}
+	kv.SetKeyValue(ctxEncodeTableKey{}, tbl)
+	return tbl
+}
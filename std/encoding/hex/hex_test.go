@@ -0,0 +1,87 @@
+package hex
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+type encodeCircuit struct {
+	In       [5]uints.U8
+	Expected [10]uints.U8
+}
+
+func (c *encodeCircuit) Define(api frontend.API) error {
+	res := Encode(api, c.In[:])
+	for i := range res {
+		api.AssertIsEqual(res[i].Val, c.Expected[i].Val)
+	}
+	return nil
+}
+
+func TestEncode(t *testing.T) {
+	assert := test.NewAssert(t)
+	data := []byte{0x00, 0x7f, 0xab, 0xff, 0x10}
+	var assignment encodeCircuit
+	copy(assignment.In[:], uints.NewU8Array(data))
+	copy(assignment.Expected[:], uints.NewU8Array([]byte(hex.EncodeToString(data))))
+	assert.CheckCircuit(&encodeCircuit{}, test.WithValidAssignment(&assignment), test.WithCurves(ecc.BN254))
+}
+
+type decodeCircuit struct {
+	In          [10]uints.U8
+	Length      frontend.Variable
+	Expected    [5]uints.U8
+	ExpectedLen frontend.Variable
+}
+
+func (c *decodeCircuit) Define(api frontend.API) error {
+	res, length, err := Decode(api, c.In[:], c.Length)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(length, c.ExpectedLen)
+	for i := range res {
+		api.AssertIsEqual(res[i].Val, c.Expected[i].Val)
+	}
+	return nil
+}
+
+func decodeAssignment(encoded string, decoded []byte) *decodeCircuit {
+	var w decodeCircuit
+	for i := range w.In {
+		// garbage after the length
+		w.In[i] = uints.NewU8('x')
+		if i < len(encoded) {
+			w.In[i] = uints.NewU8(encoded[i])
+		}
+	}
+	for i := range w.Expected {
+		w.Expected[i] = uints.NewU8(0)
+		if i < len(decoded) {
+			w.Expected[i] = uints.NewU8(decoded[i])
+		}
+	}
+	w.Length = len(encoded)
+	w.ExpectedLen = len(decoded)
+	return &w
+}
+
+func TestDecode(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.CheckCircuit(&decodeCircuit{},
+		test.WithValidAssignment(decodeAssignment("007fabff10", []byte{0x00, 0x7f, 0xab, 0xff, 0x10})),
+		test.WithValidAssignment(decodeAssignment("DEADbeef", []byte{0xde, 0xad, 0xbe, 0xef})),
+		test.WithValidAssignment(decodeAssignment("", nil)),
+		// invalid character
+		test.WithInvalidAssignment(decodeAssignment("0g", []byte{0x00})),
+		// odd length
+		test.WithInvalidAssignment(decodeAssignment("abc", []byte{0xab, 0x0c})),
+		// wrong value
+		test.WithInvalidAssignment(decodeAssignment("abcd", []byte{0xab, 0xce})),
+		test.WithCurves(ecc.BN254))
+}
@@ -0,0 +1,360 @@
+// Package json implements parsing JSON documents and extracting fields
+// in-circuit.
+//
+// The document is processed by a streaming tokenizer, which consumes one byte
+// at a time. The tokenizer is a deterministic finite automaton over classes of
+// bytes, where the transitions are looked up from tables using
+// [logderivlookup]. As JSON is not a regular language, we additionally keep a
+// stack of the container types (object or array) in a read-write [memory]. The
+// nesting depth of the document is bounded by a compile-time parameter.
+//
+// The tokenizer validates the structure of the document (matching brackets,
+// keys, colons and commas, string escapes and the literals true, false and
+// null). The escape sequences are validated to be one of \" \\ \/ \b \f \n
+// \r \t or \u followed by four hexadecimal digits, but they are not decoded.
+// Numbers are validated leniently: any sequence of digits, signs, decimal
+// points and exponent markers is accepted.
+//
+// The fields are extracted by a second pass over the tokens, where we track
+// how many keys of the path the current position is nested in. The keys are
+// compared byte by byte. As the escape sequences are not decoded, a key
+// containing an escape sequence may be an alternative encoding of a key in the
+// path (for example "s\u0075b" of "sub"). Such keys make the extracted field
+// invalid when they appear where a key of the path is looked up. The cost of
+// parsing and extracting is linear in the capacity of the document.
+package json
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/internal/kvstore"
+	"github.com/consensys/gnark/std/buffer"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/std/memory"
+	"github.com/consensys/gnark/std/rangecheck"
+	"github.com/consensys/gnark/std/selector"
+)
+
+// DefaultMaxDepth is the default maximum nesting depth of the documents.
+const DefaultMaxDepth = 8
+
+type parseConfig struct {
+	maxDepth int
+}
+
+// Option allows to configure the parsing.
+type Option func(*parseConfig) error
+
+// WithMaxDepth sets the maximum nesting depth of the document. If the document
+// is nested deeper, then the circuit is not satisfiable. The cost of the stack
+// is linear in the depth. Defaults to [DefaultMaxDepth].
+func WithMaxDepth(depth int) Option {
+	return func(c *parseConfig) error {
+		if depth <= 0 {
+			return fmt.Errorf("max depth must be positive")
+		}
+		c.maxDepth = depth
+		return nil
+	}
+}
+
+// Document is a tokenized JSON document.
+type Document struct {
+	api frontend.API
+
+	// the bytes of the document. The bytes after the length are replaced
+	// with spaces.
+	chars []frontend.Variable
+	// depths[i] is the nesting depth before consuming byte i.
+	depths []frontend.Variable
+	// the flags of the transitions, see [transition].
+	value, keyStart, keyEnd, valueEnd []frontend.Variable
+
+	isValid frontend.Variable
+}
+
+// Value is a value extracted from a document.
+type Value struct {
+	// Data is the value. For strings it is the content between the quotes
+	// without decoding the escape sequences, for other values it is the raw
+	// serialization. The capacity is the capacity of the document and the
+	// bytes after Length are zero.
+	Data []uints.U8
+	// Length is the length of the value.
+	Length frontend.Variable
+	// IsValid is 1 if the document is valid and the field exists exactly
+	// once, and 0 otherwise. It is also 0 if a key containing an escape
+	// sequence is at the position of a key of the path, as then we cannot
+	// decide if the field exists exactly once. If the field is not valid,
+	// then Length is 0.
+	IsValid frontend.Variable
+}
+
+// Parse tokenizes the first length bytes of in as a JSON document. The length
+// must be in range [0, len(in)], otherwise the circuit is not satisfiable. The
+// validity of the document is returned by [Document.IsValid]. It returns an
+// error if the options are invalid.
+func Parse(api frontend.API, in []uints.U8, length frontend.Variable, opts ...Option) (*Document, error) {
+	cfg := parseConfig{maxDepth: DefaultMaxDepth}
+	for _, o := range opts {
+		if err := o(&cfg); err != nil {
+			return nil, fmt.Errorf("apply option: %w", err)
+		}
+	}
+	if len(in) == 0 {
+		api.AssertIsEqual(length, 0)
+		return &Document{api: api, isValid: 0}, nil
+	}
+	tbls := getLexerTables(api)
+
+	// replace the bytes after the length with spaces. As spaces are allowed
+	// after the document, this does not change the validity.
+	mask := prefixMask(api, length, len(in))
+	// the mask allows length to be len(in)+1. As length is small, the
+	// difference wraps around the modulus if length > len(in) and the check
+	// fails.
+	rangecheck.New(api).Check(api.Sub(len(in), length), bits.Len(uint(len(in))))
+	chars := make([]frontend.Variable, len(in))
+	for i := range in {
+		chars[i] = api.Add(api.Mul(mask[i], api.Sub(in[i].Val, ' ')), ' ')
+	}
+	// the lookup also bounds the bytes to [0, 256)
+	classes := tbls.class.Lookup(chars...)
+	// we consume an additional space to complete the trailing primitive
+	// value.
+	classes = append(classes, cSpace)
+
+	stackInit := make([]frontend.Variable, cfg.maxDepth+1)
+	stackInit[0] = tTop
+	for i := 1; i < len(stackInit); i++ {
+		stackInit[i] = tObject
+	}
+	stack := memory.New(api, stackInit)
+
+	doc := &Document{
+		api:      api,
+		chars:    chars,
+		depths:   make([]frontend.Variable, len(in)),
+		value:    make([]frontend.Variable, len(in)),
+		keyStart: make([]frontend.Variable, len(in)),
+		keyEnd:   make([]frontend.Variable, len(in)),
+		valueEnd: make([]frontend.Variable, len(in)),
+	}
+	var state, depth frontend.Variable = initialState, 0
+	for i := range classes {
+		// keep the type of the innermost container on top of the stack, so
+		// that we can restore it when closing the nested containers.
+		stack.Write(depth, tbls.typ.Lookup(state)[0])
+		idx := api.Add(api.Mul(state, nbClasses), classes[i])
+		next := tbls.next.Lookup(idx)[0]
+		push := tbls.push.Lookup(idx)[0]
+		pop := tbls.pop.Lookup(idx)[0]
+		if i < len(in) {
+			doc.depths[i] = depth
+			doc.value[i] = tbls.value.Lookup(idx)[0]
+			doc.keyStart[i] = tbls.keyStart.Lookup(idx)[0]
+			doc.keyEnd[i] = tbls.keyEnd.Lookup(idx)[0]
+			doc.valueEnd[i] = tbls.valueEnd.Lookup(idx)[0]
+		}
+		depth = api.Add(depth, api.Sub(push, pop))
+		parent := stack.Read(depth)
+		state = api.Add(next, api.Mul(pop, parent))
+	}
+	doc.isValid = api.IsZero(api.Sub(state, finalState))
+	return doc, nil
+}
+
+// IsValid returns 1 if the document is valid and 0 otherwise.
+func (d *Document) IsValid() frontend.Variable {
+	return d.isValid
+}
+
+// ExtractField returns the value of the field at path. The path is the
+// sequence of keys of the nested objects from the root object. It returns an
+// error if the path is empty or if a key contains a character which has to be
+// escaped in JSON (quote, backslash or a control character).
+func (d *Document) ExtractField(path ...string) (*Value, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("empty path")
+	}
+	for i := range path {
+		for j := 0; j < len(path[i]); j++ {
+			if c := path[i][j]; c == '"' || c == '\\' || c < 0x20 {
+				return nil, fmt.Errorf("path key %q contains character %q which has to be escaped", path[i], c)
+			}
+		}
+	}
+	api := d.api
+	if len(d.chars) == 0 {
+		return &Value{Length: 0, IsValid: 0}, nil
+	}
+	m := len(path)
+	maxKeyLen := 0
+	for i := range path {
+		if len(path[i]) > maxKeyLen {
+			maxKeyLen = len(path[i])
+		}
+	}
+	// the progress of matching the current key against path[nbMatched]. It is
+	// the number of matched bytes, noMatch or escaped if the key contains a
+	// backslash.
+	noMatch := maxKeyLen + 1
+	escaped := noMatch + 1
+	nbProgress := escaped + 1
+	// the key matching automaton. The next progress for the number of matched
+	// path elements k, progress p and byte c is at index (k*nbProgress+p)*256+c.
+	progressTbl := logderivlookup.New(api)
+	for k := 0; k <= m; k++ {
+		for p := 0; p < nbProgress; p++ {
+			for c := 0; c < 256; c++ {
+				switch {
+				case p == escaped || c == '\\':
+					progressTbl.Insert(escaped)
+				case k < m && p < len(path[k]) && int(path[k][p]) == c:
+					progressTbl.Insert(p + 1)
+				default:
+					progressTbl.Insert(noMatch)
+				}
+			}
+		}
+	}
+	// the lengths of the path elements. We use an unreachable progress after
+	// the full path is matched.
+	keyLenTbl := logderivlookup.New(api)
+	for k := 0; k < m; k++ {
+		keyLenTbl.Insert(len(path[k]))
+	}
+	keyLenTbl.Insert(nbProgress)
+
+	// nbMatched is the number of path elements the current position is nested
+	// in. The matched key at depth k+1 increments it and the end of the value at
+	// the same depth decrements it.
+	var nbMatched, progress frontend.Variable = 0, noMatch
+	var prevInPath, prevInValue frontend.Variable = 0, 0
+	var found, start, length frontend.Variable = 0, 0, 0
+	// the number of escaped keys at the positions of the keys of the path
+	var nbEscaped frontend.Variable = 0
+	masked := make([]frontend.Variable, len(d.chars))
+	for i := range d.chars {
+		depth := d.depths[i]
+		inPath := api.IsZero(api.Sub(nbMatched, m))
+		// the value is the bytes at the depth of the key which are a part of
+		// the value and all bytes in the nested containers.
+		atKeyDepth := api.IsZero(api.Sub(depth, m))
+		inValue := api.Mul(inPath, api.Sub(1, api.Mul(atKeyDepth, api.Sub(1, d.value[i]))))
+		masked[i] = api.Mul(inValue, d.chars[i])
+		length = api.Add(length, inValue)
+		start = api.Add(start, api.Mul(i, api.Mul(inValue, api.Sub(1, prevInValue))))
+		found = api.Add(found, api.Mul(inPath, api.Sub(1, prevInPath)))
+		prevInPath, prevInValue = inPath, inValue
+
+		keyLen := keyLenTbl.Lookup(nbMatched)[0]
+		// the key is at the depth of the next key of the path
+		atNextKey := api.Mul(d.keyEnd[i], api.IsZero(api.Sub(depth, api.Add(nbMatched, 1))))
+		isMatch := api.Mul(atNextKey, api.IsZero(api.Sub(progress, keyLen)))
+		isEscaped := api.Mul(atNextKey, api.Sub(1, inPath), api.IsZero(api.Sub(progress, escaped)))
+		nbEscaped = api.Add(nbEscaped, isEscaped)
+		isEnd := api.Mul(d.valueEnd[i], api.IsZero(api.Sub(depth, nbMatched)))
+
+		idx := api.Add(api.Mul(api.Add(api.Mul(nbMatched, nbProgress), progress), 256), d.chars[i])
+		progress = api.Mul(api.Sub(1, d.keyStart[i]), progressTbl.Lookup(idx)[0])
+		nbMatched = api.Add(nbMatched, api.Sub(isMatch, isEnd))
+	}
+	isValid := api.Mul(d.isValid, api.IsZero(api.Sub(found, 1)), api.IsZero(nbEscaped))
+	// if the field is found exactly once in a valid document, then the value
+	// is contiguous. Otherwise we return an empty value.
+	start = api.Mul(isValid, start)
+	length = api.Mul(isValid, length)
+	buf := buffer.New(api, masked, api.Add(start, length)).Shift(start)
+	data := buf.Data()
+	res := make([]uints.U8, len(data))
+	for i := range data {
+		res[i] = uints.U8{Val: data[i]}
+	}
+	return &Value{Data: res, Length: length, IsValid: isValid}, nil
+}
+
+// ExtractField parses the first length bytes of in as a JSON document and
+// returns the value of the field at path. See [Parse] and
+// [Document.ExtractField].
+func ExtractField(api frontend.API, in []uints.U8, length frontend.Variable, path []string, opts ...Option) (*Value, error) {
+	doc, err := Parse(api, in, length, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return doc.ExtractField(path...)
+}
+
+// prefixMask returns a vector of size n where the first length elements are 1
+// and the rest are 0.
+func prefixMask(api frontend.API, length frontend.Variable, n int) []frontend.Variable {
+	// partitioning requires at least two elements, we add an extra element and
+	// ignore it.
+	ones := make([]frontend.Variable, n+1)
+	for i := range ones {
+		ones[i] = 1
+	}
+	return selector.Partition(api, length, false, ones)[:n]
+}
+
+type ctxLexerTablesKey struct{}
+
+// lexerTables are the lookup tables of the tokenizer. The transition tables
+// are indexed by state*nbClasses+class.
+type lexerTables struct {
+	// class maps the bytes to classes.
+	class *logderivlookup.Table
+	// typ maps the states to container types.
+	typ *logderivlookup.Table
+	// next maps to the next states.
+	next *logderivlookup.Table
+	// the flags of the transitions
+	push, pop, value, keyStart, keyEnd, valueEnd *logderivlookup.Table
+}
+
+func getLexerTables(api frontend.API) *lexerTables {
+	kv, ok := api.Compiler().(kvstore.Store)
+	if !ok {
+		panic("builder should implement key-value store")
+	}
+	if tbls, ok := kv.GetKeyValue(ctxLexerTablesKey{}).(*lexerTables); ok {
+		return tbls
+	}
+	tbls := &lexerTables{
+		class:    logderivlookup.New(api),
+		typ:      logderivlookup.New(api),
+		next:     logderivlookup.New(api),
+		push:     logderivlookup.New(api),
+		pop:      logderivlookup.New(api),
+		value:    logderivlookup.New(api),
+		keyStart: logderivlookup.New(api),
+		keyEnd:   logderivlookup.New(api),
+		valueEnd: logderivlookup.New(api),
+	}
+	for c := 0; c < 256; c++ {
+		tbls.class.Insert(byteClass(byte(c)))
+	}
+	for s := 0; s < nbStates; s++ {
+		tbls.typ.Insert(s % nbTypes)
+	}
+	asInt := func(b bool) int {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	for _, t := range transitions() {
+		tbls.next.Insert(t.next*nbTypes + t.typ)
+		tbls.push.Insert(asInt(t.push))
+		tbls.pop.Insert(asInt(t.pop))
+		tbls.value.Insert(asInt(t.value))
+		tbls.keyStart.Insert(asInt(t.keyStart))
+		tbls.keyEnd.Insert(asInt(t.keyEnd))
+		tbls.valueEnd.Insert(asInt(t.valueEnd))
+	}
+	kv.SetKeyValue(ctxLexerTablesKey{}, tbls)
+	return tbls
+}
@@ -0,0 +1,195 @@
+package json
+
+import (
+	stdjson "encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+// tokenize runs the tokenizer natively and returns if the document is valid.
+func tokenize(doc []byte, maxDepth int) bool {
+	trs := transitions()
+	stack := []int{tTop}
+	state := initialState
+	for _, c := range append(doc, ' ') {
+		t := trs[state*nbClasses+byteClass(c)]
+		state = t.next*nbTypes + t.typ
+		switch {
+		case t.push:
+			stack = append(stack, t.typ)
+			if len(stack) > maxDepth+1 {
+				return false
+			}
+		case t.pop:
+			stack = stack[:len(stack)-1]
+			state += stack[len(stack)-1]
+		}
+	}
+	return state == finalState
+}
+
+func TestTokenizer(t *testing.T) {
+	assert := test.NewAssert(t)
+	docs := []string{
+		``, ` `, `{}`, `[]`, `{ }`, `[ ]`, `1`, `-12.5e+3`, `"abc"`, `true`,
+		`false`, `null`, `nul`, `nulll`, `tru`, `{"a":1}`, `{"a":1,}`,
+		`{"a" 1}`, `{"a":}`, `{"a":1 "b":2}`, `[1,2,3]`, `[1,2,]`, `[,]`,
+		`{"a":[1,{"b":null}],"c":{"d":"e"}}`, `{"a\"b":"c\\d\n"}`,
+		`{"a":"b"}}`, `{"a":"b"]`, `[1}`, `{1:2}`, `{"a":1}{}`, `"a` + "\n" + `b"`,
+		" \t{\r\n\"a\" : [ true , false ] }\n", `{"a":{"b":{"c":[[[]]]}}}`,
+		`{"a":x}`, `{"a":"x"`, `[`, `]`, `}`, `:`, `,`, `"\"`, `"\\"`,
+		// escape sequences
+		`"\/\b\f\n\r\t\"\\"`, `"\u00e9\u00E9\uABCD\uabcd"`, `"\x"`, `"\'"`,
+		`"\U0041"`, `"\u12"`, `"\u12g4"`, `"\u"`, `{"\u0061":1}`, `{"\u006":1}`,
+		`{"\q":1}`, `{"\/":"\/"}`,
+	}
+	for _, doc := range docs {
+		assert.Equal(stdjson.Valid([]byte(doc)), tokenize([]byte(doc), DefaultMaxDepth), doc)
+	}
+}
+
+const capacity = 128
+
+type extractCircuit struct {
+	path []string
+
+	In       [capacity]uints.U8
+	Length   frontend.Variable
+	Expected [capacity]uints.U8
+	ExpLen   frontend.Variable
+	IsValid  frontend.Variable
+	DocValid frontend.Variable
+}
+
+func (c *extractCircuit) Define(api frontend.API) error {
+	doc, err := Parse(api, c.In[:], c.Length, WithMaxDepth(4))
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(doc.IsValid(), c.DocValid)
+	v, err := doc.ExtractField(c.path...)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(v.IsValid, c.IsValid)
+	api.AssertIsEqual(v.Length, c.ExpLen)
+	for i := range v.Data {
+		api.AssertIsEqual(v.Data[i].Val, c.Expected[i].Val)
+	}
+	return nil
+}
+
+func extractAssignment(path []string, doc, expected string, valid bool) *extractCircuit {
+	var in, exp [capacity]uint8
+	copy(in[:], doc)
+	copy(exp[:], expected)
+	// garbage after the length
+	for i := len(doc); i < capacity; i++ {
+		in[i] = '}'
+	}
+	res := &extractCircuit{
+		path:     path,
+		Length:   len(doc),
+		ExpLen:   len(expected),
+		IsValid:  0,
+		DocValid: 0,
+	}
+	if valid {
+		res.IsValid = 1
+	}
+	if stdjson.Valid([]byte(doc)) {
+		res.DocValid = 1
+	}
+	copy(res.In[:], uints.NewU8Array(in[:]))
+	copy(res.Expected[:], uints.NewU8Array(exp[:]))
+	return res
+}
+
+func TestExtractField(t *testing.T) {
+	assert := test.NewAssert(t)
+	const payload = `{"iss":"https://accounts.example.com","aud":["a","b"],"sub":"1234567890","ext":{"email":"a@b.c","n":{"x":-1.5}},"ok":true}`
+	testCases := []struct {
+		path     []string
+		doc      string
+		expected string
+		valid    bool
+	}{
+		{[]string{"sub"}, payload, "1234567890", true},
+		{[]string{"aud"}, payload, `["a","b"]`, true},
+		{[]string{"ext", "email"}, payload, "a@b.c", true},
+		{[]string{"ext", "n"}, payload, `{"x":-1.5}`, true},
+		{[]string{"ext", "n", "x"}, payload, "-1.5", true},
+		{[]string{"ok"}, payload, "true", true},
+		{[]string{"email"}, payload, "", false},
+		{[]string{"su"}, payload, "", false},
+		{[]string{"ext", "sub"}, payload, "", false},
+		{[]string{"a"}, `{ "b" : "a" , "a" : "" }`, "", true},
+		{[]string{"a"}, `{"b":{"a":1},"a" : 2 }`, "2", true},
+		{[]string{"a"}, `{"b":["a",{"a":1}],"a":[ 1 , 2 ] }`, "[ 1 , 2 ]", true},
+		{[]string{"a"}, `{"b\"":1,"a":"x\"y"}`, "", false},
+		{[]string{"a"}, `{"b":"\"a\":1","a":"x\"y"}`, `x\"y`, true},
+		// duplicate key
+		{[]string{"a"}, `{"a":1,"a":2}`, "", false},
+		// duplicate key written using escapes
+		{[]string{"sub"}, `{"sub":"alice","s\u0075b":"bob"}`, "", false},
+		{[]string{"sub"}, `{"s\u0075b":"bob","sub":"alice"}`, "", false},
+		{[]string{"ext", "sub"}, `{"ext":{"sub":"alice","s\u0075b":"bob"}}`, "", false},
+		// escaped keys which are not at the position of the path keys
+		{[]string{"sub"}, `{"x":{"s\u0075b":1},"sub":"alice"}`, "alice", true},
+		{[]string{"sub"}, `{"sub":{"s\u0075b":1}}`, `{"s\u0075b":1}`, true},
+		{[]string{"ext", "sub"}, `{"e\u0078t":{"a":1},"ext":{"sub":"alice"}}`, "", false},
+		// invalid documents
+		{[]string{"a"}, `{"a":1,}`, "", false},
+		{[]string{"a"}, `{"a":1`, "", false},
+		{[]string{"a"}, `["a",1]`, "", false},
+	}
+	for _, tc := range testCases {
+		assignment := extractAssignment(tc.path, tc.doc, tc.expected, tc.valid)
+		assert.NoError(test.IsSolved(&extractCircuit{path: tc.path}, assignment, ecc.BN254.ScalarField()), fmt.Sprint(tc.path, tc.doc))
+		if tc.valid {
+			// wrong value
+			wrong := extractAssignment(tc.path, tc.doc, tc.expected+"x", tc.valid)
+			assert.Error(test.IsSolved(&extractCircuit{path: tc.path}, wrong, ecc.BN254.ScalarField()), fmt.Sprint(tc.path, tc.doc))
+		}
+	}
+}
+
+func TestExtractFieldInvalidPath(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, path := range [][]string{{}, {"a\"b"}, {"a", "b\\"}, {"\n"}} {
+		assignment := extractAssignment(path, `{"a":1}`, "", false)
+		assert.Error(test.IsSolved(&extractCircuit{path: path}, assignment, ecc.BN254.ScalarField()), path)
+	}
+}
+
+func TestExtractFieldProving(t *testing.T) {
+	assert := test.NewAssert(t)
+	path := []string{"sub"}
+	assignment := extractAssignment(path, `{"iss":"a", "sub":"1234" }`, "1234", true)
+	assert.CheckCircuit(&extractCircuit{path: path}, test.WithValidAssignment(assignment), test.WithCurves(ecc.BN254), test.NoFuzzing())
+}
+
+func TestTooDeep(t *testing.T) {
+	assert := test.NewAssert(t)
+	path := []string{"a"}
+	assignment := extractAssignment(path, `{"a":[[[]]]}`, "[[[]]]", true)
+	assert.NoError(test.IsSolved(&extractCircuit{path: path}, assignment, ecc.BN254.ScalarField()))
+	assignment = extractAssignment(path, `{"a":[[[[]]]]}`, "[[[[]]]]", true)
+	assert.Error(test.IsSolved(&extractCircuit{path: path}, assignment, ecc.BN254.ScalarField()))
+}
+
+func TestLengthOutOfRange(t *testing.T) {
+	assert := test.NewAssert(t)
+	path := []string{"a"}
+	doc := `{"a":1}` + strings.Repeat(" ", capacity-7)
+	assignment := extractAssignment(path, doc, "1", true)
+	assert.NoError(test.IsSolved(&extractCircuit{path: path}, assignment, ecc.BN254.ScalarField()))
+	assignment.Length = capacity + 1
+	assert.Error(test.IsSolved(&extractCircuit{path: path}, assignment, ecc.BN254.ScalarField()))
+}
@@ -0,0 +1,352 @@
+package json
+
+// This file defines the lexer of the JSON documents. The lexer is a
+// deterministic finite automaton over the byte classes, extended with a stack
+// of container types (object, array or top-level). The state of the lexer is
+// encoded as lex*nbTypes+typ where lex is the lexical state and typ is the type
+// of the innermost container.
+
+// byte classes
+const (
+	cSpace     = iota // ' '
+	cWS               // '\t', '\n', '\r'
+	cLBrace           // '{'
+	cRBrace           // '}'
+	cLBracket         // '['
+	cRBracket         // ']'
+	cQuote            // '"'
+	cBackslash        // '\\'
+	cSlash            // '/'
+	cColon            // ':'
+	cComma            // ','
+	cDigit            // '0'-'9'
+	cMinus            // '-'
+	cPlus             // '+'
+	cDot              // '.'
+	cUpperE           // 'E'
+	cT                // 't'
+	cR                // 'r'
+	cU                // 'u'
+	cE                // 'e'
+	cF                // 'f'
+	cA                // 'a'
+	cL                // 'l'
+	cS                // 's'
+	cN                // 'n'
+	cB                // 'b'
+	cHex              // other hexadecimal letters 'c', 'd', 'A'-'D', 'F'
+	cCtrl             // control characters
+	cOther            // all other bytes
+	nbClasses
+)
+
+// container types
+const (
+	tObject = iota
+	tArray
+	tTop
+	nbTypes
+)
+
+// lexical states
+const (
+	sErr        = iota // invalid input, absorbing
+	sValue             // expecting a value
+	sValueOrEnd        // expecting a value or end of array
+	sKeyOrEnd          // expecting a key or end of object
+	sKey               // expecting a key
+	sInKey             // inside a key
+	sInKeyEsc          // inside a key after backslash
+	sInKeyU1           // expecting first hex digit of \u escape in a key
+	sInKeyU2           // expecting second hex digit of \u escape in a key
+	sInKeyU3           // expecting third hex digit of \u escape in a key
+	sInKeyU4           // expecting fourth hex digit of \u escape in a key
+	sColon             // expecting a colon after key
+	sStr               // inside a string value
+	sStrEsc            // inside a string value after backslash
+	sStrU1             // expecting first hex digit of \u escape in a string
+	sStrU2             // expecting second hex digit of \u escape in a string
+	sStrU3             // expecting third hex digit of \u escape in a string
+	sStrU4             // expecting fourth hex digit of \u escape in a string
+	sNum               // inside a number
+	sTrueR             // expecting 'r' of true
+	sTrueU             // expecting 'u' of true
+	sTrueE             // expecting 'e' of true
+	sFalseA            // expecting 'a' of false
+	sFalseL            // expecting 'l' of false
+	sFalseS            // expecting 's' of false
+	sFalseE            // expecting 'e' of false
+	sNullU             // expecting 'u' of null
+	sNullL1            // expecting first 'l' of null
+	sNullL2            // expecting second 'l' of null
+	sPrimEnd           // after a literal
+	sAfter             // after a value
+	nbLexStates
+)
+
+const nbStates = nbLexStates * nbTypes
+
+// initialState is the state of the lexer at the beginning of the document.
+const initialState = sValue*nbTypes + tTop
+
+// finalState is the state of the lexer after a valid document.
+const finalState = sAfter*nbTypes + tTop
+
+// transition is the result of the lexer consuming a byte.
+type transition struct {
+	// next is the next lexical state.
+	next int
+	// typ is the type of the container after the transition. It is not set
+	// when popping and is instead read from the stack.
+	typ int
+	// push is set when opening a container.
+	push bool
+	// pop is set when closing a container.
+	pop bool
+	// value is set when the byte is a part of a value at the current depth:
+	// opening brackets, contents of strings (excluding quotes), numbers and
+	// literals.
+	value bool
+	// keyStart is set for the opening quote of a key.
+	keyStart bool
+	// keyEnd is set for the closing quote of a key.
+	keyEnd bool
+	// valueEnd is set when the byte terminates the value of a container
+	// element (comma or closing bracket).
+	valueEnd bool
+}
+
+// byteClass returns the class of byte b.
+func byteClass(b byte) int {
+	switch {
+	case b == ' ':
+		return cSpace
+	case b == '\t' || b == '\n' || b == '\r':
+		return cWS
+	case b < 0x20:
+		return cCtrl
+	case b >= '0' && b <= '9':
+		return cDigit
+	}
+	switch b {
+	case '{':
+		return cLBrace
+	case '}':
+		return cRBrace
+	case '[':
+		return cLBracket
+	case ']':
+		return cRBracket
+	case '"':
+		return cQuote
+	case '\\':
+		return cBackslash
+	case '/':
+		return cSlash
+	case ':':
+		return cColon
+	case ',':
+		return cComma
+	case '-':
+		return cMinus
+	case '+':
+		return cPlus
+	case '.':
+		return cDot
+	case 'E':
+		return cUpperE
+	case 't':
+		return cT
+	case 'r':
+		return cR
+	case 'u':
+		return cU
+	case 'e':
+		return cE
+	case 'f':
+		return cF
+	case 'a':
+		return cA
+	case 'l':
+		return cL
+	case 's':
+		return cS
+	case 'n':
+		return cN
+	case 'b':
+		return cB
+	case 'c', 'd', 'A', 'B', 'C', 'D', 'F':
+		return cHex
+	}
+	return cOther
+}
+
+// step returns the transition of the lexer in lexical state lex and container
+// type typ when consuming a byte of class cls.
+func step(lex, typ, cls int) transition {
+	stay := transition{next: lex, typ: typ}
+	to := func(next int, value bool) transition {
+		return transition{next: next, typ: typ, value: value}
+	}
+	isWS := cls == cSpace || cls == cWS
+	// isEscape is set for the characters of the single-character escape
+	// sequences \" \\ \/ \b \f \n \r \t.
+	isEscape := cls == cQuote || cls == cBackslash || cls == cSlash || cls == cB ||
+		cls == cF || cls == cN || cls == cR || cls == cT
+	isHex := cls == cDigit || cls == cA || cls == cB || cls == cHex || cls == cE ||
+		cls == cF || cls == cUpperE
+	// terminate handles the bytes after a complete value.
+	terminate := func() transition {
+		switch {
+		case isWS:
+			return to(sAfter, false)
+		case cls == cComma && typ == tObject:
+			return transition{next: sKey, typ: typ, valueEnd: true}
+		case cls == cComma && typ == tArray:
+			return transition{next: sValue, typ: typ, valueEnd: true}
+		case cls == cRBrace && typ == tObject, cls == cRBracket && typ == tArray:
+			return transition{next: sAfter, pop: true, valueEnd: true}
+		}
+		return transition{}
+	}
+	// literal handles the next expected character of a literal.
+	literal := func(expected, next int) transition {
+		if cls == expected {
+			return to(next, true)
+		}
+		return transition{}
+	}
+
+	switch lex {
+	case sValue, sValueOrEnd:
+		switch {
+		case isWS:
+			return stay
+		case cls == cLBrace:
+			return transition{next: sKeyOrEnd, typ: tObject, push: true, value: true}
+		case cls == cLBracket:
+			return transition{next: sValueOrEnd, typ: tArray, push: true, value: true}
+		case cls == cQuote:
+			return to(sStr, false)
+		case cls == cDigit || cls == cMinus:
+			return to(sNum, true)
+		case cls == cT:
+			return to(sTrueR, true)
+		case cls == cF:
+			return to(sFalseA, true)
+		case cls == cN:
+			return to(sNullU, true)
+		case cls == cRBracket && lex == sValueOrEnd:
+			return transition{next: sAfter, pop: true, valueEnd: true}
+		}
+	case sKeyOrEnd, sKey:
+		switch {
+		case isWS:
+			return stay
+		case cls == cQuote:
+			return transition{next: sInKey, typ: typ, keyStart: true}
+		case cls == cRBrace && lex == sKeyOrEnd:
+			return transition{next: sAfter, pop: true, valueEnd: true}
+		}
+	case sInKey:
+		switch cls {
+		case cQuote:
+			return transition{next: sColon, typ: typ, keyEnd: true}
+		case cBackslash:
+			return to(sInKeyEsc, false)
+		case cWS, cCtrl:
+			return transition{}
+		}
+		return stay
+	case sInKeyEsc:
+		switch {
+		case isEscape:
+			return to(sInKey, false)
+		case cls == cU:
+			return to(sInKeyU1, false)
+		}
+	case sInKeyU1, sInKeyU2, sInKeyU3:
+		if isHex {
+			return to(lex+1, false)
+		}
+	case sInKeyU4:
+		if isHex {
+			return to(sInKey, false)
+		}
+	case sColon:
+		switch {
+		case isWS:
+			return stay
+		case cls == cColon:
+			return to(sValue, false)
+		}
+	case sStr:
+		switch cls {
+		case cQuote:
+			return to(sAfter, false)
+		case cBackslash:
+			return to(sStrEsc, true)
+		case cWS, cCtrl:
+			return transition{}
+		}
+		return to(sStr, true)
+	case sStrEsc:
+		switch {
+		case isEscape:
+			return to(sStr, true)
+		case cls == cU:
+			return to(sStrU1, true)
+		}
+	case sStrU1, sStrU2, sStrU3:
+		if isHex {
+			return to(lex+1, true)
+		}
+	case sStrU4:
+		if isHex {
+			return to(sStr, true)
+		}
+	case sNum:
+		switch cls {
+		case cDigit, cMinus, cPlus, cDot, cE, cUpperE:
+			return to(sNum, true)
+		}
+		return terminate()
+	case sTrueR:
+		return literal(cR, sTrueU)
+	case sTrueU:
+		return literal(cU, sTrueE)
+	case sTrueE:
+		return literal(cE, sPrimEnd)
+	case sFalseA:
+		return literal(cA, sFalseL)
+	case sFalseL:
+		return literal(cL, sFalseS)
+	case sFalseS:
+		return literal(cS, sFalseE)
+	case sFalseE:
+		return literal(cE, sPrimEnd)
+	case sNullU:
+		return literal(cU, sNullL1)
+	case sNullL1:
+		return literal(cL, sNullL2)
+	case sNullL2:
+		return literal(cL, sPrimEnd)
+	case sPrimEnd, sAfter:
+		return terminate()
+	}
+	return transition{}
+}
+
+// transitions returns the transitions for all states and byte classes. The
+// transition for state s and class c is at index s*nbClasses+c.
+func transitions() []transition {
+	res := make([]transition, 0, nbStates*nbClasses)
+	for lex := 0; lex < nbLexStates; lex++ {
+		for typ := 0; typ < nbTypes; typ++ {
+			for cls := 0; cls < nbClasses; cls++ {
+				res = append(res, step(lex, typ, cls))
+			}
+		}
+	}
+	return res
+}
@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/algopts"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
 	"github.com/consensys/gnark/std/math/bits"
 	"github.com/consensys/gnark/std/math/emulated"
@@ -12,6 +13,11 @@ import (
 
 // ECRecover implements [ECRECOVER] precompile contract at address 0x01.
 //
+// The public key is recovered with the GLV joint scalar multiplication, see
+// [sw_emulated.Curve.JointScalarMulBase]. Its formulas are incomplete, so the
+// circuit is not satisfiable when the recovered point R is a small multiple of
+// the generator, e.g. when r is the x coordinate of the generator.
+//
 // [ECRECOVER]: https://ethereum.github.io/execution-specs/autoapi/ethereum/paris/vm/precompiled_contracts/ecrecover/index.html
 func ECRecover(api frontend.API, msg emulated.Element[emulated.Secp256k1Fr],
 	v frontend.Variable, r, s emulated.Element[emulated.Secp256k1Fr],
@@ -80,7 +86,7 @@ func ECRecover(api frontend.API, msg emulated.Element[emulated.Secp256k1Fr],
 	// compute u2 = s * rinv
 	u2 := frField.MulMod(&s, rinv)
 	// check u1 * G + u2 R == P
-	C := curve.JointScalarMulBase(&R, u2, u1)
+	C := curve.JointScalarMulBase(&R, u2, u1, algopts.WithGLV())
 	curve.AssertIsEqual(C, &P)
 	return &P
 }
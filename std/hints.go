@@ -5,15 +5,24 @@ import (
 	"sync"
 
 	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/std/accumulator/verkle"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
 	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
 	"github.com/consensys/gnark/std/algebra/native/sw_bls24315"
+	"github.com/consensys/gnark/std/algebra/native/sw_grumpkin"
+	"github.com/consensys/gnark/std/buffer"
+	"github.com/consensys/gnark/std/encoding/base64"
 	"github.com/consensys/gnark/std/evmprecompiles"
 	"github.com/consensys/gnark/std/internal/logderivarg"
 	"github.com/consensys/gnark/std/math/bits"
 	"github.com/consensys/gnark/std/math/bitslice"
 	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/fixedpoint"
+	"github.com/consensys/gnark/std/math/intdiv"
+	"github.com/consensys/gnark/std/math/signed"
 	"github.com/consensys/gnark/std/rangecheck"
 	"github.com/consensys/gnark/std/selector"
+	"github.com/consensys/gnark/std/sort"
 )
 
 var registerOnce sync.Once
@@ -32,6 +41,7 @@ func registerHints() {
 	solver.RegisterHint(sw_bls12377.DecomposeScalarG1)
 	solver.RegisterHint(sw_bls24315.DecomposeScalarG2)
 	solver.RegisterHint(sw_bls12377.DecomposeScalarG2)
+	solver.RegisterHint(sw_grumpkin.DecomposeScalarG1)
 	solver.RegisterHint(bits.GetHints()...)
 	solver.RegisterHint(cmp.GetHints()...)
 	solver.RegisterHint(selector.GetHints()...)
@@ -40,4 +50,12 @@ func registerHints() {
 	solver.RegisterHint(evmprecompiles.GetHints()...)
 	solver.RegisterHint(logderivarg.GetHints()...)
 	solver.RegisterHint(bitslice.GetHints()...)
+	solver.RegisterHint(verkle.GetHints()...)
+	solver.RegisterHint(sort.GetHints()...)
+	solver.RegisterHint(buffer.GetHints()...)
+	solver.RegisterHint(base64.GetHints()...)
+	solver.RegisterHint(signed.GetHints()...)
+	solver.RegisterHint(fixedpoint.GetHints()...)
+	solver.RegisterHint(intdiv.GetHints()...)
+	solver.RegisterHint(sw_emulated.GetHints()...)
 }
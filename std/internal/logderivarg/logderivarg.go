@@ -146,6 +146,58 @@ func Build(api frontend.API, table Table, queries Table) error {
 	return nil
 }
 
+// BuildPermutation builds the argument that the rows of table b are a
+// permutation of the rows of table a. As every row has multiplicity one, it
+// checks
+//
+//	∑_{a∈A} 1/(x-∑_{i∈[n]}r_i*a_i) == ∑_{b∈B} 1/(x-∑_{i∈[n]}r_i*b_i).
+//
+// Contrary to [Build], the rows of a do not have to be unique.
+func BuildPermutation(api frontend.API, a, b Table) error {
+	if len(a) != len(b) {
+		return fmt.Errorf("table length mismatch: %d != %d", len(a), len(b))
+	}
+	if len(a) == 0 {
+		return fmt.Errorf("table empty")
+	}
+	nbRow := len(a[0])
+	if nbRow == 0 {
+		return fmt.Errorf("table row empty")
+	}
+	toCommit := make([]frontend.Variable, 0, 2*len(a)*nbRow)
+	for i := range a {
+		if len(a[i]) != nbRow || len(b[i]) != nbRow {
+			return fmt.Errorf("table row length mismatch")
+		}
+		toCommit = append(toCommit, a[i]...)
+		toCommit = append(toCommit, b[i]...)
+	}
+
+	multicommit.WithCommitment(api, func(api frontend.API, commitment frontend.Variable) error {
+		rowCoeffs, challenge := randLinearCoefficients(api, nbRow, commitment)
+		toInvert := make([]frontend.Variable, len(a)+len(b))
+		for i := range a {
+			toInvert[i] = api.Sub(challenge, randLinearCombination(api, rowCoeffs, a[i]))
+			toInvert[len(a)+i] = api.Sub(challenge, randLinearCombination(api, rowCoeffs, b[i]))
+		}
+		if bapi, ok := api.(frontend.BatchInverter); ok {
+			toInvert = bapi.BatchInvert(toInvert)
+		} else {
+			for i := range toInvert {
+				toInvert[i] = api.Inverse(toInvert[i])
+			}
+		}
+		var lp, rp frontend.Variable = 0, 0
+		for i := range a {
+			lp = api.Add(lp, toInvert[i])
+			rp = api.Add(rp, toInvert[len(a)+i])
+		}
+		api.AssertIsEqual(lp, rp)
+		return nil
+	}, toCommit...)
+	return nil
+}
+
 func randLinearCoefficients(api frontend.API, nbRow int, commitment frontend.Variable) (rowCoeffs []frontend.Variable, challenge frontend.Variable) {
 	if nbRow == 1 {
 		return []frontend.Variable{1}, commitment
@@ -15,9 +15,11 @@ make compile-time distinction between different emulated fields.
 
 This package defines [Element] type which stores the element value in split
 limbs. On top of the Element instance, this package defines typical arithmetic
-as addition, multiplication and subtraction. If the modulus is a prime (i.e.
-defines a finite field), then inversion and division operations are also
-possible.
+as addition, multiplication and subtraction. Inversion and division are
+possible for invertible elements. If the modulus is a prime (i.e. defines a
+finite field), then every non-zero element is invertible. Otherwise, the
+[Field.TryInverse] method can be used to compute the inverse together with a
+flag indicating if the inverse exists.
 
 The results of the operations are not always reduced to be less than the
 modulus. For consecutive operations it is necessary to manually reduce the value
@@ -30,8 +32,8 @@ limbs.
 We operate in the scalar field of the SNARK curve (native field). Denote the
 modulus of the native field as 'q'. Representing the modulus of the native field
 requires 'n' bits. We wish to emulate operations over modulus 'r'. Modulus r may
-or may not be a prime. If r is not prime, then we do not have inversion and
-division operations (the corresponding methods panic). Let the bitlength of r be
+or may not be a prime. If r is not prime, then not every element is
+invertible and the square root methods panic. Let the bitlength of r be
 'm'. We note that r may be smaller, larger or equal to q.
 
 To represent an element x ∈ N_r, we choose the limb width 'w' such that
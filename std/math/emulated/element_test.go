@@ -12,6 +12,7 @@ import (
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/frontend/cs/r1cs"
 	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/std/math/emulated/emparams"
 	"github.com/consensys/gnark/test"
 )
 
@@ -918,6 +919,12 @@ func testIsZero[T FieldParams](t *testing.T) {
 		circuit := IsZeroCircuit[T]{}
 		assert.ProverSucceeded(&circuit, &IsZeroCircuit[T]{X: ValueOf[T](X), Y: ValueOf[T](Y), Zero: 1}, test.WithCurves(testCurve), test.NoSerializationChecks(), test.WithBackends(backend.GROTH16, backend.PLONK))
 		assert.ProverSucceeded(&circuit, &IsZeroCircuit[T]{X: ValueOf[T](X), Y: ValueOf[T](0), Zero: 0}, test.WithCurves(testCurve), test.NoSerializationChecks(), test.WithBackends(backend.GROTH16, backend.PLONK))
+		if fp.NbLimbs() > 1 {
+			// only the most significant limb is non-zero
+			H := new(big.Int).Lsh(big.NewInt(1), fp.BitsPerLimb()*(fp.NbLimbs()-1))
+			assert.NoError(test.IsSolved(&circuit, &IsZeroCircuit[T]{X: ValueOf[T](H), Y: ValueOf[T](0), Zero: 0}, testCurve.ScalarField()))
+			assert.Error(test.IsSolved(&circuit, &IsZeroCircuit[T]{X: ValueOf[T](H), Y: ValueOf[T](0), Zero: 1}, testCurve.ScalarField()))
+		}
 	}, testName[T]())
 }
 
@@ -956,3 +963,397 @@ func testSqrt[T FieldParams](t *testing.T) {
 		assert.ProverSucceeded(&SqrtCircuit[T]{}, &SqrtCircuit[T]{X: ValueOf[T](X), Expected: ValueOf[T](exp)}, test.WithCurves(testCurve), test.NoSerializationChecks(), test.WithBackends(backend.GROTH16, backend.PLONK))
 	}, testName[T]())
 }
+
+type ExpCircuit[T FieldParams] struct {
+	Base, Exp, Expected Element[T]
+}
+
+func (c *ExpCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	res := f.Exp(&c.Base, &c.Exp)
+	f.AssertIsEqual(res, &c.Expected)
+	return nil
+}
+
+func TestExp(t *testing.T) {
+	testExp[Goldilocks](t)
+	testExp[Secp256k1Fp](t)
+	testExp[BN254Fp](t)
+}
+
+func testExp[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		base, _ := rand.Int(rand.Reader, fp.Modulus())
+		exp, _ := rand.Int(rand.Reader, fp.Modulus())
+		expected := new(big.Int).Exp(base, exp, fp.Modulus())
+		witness := &ExpCircuit[T]{Base: ValueOf[T](base), Exp: ValueOf[T](exp), Expected: ValueOf[T](expected)}
+		if fp.NbLimbs() == 1 {
+			assert.CheckCircuit(&ExpCircuit[T]{}, test.WithValidAssignment(witness), test.WithCurves(testCurve), test.NoFuzzing())
+		} else {
+			// full exponentiation is too slow for proving in tests
+			assert.NoError(test.IsSolved(&ExpCircuit[T]{}, witness, testCurve.ScalarField()))
+		}
+		wrong := new(big.Int).Add(expected, big.NewInt(1))
+		assert.Error(test.IsSolved(&ExpCircuit[T]{}, &ExpCircuit[T]{Base: ValueOf[T](base), Exp: ValueOf[T](exp), Expected: ValueOf[T](wrong)}, testCurve.ScalarField()))
+		// zero exponent, both as a variable and as a constant
+		witness = &ExpCircuit[T]{Base: ValueOf[T](base), Exp: ValueOf[T](0), Expected: ValueOf[T](1)}
+		assert.NoError(test.IsSolved(&ExpCircuit[T]{}, witness, testCurve.ScalarField()))
+		assert.NoError(test.IsSolved(&ExpCircuit[T]{}, witness, testCurve.ScalarField(), test.SetAllVariablesAsConstants()))
+	}, testName[T]())
+}
+
+type BatchInverseCircuit[T FieldParams] struct {
+	In, Expected [5]Element[T]
+}
+
+func (c *BatchInverseCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	in := make([]*Element[T], len(c.In))
+	for i := range c.In {
+		in[i] = &c.In[i]
+	}
+	res := f.BatchInverse(in)
+	for i := range res {
+		f.AssertIsEqual(res[i], &c.Expected[i])
+	}
+	return nil
+}
+
+func TestBatchInverse(t *testing.T) {
+	testBatchInverse[Goldilocks](t)
+	testBatchInverse[Secp256k1Fp](t)
+	testBatchInverse[BN254Fp](t)
+}
+
+func testBatchInverse[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		var witness BatchInverseCircuit[T]
+		for i := range witness.In {
+			v, _ := rand.Int(rand.Reader, fp.Modulus())
+			witness.In[i] = ValueOf[T](v)
+			witness.Expected[i] = ValueOf[T](new(big.Int).ModInverse(v, fp.Modulus()))
+		}
+		assert.CheckCircuit(&BatchInverseCircuit[T]{}, test.WithValidAssignment(&witness), test.WithCurves(testCurve), test.NoFuzzing())
+		witness.In[2] = ValueOf[T](0)
+		assert.Error(test.IsSolved(&BatchInverseCircuit[T]{}, &witness, testCurve.ScalarField()))
+	}, testName[T]())
+}
+
+type LegendreCircuit[T FieldParams] struct {
+	X         Element[T]
+	Legendre  frontend.Variable
+	IsResidue frontend.Variable
+}
+
+func (c *LegendreCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(f.Legendre(&c.X), c.Legendre)
+	api.AssertIsEqual(f.IsQuadraticResidue(&c.X), c.IsResidue)
+	return nil
+}
+
+func TestLegendre(t *testing.T) {
+	testLegendre[Goldilocks](t)
+	testLegendre[Secp256k1Fp](t)
+	testLegendre[BN254Fp](t)
+}
+
+func testLegendre[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		residue, nonResidue := false, false
+		for !residue || !nonResidue {
+			x, _ := rand.Int(rand.Reader, fp.Modulus())
+			l := big.Jacobi(x, fp.Modulus())
+			residue = residue || l == 1
+			nonResidue = nonResidue || l == -1
+			witness := LegendreCircuit[T]{X: ValueOf[T](x), Legendre: l, IsResidue: 0}
+			if l == 1 {
+				witness.IsResidue = 1
+			}
+			assert.CheckCircuit(&LegendreCircuit[T]{}, test.WithValidAssignment(&witness), test.WithCurves(testCurve), test.NoFuzzing())
+			witness.Legendre = -l
+			assert.Error(test.IsSolved(&LegendreCircuit[T]{}, &witness, testCurve.ScalarField()))
+		}
+		witness := LegendreCircuit[T]{X: ValueOf[T](0), Legendre: 0, IsResidue: 1}
+		assert.NoError(test.IsSolved(&LegendreCircuit[T]{}, &witness, testCurve.ScalarField()))
+	}, testName[T]())
+}
+
+type MuxCircuit[T FieldParams] struct {
+	Selector frontend.Variable
+	Inputs   [5]Element[T]
+	Expected Element[T]
+}
+
+func (c *MuxCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	inputs := make([]*Element[T], len(c.Inputs))
+	for i := range c.Inputs {
+		inputs[i] = &c.Inputs[i]
+	}
+	f.AssertIsEqual(f.Mux(c.Selector, inputs...), &c.Expected)
+	tbl := f.NewLookupTable(inputs...)
+	f.AssertIsEqual(tbl.Lookup(c.Selector), &c.Expected)
+	return nil
+}
+
+func TestMux(t *testing.T) {
+	testMux[Goldilocks](t)
+	testMux[Secp256k1Fp](t)
+	testMux[BN254Fp](t)
+}
+
+func testMux[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		var witness MuxCircuit[T]
+		vals := make([]*big.Int, len(witness.Inputs))
+		for i := range witness.Inputs {
+			vals[i], _ = rand.Int(rand.Reader, fp.Modulus())
+			witness.Inputs[i] = ValueOf[T](vals[i])
+		}
+		witness.Selector = 3
+		witness.Expected = ValueOf[T](vals[3])
+		assert.CheckCircuit(&MuxCircuit[T]{}, test.WithValidAssignment(&witness), test.WithCurves(testCurve), test.NoFuzzing())
+		witness.Selector = 2
+		assert.Error(test.IsSolved(&MuxCircuit[T]{}, &witness, testCurve.ScalarField()))
+		witness.Selector = 5
+		assert.Error(test.IsSolved(&MuxCircuit[T]{}, &witness, testCurve.ScalarField()))
+	}, testName[T]())
+}
+
+type IsLessCircuit[T FieldParams] struct {
+	A, B          Element[T]
+	IsLess, IsOdd frontend.Variable
+	ExpectedBits  []frontend.Variable
+	NonCanonicalB Element[T]
+}
+
+func (c *IsLessCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(f.IsLess(&c.A, &c.B), c.IsLess)
+	api.AssertIsEqual(f.IsOdd(&c.A), c.IsOdd)
+	// b+p has the same canonical representation
+	bs := f.ToBitsCanonical(f.Add(&c.NonCanonicalB, f.Modulus()))
+	if len(bs) != len(c.ExpectedBits) {
+		return fmt.Errorf("got %d bits, expected %d", len(bs), len(c.ExpectedBits))
+	}
+	for i := range bs {
+		api.AssertIsEqual(bs[i], c.ExpectedBits[i])
+	}
+	return nil
+}
+
+func TestIsLess(t *testing.T) {
+	testIsLess[Goldilocks](t)
+	testIsLess[Secp256k1Fr](t)
+	testIsLess[BN254Fp](t)
+}
+
+func testIsLess[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	nbBits := fp.Modulus().BitLen()
+	assert.Run(func(assert *test.Assert) {
+		for i, tc := range []struct{ a, b *big.Int }{
+			{big.NewInt(3), big.NewInt(5)},
+			{big.NewInt(5), big.NewInt(3)},
+			{big.NewInt(5), big.NewInt(5)},
+			{new(big.Int).Sub(fp.Modulus(), big.NewInt(1)), big.NewInt(0)},
+			{big.NewInt(0), new(big.Int).Sub(fp.Modulus(), big.NewInt(1))},
+		} {
+			witness := IsLessCircuit[T]{
+				A:             ValueOf[T](tc.a),
+				B:             ValueOf[T](tc.b),
+				IsLess:        0,
+				IsOdd:         tc.a.Bit(0),
+				ExpectedBits:  make([]frontend.Variable, nbBits),
+				NonCanonicalB: ValueOf[T](tc.b),
+			}
+			if tc.a.Cmp(tc.b) < 0 {
+				witness.IsLess = 1
+			}
+			for j := range witness.ExpectedBits {
+				witness.ExpectedBits[j] = tc.b.Bit(j)
+			}
+			circuit := IsLessCircuit[T]{ExpectedBits: make([]frontend.Variable, nbBits)}
+			if i == 0 {
+				assert.CheckCircuit(&circuit, test.WithValidAssignment(&witness), test.WithCurves(testCurve), test.NoFuzzing())
+			} else {
+				assert.NoError(test.IsSolved(&circuit, &witness, testCurve.ScalarField()))
+			}
+			witness.IsLess = 1 - witness.IsLess.(int)
+			assert.Error(test.IsSolved(&circuit, &witness, testCurve.ScalarField()))
+		}
+	}, testName[T]())
+}
+
+type ModReduceCircuit[S, T FieldParams] struct {
+	A        Element[T]
+	Expected Element[S]
+}
+
+func (c *ModReduceCircuit[S, T]) Define(api frontend.API) error {
+	res, err := ModReduce[S](api, &c.A)
+	if err != nil {
+		return err
+	}
+	// the result is canonical, so we can compare the limbs directly.
+	if len(res.Limbs) != len(c.Expected.Limbs) {
+		return fmt.Errorf("got %d limbs, expected %d", len(res.Limbs), len(c.Expected.Limbs))
+	}
+	for i := range res.Limbs {
+		api.AssertIsEqual(res.Limbs[i], c.Expected.Limbs[i])
+	}
+	return nil
+}
+
+func TestModReduce(t *testing.T) {
+	testModReduce[Secp256k1Fr, Secp256k1Fp](t)
+	testModReduce[BN254Fr, BLS12381Fp](t)
+	testModReduce[Goldilocks, BN254Fp](t)
+}
+
+func testModReduce[S, T FieldParams](t *testing.T) {
+	var fs S
+	var ft T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		a, _ := rand.Int(rand.Reader, ft.Modulus())
+		expected := new(big.Int).Mod(a, fs.Modulus())
+		witness := ModReduceCircuit[S, T]{A: ValueOf[T](a), Expected: ValueOf[S](expected)}
+		assert.CheckCircuit(&ModReduceCircuit[S, T]{}, test.WithValidAssignment(&witness), test.WithCurves(testCurve), test.NoFuzzing())
+		witness.Expected = ValueOf[S](new(big.Int).Add(expected, big.NewInt(1)))
+		assert.Error(test.IsSolved(&ModReduceCircuit[S, T]{}, &witness, testCurve.ScalarField()))
+	}, testName[T]())
+}
+
+type TryInverseCircuit[T FieldParams] struct {
+	A, Expected Element[T]
+	Exists      frontend.Variable
+}
+
+func (c *TryInverseCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	res, exists := f.TryInverse(&c.A)
+	api.AssertIsEqual(exists, c.Exists)
+	f.AssertIsEqual(res, &c.Expected)
+	return nil
+}
+
+func TestTryInverse(t *testing.T) {
+	testTryInverse[Secp256k1Fp](t, big.NewInt(0))
+	testTryInverse[emparams.Mod2e64](t, big.NewInt(6))
+	testTryInverse[emparams.Mod2e256](t, big.NewInt(1<<40))
+}
+
+func testTryInverse[T FieldParams](t *testing.T, nonInvertible *big.Int) {
+	var fp T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		var val *big.Int
+		inv := new(big.Int)
+		for {
+			val, _ = rand.Int(rand.Reader, fp.Modulus())
+			if inv.ModInverse(val, fp.Modulus()) != nil {
+				break
+			}
+		}
+		witness := TryInverseCircuit[T]{A: ValueOf[T](val), Expected: ValueOf[T](inv), Exists: 1}
+		assert.CheckCircuit(&TryInverseCircuit[T]{}, test.WithValidAssignment(&witness), test.WithCurves(testCurve), test.NoFuzzing())
+		witness = TryInverseCircuit[T]{A: ValueOf[T](nonInvertible), Expected: ValueOf[T](0), Exists: 0}
+		assert.NoError(test.IsSolved(&TryInverseCircuit[T]{}, &witness, testCurve.ScalarField()))
+		witness.Exists = 1
+		assert.Error(test.IsSolved(&TryInverseCircuit[T]{}, &witness, testCurve.ScalarField()))
+	}, testName[T]())
+}
+
+type RingCircuit[T FieldParams] struct {
+	A, B                    Element[T]
+	Sum, Diff, Prod, InvOdd Element[T]
+	Bits                    []frontend.Variable
+}
+
+func (c *RingCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	f.AssertIsEqual(f.Add(&c.A, &c.B), &c.Sum)
+	f.AssertIsEqual(f.Sub(&c.A, &c.B), &c.Diff)
+	f.AssertIsEqual(f.Mul(&c.A, &c.B), &c.Prod)
+	// A is odd and thus invertible
+	f.AssertIsEqual(f.Inverse(&c.A), &c.InvOdd)
+	f.AssertIsEqual(f.Div(&c.Prod, &c.A), &c.B)
+	bts := f.ToBitsCanonical(f.Neg(&c.A))
+	if len(bts) != len(c.Bits) {
+		return fmt.Errorf("got %d bits, expected %d", len(bts), len(c.Bits))
+	}
+	for i := range bts {
+		api.AssertIsEqual(bts[i], c.Bits[i])
+	}
+	return nil
+}
+
+func TestRing(t *testing.T) {
+	testRing[emparams.Mod2e64](t)
+	testRing[emparams.Mod2e256](t)
+}
+
+func testRing[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		mod := fp.Modulus()
+		nbBits := mod.BitLen() - 1
+		a, _ := rand.Int(rand.Reader, mod)
+		a.SetBit(a, 0, 1)
+		b, _ := rand.Int(rand.Reader, mod)
+		sum := new(big.Int).Add(a, b)
+		diff := new(big.Int).Sub(a, b)
+		prod := new(big.Int).Mul(a, b)
+		neg := new(big.Int).Neg(a)
+		neg.Mod(neg, mod)
+		witness := RingCircuit[T]{
+			A:      ValueOf[T](a),
+			B:      ValueOf[T](b),
+			Sum:    ValueOf[T](sum.Mod(sum, mod)),
+			Diff:   ValueOf[T](diff.Mod(diff, mod)),
+			Prod:   ValueOf[T](prod.Mod(prod, mod)),
+			InvOdd: ValueOf[T](new(big.Int).ModInverse(a, mod)),
+			Bits:   make([]frontend.Variable, nbBits),
+		}
+		for i := range witness.Bits {
+			witness.Bits[i] = neg.Bit(i)
+		}
+		circuit := RingCircuit[T]{Bits: make([]frontend.Variable, nbBits)}
+		assert.CheckCircuit(&circuit, test.WithValidAssignment(&witness), test.WithCurves(testCurve), test.NoFuzzing())
+		witness.Prod = ValueOf[T](new(big.Int).Add(prod, big.NewInt(1)))
+		assert.Error(test.IsSolved(&circuit, &witness, testCurve.ScalarField()))
+	}, testName[T]())
+}
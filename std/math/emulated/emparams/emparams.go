@@ -16,6 +16,7 @@ import (
 	"math/big"
 
 	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch"
 	"github.com/consensys/gnark-crypto/field/goldilocks"
 )
 
@@ -281,3 +282,119 @@ func (fp BLS12315Fp) Modulus() *big.Int { return ecc.BLS24_315.BaseField() }
 type BLS12315Fr struct{ fourLimbPrimeField }
 
 func (fr BLS12315Fr) Modulus() *big.Int { return ecc.BLS24_315.ScalarField() }
+
+// BandersnatchFr provides type parametrization for field emulation:
+//   - limbs: 4
+//   - limb width: 64 bits
+//
+// The prime modulus for type parametrisation is:
+//
+//	0x1cfb69d4ca675f520cce760202687600ff8f87007419047174fd06b52876e7e1 (base 16)
+//	13108968793781547619861935127046491459309155893440570251786403306729687672801 (base 10)
+//
+// This is the scalar field of the Bandersnatch curve (twisted Edwards curve
+// defined over the scalar field of the BLS12-381 curve).
+type BandersnatchFr struct{ fourLimbPrimeField }
+
+func (fr BandersnatchFr) Modulus() *big.Int {
+	curve := bandersnatch.GetEdwardsCurve()
+	return &curve.Order
+}
+
+// Curve25519Fp provides type parametrization for field emulation:
+//   - limbs: 4
+//   - limb width: 64 bits
+//
+// The prime modulus for type parametrisation is:
+//
+//	0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed (base 16)
+//	57896044618658097711785492504343953926634992332820282019728792003956564819949 (base 10)
+//
+// This is the base field of the Curve25519 curve (also of the birationally
+// equivalent Ed25519 curve).
+type Curve25519Fp struct{ fourLimbPrimeField }
+
+func (Curve25519Fp) Modulus() *big.Int {
+	p := new(big.Int).Lsh(big.NewInt(1), 255)
+	return p.Sub(p, big.NewInt(19))
+}
+
+// Mod1e4096 provides type parametrization for emulated arithmetic:
+//   - limbs: 64
+//   - limb width: 64 bits
+//
+// The modulus for type parametrisation is 2^4096-1.
+//
+// This is a non-prime modulus. It is mainly targeted for the operations with
+// variable modulus ([emulated.Field.ModMul], [emulated.Field.ModExp] etc.),
+// where the actual modulus is given as a witness. Only the width of the
+// elements is defined by the parameters.
+type Mod1e4096 struct{}
+
+func (Mod1e4096) NbLimbs() uint     { return 64 }
+func (Mod1e4096) BitsPerLimb() uint { return 64 }
+func (Mod1e4096) IsPrime() bool     { return false }
+func (Mod1e4096) Modulus() *big.Int {
+	val := new(big.Int).Lsh(big.NewInt(1), 4096)
+	return val.Sub(val, big.NewInt(1))
+}
+
+// Mod1e512 provides type parametrization for emulated arithmetic:
+//   - limbs: 8
+//   - limb width: 64 bits
+//
+// The modulus for type parametrisation is 2^512-1.
+//
+// This is a non-prime modulus. It is mainly targeted for the operations with
+// variable modulus ([emulated.Field.ModMul], [emulated.Field.ModExp] etc.),
+// where the actual modulus is given as a witness. Only the width of the
+// elements is defined by the parameters.
+type Mod1e512 struct{}
+
+func (Mod1e512) NbLimbs() uint     { return 8 }
+func (Mod1e512) BitsPerLimb() uint { return 64 }
+func (Mod1e512) IsPrime() bool     { return false }
+func (Mod1e512) Modulus() *big.Int {
+	val := new(big.Int).Lsh(big.NewInt(1), 512)
+	return val.Sub(val, big.NewInt(1))
+}
+
+// Mod2e256 provides type parametrization for emulated arithmetic:
+//   - limbs: 5
+//   - limb width: 64 bits
+//
+// The modulus for type parametrisation is 2^256. This is the ring of EVM words.
+//
+// The reduced elements fit into four limbs, but the modulus itself is 257 bits
+// and [emulated.NewField] requires NbLimbs to be the number of limbs of the
+// modulus. The modulus is used as a constant element in the multiplication
+// checks and the range checks, so it has to be representable with NbLimbs
+// limbs. Thus the elements are represented using five limbs, where the most
+// significant limb of a reduced element is zero. This costs one limb in every
+// operation, compared to the prime moduli of the same width.
+//
+// This is a non-prime modulus. Only the odd elements are invertible, see
+// [emulated.Field.TryInverse].
+type Mod2e256 struct{}
+
+func (Mod2e256) NbLimbs() uint     { return 5 }
+func (Mod2e256) BitsPerLimb() uint { return 64 }
+func (Mod2e256) IsPrime() bool     { return false }
+func (Mod2e256) Modulus() *big.Int { return new(big.Int).Lsh(big.NewInt(1), 256) }
+
+// Mod2e64 provides type parametrization for emulated arithmetic:
+//   - limbs: 2
+//   - limb width: 64 bits
+//
+// The modulus for type parametrisation is 2^64. As for [Mod2e256], the modulus
+// is one bit wider than the reduced elements and needs an additional limb, so
+// the elements are represented using two limbs.
+//
+// This is a non-prime modulus. Only the odd elements are invertible, see
+// [emulated.Field.TryInverse].
+type Mod2e64 struct{}
+
+func (Mod2e64) NbLimbs() uint     { return 2 }
+func (Mod2e64) BitsPerLimb() uint { return 64 }
+func (Mod2e64) IsPrime() bool     { return false }
+func (Mod2e64) Modulus() *big.Int { return new(big.Int).Lsh(big.NewInt(1), 64) }
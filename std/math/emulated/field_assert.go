@@ -128,7 +128,9 @@ func (f *Field[T]) AssertIsEqual(a, b *Element[T]) {
 		return
 	}
 
-	diff := f.subNoReduce(b, a)
+	// we reduce the inputs if the difference would overflow. Otherwise, the
+	// carries in the limb equality check would not fit into the native field.
+	diff := f.Sub(b, a)
 
 	// we compute k such that diff / p == k
 	// so essentially, we say "I know an element k such that k*p == diff"
@@ -154,6 +156,13 @@ func (f *Field[T]) AssertIsLessOrEqual(e, a *Element[T]) {
 	}
 	eBits := f.ToBits(e)
 	aBits := f.ToBits(a)
+	f.assertBitsLessOrEqual(eBits, aBits)
+}
+
+// assertBitsLessOrEqual ensures that the value given by bits eBits is less or
+// equal than the value given by bits aBits. The bits are in little-endian
+// order and may have different lengths.
+func (f *Field[T]) assertBitsLessOrEqual(eBits, aBits []frontend.Variable) {
 	ff := func(xbits, ybits []frontend.Variable) []frontend.Variable {
 		diff := len(xbits) - len(ybits)
 		ybits = append(ybits, make([]frontend.Variable, diff)...)
@@ -198,7 +207,26 @@ func (f *Field[T]) IsZero(a *Element[T]) frontend.Variable {
 	f.AssertIsInRange(ca)
 	res := f.api.IsZero(ca.Limbs[0])
 	for i := 1; i < len(ca.Limbs); i++ {
-		f.api.Mul(res, f.api.IsZero(ca.Limbs[i]))
+		res = f.api.Mul(res, f.api.IsZero(ca.Limbs[i]))
+	}
+	return res
+}
+
+// IsLess returns 1 if the canonical representation of a is less than the
+// canonical representation of b and 0 otherwise. The inputs do not have to be
+// reduced.
+func (f *Field[T]) IsLess(a, b *Element[T]) frontend.Variable {
+	aBits := f.ToBitsCanonical(a)
+	bBits := f.ToBitsCanonical(b)
+	// we go from the most significant bit and keep track if all the more
+	// significant bits are equal. The result is set at the first differing
+	// bit.
+	var res, eq frontend.Variable = 0, 1
+	for i := len(aBits) - 1; i >= 0; i-- {
+		// 1 if a_i < b_i
+		lt := f.api.Mul(f.api.Sub(1, aBits[i]), bBits[i])
+		res = f.api.MulAcc(res, eq, lt)
+		eq = f.api.Mul(eq, f.api.Sub(1, f.api.Xor(aBits[i], bBits[i])))
 	}
 	return res
 }
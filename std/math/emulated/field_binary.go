@@ -1,6 +1,9 @@
 package emulated
 
 import (
+	"fmt"
+	"math/big"
+
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/std/math/bits"
 )
@@ -34,6 +37,38 @@ func (f *Field[T]) ToBits(a *Element[T]) []frontend.Variable {
 	return fullBits
 }
 
+// ToBitsCanonical returns the bit representation of the canonical
+// representation of the Element in little-endian (LSB first) order. The
+// returned bits are constrained to be 0-1 and the value given by the bits is
+// less than the modulus. The number of returned bits is the bit-length of the
+// largest element, i.e. of modulus-1.
+//
+// The input does not have to be reduced. As the element with zero overflow may
+// still have value larger than the modulus, the input is always reduced.
+func (f *Field[T]) ToBitsCanonical(a *Element[T]) []frontend.Variable {
+	nbBits := new(big.Int).Sub(f.fParams.Modulus(), big.NewInt(1)).BitLen()
+	if ba, aConst := f.constantValue(a); aConst {
+		ba.Mod(ba, f.fParams.Modulus())
+		res := make([]frontend.Variable, nbBits)
+		for i := range res {
+			res[i] = ba.Bit(i)
+		}
+		return res
+	}
+	ca := f.reduceAndOp(f.mulMod, f.mulPreCond, a, f.One())
+	bts := f.ToBits(ca)
+	pBits := f.ToBits(f.modulusPrev())
+	f.assertBitsLessOrEqual(bts, pBits)
+	// the more significant bits are zero as the value is less than modulus.
+	return bts[:nbBits]
+}
+
+// IsOdd returns 1 if the canonical representation of a is odd and 0 otherwise.
+// The input does not have to be reduced.
+func (f *Field[T]) IsOdd(a *Element[T]) frontend.Variable {
+	return f.ToBitsCanonical(a)[0]
+}
+
 // FromBits returns a new Element given the bits is little-endian order.
 func (f *Field[T]) FromBits(bs ...frontend.Variable) *Element[T] {
 	nbLimbs := (uint(len(bs)) + f.fParams.BitsPerLimb() - 1) / f.fParams.BitsPerLimb()
@@ -44,3 +79,45 @@ func (f *Field[T]) FromBits(bs ...frontend.Variable) *Element[T] {
 	limbs[nbLimbs-1] = bits.FromBinary(f.api, bs[(nbLimbs-1)*f.fParams.BitsPerLimb():])
 	return f.newInternalElement(limbs, 0)
 }
+
+// ModReduce returns the canonical representation of a in the emulated field T
+// reduced modulo the modulus of the emulated field S. For example, it can be
+// used for reducing the x-coordinate of a point modulo the scalar field in the
+// ECDSA signature verification. The result is the canonical representation in
+// S, i.e. it is less than the modulus of S.
+func ModReduce[S, T FieldParams](api frontend.API, a *Element[T]) (*Element[S], error) {
+	from, err := NewField[T](api)
+	if err != nil {
+		return nil, fmt.Errorf("new field: %w", err)
+	}
+	to, err := NewField[S](api)
+	if err != nil {
+		return nil, fmt.Errorf("new field: %w", err)
+	}
+	var fs S
+	bts := from.ToBitsCanonical(a)
+	// we split the bits into chunks which fit into an element of S and then
+	// compute sum_i chunk_i * 2^(i*chunkSize) in S.
+	chunkSize := int(fs.NbLimbs() * fs.BitsPerLimb())
+	var res *Element[S]
+	for i := 0; i < len(bts); i += chunkSize {
+		chunk := make([]frontend.Variable, chunkSize)
+		for j := range chunk {
+			chunk[j] = 0
+			if i+j < len(bts) {
+				chunk[j] = bts[i+j]
+			}
+		}
+		e := to.FromBits(chunk...)
+		if i > 0 {
+			shift := new(big.Int).Lsh(big.NewInt(1), uint(i))
+			e = to.Mul(e, newConstElement[S](shift))
+		}
+		if res == nil {
+			res = e
+		} else {
+			res = to.Add(res, e)
+		}
+	}
+	return to.FromBits(to.ToBitsCanonical(res)...), nil
+}
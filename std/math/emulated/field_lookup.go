@@ -0,0 +1,58 @@
+package emulated
+
+import (
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
+)
+
+// LookupTable is a table of emulated elements which can be queried at variable
+// indices. Every limb is stored in a separate native lookup table using the
+// log-derivative argument, so the cost of the table is linear in the number of
+// entries and queries. For a single query, [Field.Mux] is more efficient.
+type LookupTable[T FieldParams] struct {
+	f        *Field[T]
+	limbs    []*logderivlookup.Table
+	overflow uint
+}
+
+// NewLookupTable returns a new lookup table with the given entries. The entries
+// are indexed starting from zero.
+func (f *Field[T]) NewLookupTable(entries ...*Element[T]) *LookupTable[T] {
+	if len(entries) == 0 {
+		panic("no entries for the table")
+	}
+	var overflow uint
+	var nbLimbs int
+	for i := range entries {
+		f.enforceWidthConditional(entries[i])
+		overflow = max(overflow, entries[i].overflow)
+		nbLimbs = max(nbLimbs, len(entries[i].Limbs))
+	}
+	t := &LookupTable[T]{
+		f:        f,
+		limbs:    make([]*logderivlookup.Table, nbLimbs),
+		overflow: overflow,
+	}
+	for i := range t.limbs {
+		t.limbs[i] = logderivlookup.New(f.api)
+		for j := range entries {
+			if i < len(entries[j].Limbs) {
+				t.limbs[i].Insert(entries[j].Limbs[i])
+			} else {
+				t.limbs[i].Insert(0)
+			}
+		}
+	}
+	return t
+}
+
+// Lookup returns the entry at index idx. If the index is out of bounds, then
+// the circuit is not satisfiable. The overflow of the result is the maximum of
+// the entries' overflows.
+func (t *LookupTable[T]) Lookup(idx frontend.Variable) *Element[T] {
+	limbs := make([]frontend.Variable, len(t.limbs))
+	for i := range t.limbs {
+		limbs[i] = t.limbs[i].Lookup(idx)[0]
+	}
+	return t.f.newInternalElement(limbs, t.overflow)
+}
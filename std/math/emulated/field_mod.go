@@ -0,0 +1,163 @@
+package emulated
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+)
+
+// The methods in this file perform arithmetic modulo a variable modulus which is
+// given as an [Element] instead of the modulus defined by the type parameter.
+// This allows to implement primitives where the modulus is only known at
+// proving time, such as RSA signature verification, the MODEXP precompile or
+// Paillier encryption. The type parameter T only defines the width of the
+// elements and has to be wide enough to fit the inputs and the modulus. See
+// [emparams.Mod1e512] and [emparams.Mod1e4096] for such parameters.
+//
+// The multiplication checks are performed in the same way as for the fixed
+// modulus (see [mulCheck]), but we evaluate the variable modulus as a
+// polynomial at the random challenge instead of the constant modulus.
+//
+// NB! The results are not guaranteed to be reduced below the modulus, only to
+// fit into the number of limbs defined by T. Use [Field.ModAssertIsEqual] for
+// comparing the results.
+//
+// All the methods assert that the modulus is non-zero, so for the zero modulus
+// the circuit is not satisfiable.
+
+// ModMul computes a*b mod modulus and returns it. The modulus must be non-zero,
+// otherwise the circuit is not satisfiable.
+func (f *Field[T]) ModMul(a, b *Element[T], modulus *Element[T]) *Element[T] {
+	f.assertModulusNonZero(modulus)
+	return f.modMul(a, b, modulus)
+}
+
+// modMul computes a*b mod modulus without asserting that the modulus is
+// non-zero.
+func (f *Field[T]) modMul(a, b *Element[T], modulus *Element[T]) *Element[T] {
+	a, b, _ = f.modReduceOperands(f.mulPreCond, a, b, modulus)
+	return f.mulModWith(a, b, modulus)
+}
+
+// ModAdd computes a+b mod modulus and returns it. The modulus must be non-zero,
+// otherwise the circuit is not satisfiable.
+func (f *Field[T]) ModAdd(a, b *Element[T], modulus *Element[T]) *Element[T] {
+	f.assertModulusNonZero(modulus)
+	a, b, nextOverflow := f.modReduceOperands(f.addPreCond, a, b, modulus)
+	f.enforceWidthConditional(a)
+	f.enforceWidthConditional(b)
+	// we can not use [Field.add] as it reduces constant inputs modulo the
+	// emulation parameter.
+	nbLimbs := max(len(a.Limbs), len(b.Limbs))
+	limbs := make([]frontend.Variable, nbLimbs)
+	for i := range limbs {
+		limbs[i] = 0
+		if i < len(a.Limbs) {
+			limbs[i] = f.api.Add(limbs[i], a.Limbs[i])
+		}
+		if i < len(b.Limbs) {
+			limbs[i] = f.api.Add(limbs[i], b.Limbs[i])
+		}
+	}
+	return f.mulModWith(f.newInternalElement(limbs, nextOverflow), f.One(), modulus)
+}
+
+// ModExp computes base^exp mod modulus and returns it. The exponent is
+// decomposed into bits and the result is computed using square-and-multiply.
+// If the exponent is a constant, then the multiplications with the zero bits
+// are omitted. The modulus must be non-zero, otherwise the circuit is not
+// satisfiable.
+func (f *Field[T]) ModExp(base, exp *Element[T], modulus *Element[T]) *Element[T] {
+	f.assertModulusNonZero(modulus)
+	expBts := f.ToBits(exp)
+	res := f.exp(base, expBts, func(a, b *Element[T]) *Element[T] {
+		return f.modMul(a, b, modulus)
+	})
+	// when the exponent has no constant set bits, then the result may be the
+	// initial 1 which is not reduced. x^0 = 1 mod modulus, but for modulus 1
+	// we still have to reduce.
+	for i := range expBts {
+		if c, isConst := f.api.Compiler().ConstantValue(expBts[i]); isConst && c.Sign() != 0 {
+			return res
+		}
+	}
+	return f.mulModWith(res, f.One(), modulus)
+}
+
+// ModInverse computes the inverse of a modulo modulus and returns it. If a and
+// the modulus are not coprime, then the circuit is not satisfiable.
+func (f *Field[T]) ModInverse(a *Element[T], modulus *Element[T]) *Element[T] {
+	f.enforceWidthConditional(a)
+	f.enforceWidthConditional(modulus)
+	hintInputs := []frontend.Variable{
+		f.fParams.BitsPerLimb(),
+		f.fParams.NbLimbs(),
+	}
+	hintInputs = append(hintInputs, modulus.Limbs...)
+	hintInputs = append(hintInputs, a.Limbs...)
+	res, err := f.api.NewHint(InverseHint, int(f.fParams.NbLimbs()), hintInputs...)
+	if err != nil {
+		panic(fmt.Sprintf("inverse hint: %v", err))
+	}
+	e := f.packLimbs(res, true)
+	f.ModAssertIsEqual(f.ModMul(a, e, modulus), f.One(), modulus)
+	return e
+}
+
+// ModAssertIsEqual asserts that a and b are equal modulo modulus. The inputs do
+// not have to be reduced.
+func (f *Field[T]) ModAssertIsEqual(a, b *Element[T], modulus *Element[T]) {
+	// we reduce a modulo the modulus and then check that b reduces to the same
+	// value. The quotients are non-negative, so we have a = r + k_a*modulus and
+	// b = r + k_b*modulus, which implies that a-b is a multiple of modulus.
+	r := f.ModMul(a, f.One(), modulus)
+	b, one, _ := f.modReduceOperands(f.mulPreCond, b, f.One(), modulus)
+	f.enforceWidthConditional(b)
+	f.enforceWidthConditional(modulus)
+	k, _, c, err := f.callMulHint(b, one, modulus, false)
+	if err != nil {
+		panic(err)
+	}
+	f.mulChecks = append(f.mulChecks, mulCheck[T]{
+		f: f,
+		a: b,
+		b: one,
+		c: c,
+		k: k,
+		r: r,
+		p: modulus,
+	})
+}
+
+// assertModulusNonZero asserts that the variable modulus is non-zero. As the
+// limbs of the modulus are width-constrained, their sum does not overflow the
+// native field and it is zero only if all the limbs are zero.
+func (f *Field[T]) assertModulusNonZero(modulus *Element[T]) {
+	f.enforceWidthConditional(modulus)
+	var sum frontend.Variable = 0
+	for i := range modulus.Limbs {
+		sum = f.api.Add(sum, modulus.Limbs[i])
+	}
+	f.api.AssertIsDifferent(sum, 0)
+}
+
+// modReduceOperands reduces the inputs modulo the modulus (larger overflow
+// first) until the operation with the precondition preCond does not overflow.
+// It is similar to [Field.reduceAndOp], but we can not reduce modulo the
+// emulation parameter.
+func (f *Field[T]) modReduceOperands(preCond func(a, b *Element[T]) (uint, error), a, b, modulus *Element[T]) (*Element[T], *Element[T], uint) {
+	for {
+		nextOverflow, err := preCond(a, b)
+		if err == nil {
+			return a, b, nextOverflow
+		}
+		if a.overflow == 0 && b.overflow == 0 {
+			panic(fmt.Sprintf("reduced inputs overflow: %v", err))
+		}
+		if a.overflow < b.overflow {
+			b = f.mulModWith(b, f.One(), modulus)
+		} else {
+			a = f.mulModWith(a, f.One(), modulus)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package emulated
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated/emparams"
+	"github.com/consensys/gnark/test"
+)
+
+type variableModulusCircuit[T FieldParams] struct {
+	A, B, Modulus                     Element[T]
+	Prod, Sum, Exp, Inv, NonCanonical Element[T]
+}
+
+func (c *variableModulusCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	f.ModAssertIsEqual(f.ModMul(&c.A, &c.B, &c.Modulus), &c.Prod, &c.Modulus)
+	f.ModAssertIsEqual(f.ModAdd(&c.A, &c.B, &c.Modulus), &c.Sum, &c.Modulus)
+	f.ModAssertIsEqual(f.ModExp(&c.A, &c.B, &c.Modulus), &c.Exp, &c.Modulus)
+	f.ModAssertIsEqual(f.ModInverse(&c.A, &c.Modulus), &c.Inv, &c.Modulus)
+	f.ModAssertIsEqual(&c.Prod, &c.NonCanonical, &c.Modulus)
+	return nil
+}
+
+func variableModulusAssignment(a, b, p *big.Int) *variableModulusCircuit[emparams.Mod1e512] {
+	prod := new(big.Int).Mul(a, b)
+	prod.Mod(prod, p)
+	sum := new(big.Int).Add(a, b)
+	sum.Mod(sum, p)
+	exp := new(big.Int).Exp(a, b, p)
+	inv := new(big.Int).ModInverse(a, p)
+	return &variableModulusCircuit[emparams.Mod1e512]{
+		A:            ValueOf[emparams.Mod1e512](a),
+		B:            ValueOf[emparams.Mod1e512](b),
+		Modulus:      ValueOf[emparams.Mod1e512](p),
+		Prod:         ValueOf[emparams.Mod1e512](prod),
+		Sum:          ValueOf[emparams.Mod1e512](sum),
+		Exp:          ValueOf[emparams.Mod1e512](exp),
+		Inv:          ValueOf[emparams.Mod1e512](inv),
+		NonCanonical: ValueOf[emparams.Mod1e512](new(big.Int).Add(prod, p)),
+	}
+}
+
+func TestVariableModulus(t *testing.T) {
+	assert := test.NewAssert(t)
+	bound := new(big.Int).Lsh(big.NewInt(1), 256)
+	// odd modulus, so that a random even value is invertible with a good
+	// probability.
+	p, _ := rand.Int(rand.Reader, bound)
+	p.SetBit(p, 0, 1)
+	var a *big.Int
+	for {
+		a, _ = rand.Int(rand.Reader, bound)
+		if new(big.Int).GCD(nil, nil, a, p).Cmp(big.NewInt(1)) == 0 {
+			break
+		}
+	}
+	b, _ := rand.Int(rand.Reader, bound)
+	assignment := variableModulusAssignment(a, b, p)
+	assert.NoError(test.IsSolved(&variableModulusCircuit[emparams.Mod1e512]{}, assignment, testCurve.ScalarField()))
+
+	// wrong product
+	wrong := variableModulusAssignment(a, b, p)
+	prod := new(big.Int).Mul(a, b)
+	prod.Add(prod, big.NewInt(1)).Mod(prod, p)
+	wrong.Prod = ValueOf[emparams.Mod1e512](prod)
+	assert.Error(test.IsSolved(&variableModulusCircuit[emparams.Mod1e512]{}, wrong, testCurve.ScalarField()))
+
+	// wrong exponentiation
+	wrong = variableModulusAssignment(a, b, p)
+	wrong.Exp = ValueOf[emparams.Mod1e512](new(big.Int).Exp(a, new(big.Int).Add(b, big.NewInt(1)), p))
+	assert.Error(test.IsSolved(&variableModulusCircuit[emparams.Mod1e512]{}, wrong, testCurve.ScalarField()))
+}
+
+type zeroModulusCircuit struct {
+	A, B, Modulus, Expected Element[emparams.Mod1e512]
+
+	op string
+}
+
+func (c *zeroModulusCircuit) Define(api frontend.API) error {
+	f, err := NewField[emparams.Mod1e512](api)
+	if err != nil {
+		return err
+	}
+	var res *Element[emparams.Mod1e512]
+	switch c.op {
+	case "mul":
+		res = f.ModMul(&c.A, &c.B, &c.Modulus)
+	case "add":
+		res = f.ModAdd(&c.A, &c.B, &c.Modulus)
+	case "exp":
+		res = f.ModExp(&c.A, &c.B, &c.Modulus)
+	default:
+		return fmt.Errorf("unknown operation %s", c.op)
+	}
+	// the modulus may be zero, so we compare the limbs and not the residues.
+	for i := range res.Limbs {
+		api.AssertIsEqual(res.Limbs[i], c.Expected.Limbs[i])
+	}
+	return nil
+}
+
+func TestVariableModulusZero(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, tc := range []struct {
+		op                          string
+		modulus, reduced, unreduced int64
+	}{
+		{"mul", 7, 6, 6},
+		{"add", 4, 1, 5},
+		{"exp", 7, 1, 8},
+	} {
+		assignment := &zeroModulusCircuit{
+			A:        ValueOf[emparams.Mod1e512](2),
+			B:        ValueOf[emparams.Mod1e512](3),
+			Modulus:  ValueOf[emparams.Mod1e512](tc.modulus),
+			Expected: ValueOf[emparams.Mod1e512](tc.reduced),
+		}
+		assert.NoError(test.IsSolved(&zeroModulusCircuit{op: tc.op}, assignment, testCurve.ScalarField()), tc.op)
+		// for the zero modulus the hint returns the unreduced result, which
+		// satisfies the multiplication checks. Only the explicit check for the
+		// modulus rejects it.
+		assignment.Modulus = ValueOf[emparams.Mod1e512](0)
+		assignment.Expected = ValueOf[emparams.Mod1e512](tc.unreduced)
+		assert.Error(test.IsSolved(&zeroModulusCircuit{op: tc.op}, assignment, testCurve.ScalarField()), tc.op)
+	}
+}
+
+type variableModulusMulCircuit struct {
+	A, B, Modulus, Prod Element[emparams.Mod1e512]
+}
+
+func (c *variableModulusMulCircuit) Define(api frontend.API) error {
+	f, err := NewField[emparams.Mod1e512](api)
+	if err != nil {
+		return err
+	}
+	f.ModAssertIsEqual(f.ModMul(&c.A, &c.B, &c.Modulus), &c.Prod, &c.Modulus)
+	return nil
+}
+
+func TestVariableModulusProving(t *testing.T) {
+	assert := test.NewAssert(t)
+	bound := new(big.Int).Lsh(big.NewInt(1), 512)
+	p, _ := rand.Int(rand.Reader, bound)
+	a, _ := rand.Int(rand.Reader, bound)
+	b, _ := rand.Int(rand.Reader, bound)
+	prod := new(big.Int).Mul(a, b)
+	prod.Mod(prod, p)
+	assignment := &variableModulusMulCircuit{
+		A:       ValueOf[emparams.Mod1e512](a),
+		B:       ValueOf[emparams.Mod1e512](b),
+		Modulus: ValueOf[emparams.Mod1e512](p),
+		Prod:    ValueOf[emparams.Mod1e512](prod),
+	}
+	assert.CheckCircuit(&variableModulusMulCircuit{}, test.WithValidAssignment(assignment), test.WithCurves(testCurve), test.NoFuzzing())
+}
+
+type rsaCircuit struct {
+	Signature, Modulus, Message Element[emparams.Mod1e512]
+}
+
+func (c *rsaCircuit) Define(api frontend.API) error {
+	f, err := NewField[emparams.Mod1e512](api)
+	if err != nil {
+		return err
+	}
+	e := ValueOf[emparams.Mod1e512](65537)
+	f.ModAssertIsEqual(f.ModExp(&c.Signature, &e, &c.Modulus), &c.Message, &c.Modulus)
+	return nil
+}
+
+func TestVariableModulusRSA(t *testing.T) {
+	assert := test.NewAssert(t)
+	p, _ := rand.Prime(rand.Reader, 256)
+	q, _ := rand.Prime(rand.Reader, 256)
+	n := new(big.Int).Mul(p, q)
+	phi := new(big.Int).Mul(new(big.Int).Sub(p, big.NewInt(1)), new(big.Int).Sub(q, big.NewInt(1)))
+	d := new(big.Int).ModInverse(big.NewInt(65537), phi)
+	if d == nil {
+		t.Skip("65537 not invertible")
+	}
+	msg, _ := rand.Int(rand.Reader, n)
+	sig := new(big.Int).Exp(msg, d, n)
+	assignment := &rsaCircuit{
+		Signature: ValueOf[emparams.Mod1e512](sig),
+		Modulus:   ValueOf[emparams.Mod1e512](n),
+		Message:   ValueOf[emparams.Mod1e512](msg),
+	}
+	assert.NoError(test.IsSolved(&rsaCircuit{}, assignment, testCurve.ScalarField()))
+	assignment.Message = ValueOf[emparams.Mod1e512](new(big.Int).Add(msg, big.NewInt(1)))
+	assert.Error(test.IsSolved(&rsaCircuit{}, assignment, testCurve.ScalarField()))
+}
+
+type modExpZeroCircuit struct {
+	Base, Exp, Modulus, Expected Element[emparams.Mod1e512]
+}
+
+func (c *modExpZeroCircuit) Define(api frontend.API) error {
+	f, err := NewField[emparams.Mod1e512](api)
+	if err != nil {
+		return err
+	}
+	res := f.ModExp(&c.Base, &c.Exp, &c.Modulus)
+	// the result must be reduced also for the zero exponent, so we compare the
+	// limbs and not the residues modulo the emulated modulus.
+	for i := range res.Limbs {
+		api.AssertIsEqual(res.Limbs[i], c.Expected.Limbs[i])
+	}
+	return nil
+}
+
+func TestVariableModulusExpZero(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, tc := range []struct {
+		modulus, expected int64
+	}{
+		{1, 0},
+		{7, 1},
+	} {
+		assignment := &modExpZeroCircuit{
+			Base:     ValueOf[emparams.Mod1e512](5),
+			Exp:      ValueOf[emparams.Mod1e512](0),
+			Modulus:  ValueOf[emparams.Mod1e512](tc.modulus),
+			Expected: ValueOf[emparams.Mod1e512](tc.expected),
+		}
+		assert.NoError(test.IsSolved(&modExpZeroCircuit{}, assignment, testCurve.ScalarField()), tc.modulus)
+		// constant zero exponent
+		assert.NoError(test.IsSolved(&modExpZeroCircuit{}, assignment, testCurve.ScalarField(), test.SetAllVariablesAsConstants()), tc.modulus)
+	}
+}
@@ -27,6 +27,7 @@ import (
 //   - r - the multiplication result reduced modulo the emulation parameter.
 //   - k - the quotient for integer multiplication a*b divided by emulation parameter.
 //   - c - element representing carry. Used only for aligning the limb widths.
+//   - p - the modulus. If nil, then the emulation parameter is used.
 //
 // Given these values, the following holds:
 //
@@ -58,6 +59,7 @@ type mulCheck[T FieldParams] struct {
 	r    *Element[T] // reduced value
 	k    *Element[T] // coefficient
 	c    *Element[T] // carry
+	p    *Element[T] // modulus if non-nil
 }
 
 // evalRound1 evaluates first c(X), r(X) and k(X) at a given random point at[0].
@@ -67,6 +69,9 @@ func (mc *mulCheck[T]) evalRound1(api frontend.API, at []frontend.Variable) {
 	mc.c = mc.f.evalWithChallenge(mc.c, at)
 	mc.r = mc.f.evalWithChallenge(mc.r, at)
 	mc.k = mc.f.evalWithChallenge(mc.k, at)
+	if mc.p != nil {
+		mc.p = mc.f.evalWithChallenge(mc.p, at)
+	}
 }
 
 // evalRound2 now evaluates a and b at a given random point at[0]. However, it
@@ -79,8 +84,13 @@ func (mc *mulCheck[T]) evalRound2(api frontend.API, at []frontend.Variable) {
 
 // check checks a(ch) * b(ch) = r(ch) + k(ch) * p(ch) + (2^t - ch) c(ch). As the
 // computation of p(ch) and (2^t-ch) can be shared over all mulCheck instances,
-// then we get them already evaluated as peval and coef.
+// then we get them already evaluated as peval and coef. If the check is
+// performed modulo a variable modulus, then peval is ignored and the evaluation
+// of the variable modulus is used instead.
 func (mc *mulCheck[T]) check(api frontend.API, peval, coef frontend.Variable) {
+	if mc.p != nil {
+		peval = mc.p.evaluation
+	}
 	ls := api.Mul(mc.a.evaluation, mc.b.evaluation)
 	rs := api.Add(mc.r.evaluation, api.Mul(peval, mc.k.evaluation), api.Mul(mc.c.evaluation, coef))
 	api.AssertIsEqual(ls, rs)
@@ -99,14 +109,27 @@ func (mc *mulCheck[T]) cleanEvaluations() {
 	mc.k.isEvaluated = false
 	mc.c.evaluation = 0
 	mc.c.isEvaluated = false
+	if mc.p != nil {
+		mc.p.evaluation = 0
+		mc.p.isEvaluated = false
+	}
 }
 
 // mulMod returns a*b mod r. In practice it computes the result using a hint and
 // defers the actual multiplication check.
 func (f *Field[T]) mulMod(a, b *Element[T], _ uint) *Element[T] {
+	return f.mulModWith(a, b, nil)
+}
+
+// mulModWith returns a*b mod p. If p is nil, then reduces modulo the emulation
+// parameter. Similarly to [Field.mulMod] the multiplication check is deferred.
+func (f *Field[T]) mulModWith(a, b, p *Element[T]) *Element[T] {
 	f.enforceWidthConditional(a)
 	f.enforceWidthConditional(b)
-	k, r, c, err := f.callMulHint(a, b)
+	if p != nil {
+		f.enforceWidthConditional(p)
+	}
+	k, r, c, err := f.callMulHint(a, b, p, true)
 	if err != nil {
 		panic(err)
 	}
@@ -117,6 +140,7 @@ func (f *Field[T]) mulMod(a, b *Element[T], _ uint) *Element[T] {
 		c: c,
 		k: k,
 		r: r,
+		p: p,
 	}
 	f.mulChecks = append(f.mulChecks, mc)
 	return r
@@ -167,6 +191,9 @@ func (f *Field[T]) performMulChecks(api frontend.API) error {
 		toCommit = append(toCommit, f.mulChecks[i].r.Limbs...)
 		toCommit = append(toCommit, f.mulChecks[i].k.Limbs...)
 		toCommit = append(toCommit, f.mulChecks[i].c.Limbs...)
+		if f.mulChecks[i].p != nil {
+			toCommit = append(toCommit, f.mulChecks[i].p.Limbs...)
+		}
 	}
 	// we give all the inputs as inputs to obtain random verifier challenge.
 	multicommit.WithCommitment(api, func(api frontend.API, commitment frontend.Variable) error {
@@ -209,8 +236,10 @@ func (f *Field[T]) performMulChecks(api frontend.API) error {
 	return nil
 }
 
-// callMulHint uses hint to compute r, k and c.
-func (f *Field[T]) callMulHint(a, b *Element[T]) (quo, rem, carries *Element[T], err error) {
+// callMulHint uses hint to compute r, k and c. If modulus is nil, then the
+// emulation parameter is used as the modulus. If withRem is false, then the
+// remainder is not range checked and not returned.
+func (f *Field[T]) callMulHint(a, b, modulus *Element[T], withRem bool) (quo, rem, carries *Element[T], err error) {
 	// inputs is always nblimbs
 	// quotient may be larger if inputs have overflow
 	// remainder is always nblimbs
@@ -219,8 +248,16 @@ func (f *Field[T]) callMulHint(a, b *Element[T]) (quo, rem, carries *Element[T],
 	// skip error handle - it happens when we are supposed to reduce. But we
 	// already check it as a precondition. We only need the overflow here.
 	nbLimbs, nbBits := f.fParams.NbLimbs(), f.fParams.BitsPerLimb()
+	modBits := uint(f.fParams.Modulus().BitLen())
+	if modulus == nil {
+		modulus = f.Modulus()
+	} else {
+		// the value of the modulus is not known at compile time. We only
+		// assume it is non-zero, in which case the quotient is at most a*b.
+		modBits = 1
+	}
 	nbQuoLimbs := ((2*nbLimbs-1)*nbBits + nextOverflow + 1 - //
-		uint(f.fParams.Modulus().BitLen()) + //
+		modBits + //
 		nbBits - 1) /
 		nbBits
 	nbRemLimbs := nbLimbs
@@ -229,7 +266,7 @@ func (f *Field[T]) callMulHint(a, b *Element[T]) (quo, rem, carries *Element[T],
 		nbBits,
 		nbLimbs,
 	}
-	hintInputs = append(hintInputs, f.Modulus().Limbs...)
+	hintInputs = append(hintInputs, modulus.Limbs...)
 	hintInputs = append(hintInputs, a.Limbs...)
 	hintInputs = append(hintInputs, b.Limbs...)
 	ret, err := f.api.NewHint(mulHint, int(nbQuoLimbs)+int(nbRemLimbs)+int(nbCarryLimbs), hintInputs...)
@@ -238,7 +275,9 @@ func (f *Field[T]) callMulHint(a, b *Element[T]) (quo, rem, carries *Element[T],
 		return
 	}
 	quo = f.packLimbs(ret[:nbQuoLimbs], false)
-	rem = f.packLimbs(ret[nbQuoLimbs:nbQuoLimbs+nbRemLimbs], true)
+	if withRem {
+		rem = f.packLimbs(ret[nbQuoLimbs:nbQuoLimbs+nbRemLimbs], true)
+	}
 	carries = f.newInternalElement(ret[nbQuoLimbs+nbRemLimbs:], 0)
 	return
 }
@@ -277,7 +316,14 @@ func mulHint(field *big.Int, inputs, outputs []*big.Int) error {
 	quo := new(big.Int)
 	rem := new(big.Int)
 	ab := new(big.Int).Mul(a, b)
-	quo.QuoRem(ab, p, rem)
+	if p.Sign() == 0 {
+		// only a variable modulus can be zero, in which case the circuit
+		// asserts it to be non-zero. We return the unreduced product so that
+		// the solver fails on that assertion and not in the hint.
+		rem.Set(ab)
+	} else {
+		quo.QuoRem(ab, p, rem)
+	}
 	if err := decompose(quo, uint(nbBits), quoLimbs); err != nil {
 		return fmt.Errorf("decompose quo: %w", err)
 	}
@@ -5,9 +5,12 @@ import (
 	"fmt"
 
 	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/selector"
 )
 
-// Div computes a/b and returns it. It uses [DivHint] as a hint function.
+// Div computes a/b and returns it. It uses [DivHint] as a hint function. If the
+// modulus is not prime, then b must be invertible, otherwise the circuit is not
+// satisfiable.
 func (f *Field[T]) Div(a, b *Element[T]) *Element[T] {
 	return f.reduceAndOp(f.div, f.divPreCond, a, b)
 }
@@ -22,11 +25,6 @@ func (f *Field[T]) divPreCond(a, b *Element[T]) (nextOverflow uint, err error) {
 
 func (f *Field[T]) div(a, b *Element[T], _ uint) *Element[T] {
 	// omit width assertion as for a is done in AssertIsEqual and for b is done in Mul below
-	if !f.fParams.IsPrime() {
-		// TODO shouldn't we still try to do a classic int div in a hint, constraint the result, and let it fail?
-		// that would enable things like uint32 div ?
-		panic("modulus not a prime")
-	}
 	div, err := f.computeDivisionHint(a.Limbs, b.Limbs)
 	if err != nil {
 		panic(fmt.Sprintf("compute division: %v", err))
@@ -37,7 +35,9 @@ func (f *Field[T]) div(a, b *Element[T], _ uint) *Element[T] {
 	return e
 }
 
-// Inverse compute 1/a and returns it. It uses [InverseHint].
+// Inverse compute 1/a and returns it. It uses [InverseHint]. If the modulus is
+// not prime, then a must be invertible, otherwise the circuit is not
+// satisfiable. Use [Field.TryInverse] when the input may not be invertible.
 func (f *Field[T]) Inverse(a *Element[T]) *Element[T] {
 	return f.reduceAndOp(f.inverse, f.inversePreCond, a, nil)
 }
@@ -52,9 +52,6 @@ func (f *Field[T]) inversePreCond(a, _ *Element[T]) (nextOverflow uint, err erro
 
 func (f *Field[T]) inverse(a, _ *Element[T], _ uint) *Element[T] {
 	// omit width assertion as is done in Mul below
-	if !f.fParams.IsPrime() {
-		panic("modulus not a prime")
-	}
 	k, err := f.computeInverseHint(a.Limbs)
 	if err != nil {
 		panic(fmt.Sprintf("compute inverse: %v", err))
@@ -66,6 +63,26 @@ func (f *Field[T]) inverse(a, _ *Element[T], _ uint) *Element[T] {
 	return e
 }
 
+// TryInverse computes 1/a and returns it together with a flag indicating if the
+// inverse exists. If the inverse does not exist, then the returned element is
+// zero. Differently from [Field.Inverse], the method can be used for inputs
+// which are not invertible, for example zero or when the modulus is composite.
+func (f *Field[T]) TryInverse(a *Element[T]) (inv *Element[T], exists frontend.Variable) {
+	res, err := f.NewHint(tryInverseHint, 3, a)
+	if err != nil {
+		panic(fmt.Sprintf("compute inverse: %v", err))
+	}
+	exists = res[0].Limbs[0]
+	f.api.AssertIsBoolean(exists)
+	// if the inverse exists, then a*inv = 1. Otherwise, the hint returns
+	// non-zero z such that a*z = 0, which is only possible if a is not
+	// invertible.
+	t := f.Select(exists, res[1], res[2])
+	f.AssertIsEqual(f.Mul(a, t), f.Select(exists, f.One(), f.Zero()))
+	f.api.AssertIsEqual(f.IsZero(t), 0)
+	return f.Select(exists, res[1], f.Zero()), exists
+}
+
 // Sqrt computes square root of a and returns it. It uses [SqrtHint].
 func (f *Field[T]) Sqrt(a *Element[T]) *Element[T] {
 	return f.reduceAndOp(f.sqrt, f.sqrtPreCond, a, nil)
@@ -93,6 +110,104 @@ func (f *Field[T]) sqrt(a, _ *Element[T], _ uint) *Element[T] {
 	return res[0]
 }
 
+// Exp computes base^exp modulo the field order and returns it. The exponent is
+// decomposed into bits, so it is not reduced modulo the field order. For a
+// constant exponent the multiplications for zero bits are omitted.
+func (f *Field[T]) Exp(base, exp *Element[T]) *Element[T] {
+	return f.exp(base, f.ToBits(exp), f.Mul)
+}
+
+// exp computes base^e using square-and-multiply, where e is given by its bits
+// in little-endian order. The multiplication is given by mul to allow reusing
+// the method for the variable modulus operations.
+func (f *Field[T]) exp(base *Element[T], expBts []frontend.Variable, mul func(a, b *Element[T]) *Element[T]) *Element[T] {
+	// omit the most significant constant zero bits.
+	for len(expBts) > 0 {
+		c, isConst := f.api.Compiler().ConstantValue(expBts[len(expBts)-1])
+		if !isConst || c.Sign() != 0 {
+			break
+		}
+		expBts = expBts[:len(expBts)-1]
+	}
+	res := f.One()
+	acc := base
+	for i := range expBts {
+		if i > 0 {
+			acc = mul(acc, acc)
+		}
+		if c, isConst := f.api.Compiler().ConstantValue(expBts[i]); isConst {
+			if c.Sign() != 0 {
+				res = mul(res, acc)
+			}
+			continue
+		}
+		res = f.Select(expBts[i], mul(res, acc), res)
+	}
+	return res
+}
+
+// BatchInverse computes the inverses of all the elements in a and returns them.
+// If any of the inputs is not invertible, then the circuit is not satisfiable.
+//
+// The inverses are computed out-of-circuit in a single hint call, but every
+// inverse is checked individually with one multiplication. Thus, the number of
+// constraints is the same as when calling [Field.Inverse] for every element.
+// We do not use Montgomery's trick in-circuit, as it would trade the n checks
+// for a single inversion and 3(n-1) multiplications, which is more expensive
+// when an inverse costs only a multiplication check.
+func (f *Field[T]) BatchInverse(a []*Element[T]) []*Element[T] {
+	if len(a) == 0 {
+		return nil
+	}
+	res, err := f.NewHint(batchInverseHint, len(a), a...)
+	if err != nil {
+		panic(fmt.Sprintf("compute batch inverse: %v", err))
+	}
+	one := f.One()
+	for i := range a {
+		f.AssertIsEqual(f.Mul(res[i], a[i]), one)
+	}
+	return res
+}
+
+// Legendre returns the Legendre symbol of a as a native variable. The returned
+// value is 1 if a is a non-zero quadratic residue, -1 if a is a quadratic
+// non-residue and 0 if a is zero. It panics if the modulus is not prime.
+func (f *Field[T]) Legendre(a *Element[T]) frontend.Variable {
+	isZero, isResidue := f.legendre(a)
+	return f.api.Select(isZero, 0, f.api.Sub(f.api.Mul(isResidue, 2), 1))
+}
+
+// IsQuadraticResidue returns 1 if a is a quadratic residue (including zero)
+// and 0 otherwise. It panics if the modulus is not prime.
+func (f *Field[T]) IsQuadraticResidue(a *Element[T]) frontend.Variable {
+	isZero, isResidue := f.legendre(a)
+	return f.api.Or(isZero, isResidue)
+}
+
+// legendre returns if a is zero and if a is a quadratic residue. If a is zero,
+// then the second returned value is not constrained.
+//
+// The hint returns the residuosity flag and a square root of either a (if a
+// is a residue) or of a*g, where g is a fixed non-residue. As the product of a
+// non-zero residue and non-residue is a non-residue, only one of the cases is
+// satisfiable for non-zero a.
+func (f *Field[T]) legendre(a *Element[T]) (isZero, isResidue frontend.Variable) {
+	if !f.fParams.IsPrime() {
+		panic("modulus not a prime")
+	}
+	res, err := f.NewHint(quadraticResidueHint, 2, a)
+	if err != nil {
+		panic(fmt.Sprintf("compute quadratic residue: %v", err))
+	}
+	isResidue = res[0].Limbs[0]
+	f.api.AssertIsBoolean(isResidue)
+	g := newConstElement[T](nonResidue(f.fParams.Modulus()))
+	expected := f.Select(isResidue, a, f.Mul(a, g))
+	f.AssertIsEqual(f.Mul(res[1], res[1]), expected)
+	return f.IsZero(a), isResidue
+}
+
 // Add computes a+b and returns it. If the result wouldn't fit into Element, then
 // first reduces the inputs (larger first) and tries again. Doesn't mutate
 // inputs.
@@ -152,17 +267,6 @@ func (f *Field[T]) Sub(a, b *Element[T]) *Element[T] {
 	return f.reduceAndOp(f.sub, f.subPreCond, a, b)
 }
 
-// subReduce returns a-b and returns it. Contrary to [Field[T].Sub] method this
-// method does not reduce the inputs if the result would overflow. This method
-// is currently only used as a subroutine in [Field[T].Reduce] method to avoid
-// infinite recursion when we are working exactly on the overflow limits.
-func (f *Field[T]) subNoReduce(a, b *Element[T]) *Element[T] {
-	nextOverflow, _ := f.subPreCond(a, b)
-	// we ignore error as it only indicates if we should reduce or not. But we
-	// are in non-reducing version of sub.
-	return f.sub(a, b, nextOverflow)
-}
-
 func (f *Field[T]) subPreCond(a, b *Element[T]) (nextOverflow uint, err error) {
 	reduceRight := a.overflow < (b.overflow + 1)
 	nextOverflow = max(b.overflow+1, a.overflow) + 1
@@ -266,6 +370,41 @@ func (f *Field[T]) Lookup2(b0, b1 frontend.Variable, a, b, c, d *Element[T]) *El
 	return e
 }
 
+// Mux selects the element inputs[sel] and returns it. The selector sel must be
+// between 0 and len(inputs)-1 (inclusive), otherwise the circuit is not
+// satisfiable. The indicators for the selector are computed once and are shared
+// over all the limbs.
+//
+// The number of the limbs and overflow in the result is the maximum of the
+// inputs'. If the inputs are very unbalanced, then reduce the result.
+func (f *Field[T]) Mux(sel frontend.Variable, inputs ...*Element[T]) *Element[T] {
+	if len(inputs) == 0 {
+		panic("no inputs to select from")
+	}
+	if len(inputs) == 1 {
+		f.api.AssertIsEqual(sel, 0)
+		return inputs[0]
+	}
+	var overflow uint
+	var nbLimbs int
+	for i := range inputs {
+		f.enforceWidthConditional(inputs[i])
+		overflow = max(overflow, inputs[i].overflow)
+		nbLimbs = max(nbLimbs, len(inputs[i].Limbs))
+	}
+	indicators := selector.Decoder(f.api, len(inputs), sel)
+	e := f.newInternalElement(make([]frontend.Variable, nbLimbs), overflow)
+	for i := range e.Limbs {
+		e.Limbs[i] = 0
+		for j := range inputs {
+			if i < len(inputs[j].Limbs) {
+				e.Limbs[i] = f.api.MulAcc(e.Limbs[i], indicators[j], inputs[j].Limbs[i])
+			}
+		}
+	}
+	return e
+}
+
 // reduceAndOp applies op on the inputs. If the pre-condition check preCond
 // errs, then first reduces the input arguments. The reduction is done
 // one-by-one with the element with highest overflow reduced first.
@@ -25,6 +25,9 @@ func GetHints() []solver.Hint {
 		RightShift,
 		SqrtHint,
 		mulHint,
+		batchInverseHint,
+		quadraticResidueHint,
+		tryInverseHint,
 	}
 }
 
@@ -294,3 +297,79 @@ func SqrtHint(mod *big.Int, inputs []*big.Int, outputs []*big.Int) error {
 		return nil
 	})
 }
+
+// batchInverseHint computes the inverses of all the inputs.
+func batchInverseHint(mod *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	return UnwrapHint(inputs, outputs, func(field *big.Int, inputs, outputs []*big.Int) error {
+		if len(inputs) != len(outputs) {
+			return fmt.Errorf("expecting equal number of inputs and outputs")
+		}
+		for i := range inputs {
+			if outputs[i].ModInverse(inputs[i], field) == nil {
+				return fmt.Errorf("input %d not invertible", i)
+			}
+		}
+		return nil
+	})
+}
+
+// quadraticResidueHint returns 1 and a square root of the input if the input is
+// a quadratic residue. Otherwise, returns 0 and a square root of input*g, where
+// g is the non-residue returned by [nonResidue].
+func quadraticResidueHint(mod *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	return UnwrapHint(inputs, outputs, func(field *big.Int, inputs, outputs []*big.Int) error {
+		if len(inputs) != 1 {
+			return fmt.Errorf("expecting single input")
+		}
+		if len(outputs) != 2 {
+			return fmt.Errorf("expecting two outputs")
+		}
+		x := new(big.Int).Mod(inputs[0], field)
+		if big.Jacobi(x, field) >= 0 {
+			outputs[0].SetUint64(1)
+		} else {
+			outputs[0].SetUint64(0)
+			x.Mul(x, nonResidue(field))
+			x.Mod(x, field)
+		}
+		if outputs[1].ModSqrt(x, field) == nil {
+			return fmt.Errorf("no square root")
+		}
+		return nil
+	})
+}
+
+// nonResidue returns the smallest quadratic non-residue modulo the prime p.
+func nonResidue(p *big.Int) *big.Int {
+	g := big.NewInt(2)
+	for big.Jacobi(g, p) != -1 {
+		g.Add(g, big.NewInt(1))
+	}
+	return g
+}
+
+// tryInverseHint returns 1 and the inverse of the input if it exists.
+// Otherwise, returns 0 and non-zero z such that input*z = 0.
+func tryInverseHint(mod *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	return UnwrapHint(inputs, outputs, func(field *big.Int, inputs, outputs []*big.Int) error {
+		if len(inputs) != 1 {
+			return fmt.Errorf("expecting single input")
+		}
+		if len(outputs) != 3 {
+			return fmt.Errorf("expecting three outputs")
+		}
+		x := new(big.Int).Mod(inputs[0], field)
+		if outputs[1].ModInverse(x, field) != nil {
+			outputs[0].SetUint64(1)
+			outputs[2].SetUint64(0)
+			return nil
+		}
+		// x*(field/gcd(x, field)) is a multiple of field. For x = 0 we have
+		// gcd equal to field and z = 1.
+		g := new(big.Int).GCD(nil, nil, x, field)
+		outputs[0].SetUint64(0)
+		outputs[1].SetUint64(0)
+		outputs[2].Quo(field, g)
+		return nil
+	})
+}
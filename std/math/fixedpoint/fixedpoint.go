@@ -0,0 +1,270 @@
+// Package fixedpoint implements arithmetic on signed fixed-point numbers.
+//
+// The numbers are in Q-format with n bits in total and f fractional bits, i.e.
+// the raw value x represents the number x/2^f. The raw values are n-bit signed
+// integers handled by the [signed] package, so the additions and subtractions
+// wrap around as in two's-complement arithmetic.
+//
+// The multiplications and divisions compute the exact result and then round it
+// to f fractional bits using the chosen [RoundingMode]. The rounded results are
+// wrapped around to n bits.
+package fixedpoint
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/bitslice"
+	"github.com/consensys/gnark/std/math/cmp"
+	"github.com/consensys/gnark/std/math/signed"
+	"github.com/consensys/gnark/std/rangecheck"
+)
+
+// RoundingMode defines how to round the results of the multiplications and
+// divisions.
+type RoundingMode int
+
+const (
+	// RoundDown rounds towards negative infinity.
+	RoundDown RoundingMode = iota
+	// RoundUp rounds towards positive infinity.
+	RoundUp
+	// RoundTowardZero truncates the result.
+	RoundTowardZero
+	// RoundHalfUp rounds to the nearest number and the ties towards positive
+	// infinity.
+	RoundHalfUp
+	// RoundHalfEven rounds to the nearest number and the ties to the even
+	// number.
+	RoundHalfEven
+)
+
+type config struct {
+	rounding RoundingMode
+}
+
+// Option allows to configure the fixed-point arithmetic.
+type Option func(*config) error
+
+// WithRoundingMode sets the rounding mode. Defaults to [RoundHalfEven].
+func WithRoundingMode(mode RoundingMode) Option {
+	return func(c *config) error {
+		if mode < RoundDown || mode > RoundHalfEven {
+			return fmt.Errorf("unknown rounding mode %d", mode)
+		}
+		c.rounding = mode
+		return nil
+	}
+}
+
+// Fixed implements arithmetic on fixed-point numbers.
+type Fixed struct {
+	api        frontend.API
+	ints       *signed.Int
+	rchecker   frontend.Rangechecker
+	cmp        *cmp.BoundedComparator
+	nbBits     int
+	nbFracBits int
+	rounding   RoundingMode
+}
+
+// New returns a new [*Fixed] for numbers with nbBits bits in total and
+// nbFracBits fractional bits. It returns an error if the number of bits is
+// invalid or too large for the native field. The total number of bits must be
+// less than a third of the bit-length of the native field.
+func New(api frontend.API, nbBits, nbFracBits int, opts ...Option) (*Fixed, error) {
+	cfg := config{rounding: RoundHalfEven}
+	for _, o := range opts {
+		if err := o(&cfg); err != nil {
+			return nil, fmt.Errorf("apply option: %w", err)
+		}
+	}
+	if nbFracBits < 0 || nbFracBits >= nbBits {
+		return nil, fmt.Errorf("number of fractional bits must be in range [0, %d)", nbBits)
+	}
+	// the quotients of the divisions are up to 2n bits and multiplied with
+	// n-bit divisors.
+	if 3*nbBits+2 >= api.Compiler().FieldBitLen() {
+		return nil, fmt.Errorf("number of bits %d too large for the native field", nbBits)
+	}
+	ints, err := signed.New(api, nbBits)
+	if err != nil {
+		return nil, fmt.Errorf("new signed: %w", err)
+	}
+	return &Fixed{
+		api:      api,
+		ints:     ints,
+		rchecker: rangecheck.New(api),
+		// the remainders and divisors are less than 2^n, so the compared
+		// values are less than 2^(n+1).
+		cmp:        cmp.NewBoundedComparator(api, pow2(nbBits+1), false),
+		nbBits:     nbBits,
+		nbFracBits: nbFracBits,
+		rounding:   cfg.rounding,
+	}, nil
+}
+
+// pow2 returns 2^k as a constant.
+func pow2(k int) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(k))
+}
+
+// Ints returns the arithmetic of the underlying signed integers.
+func (fx *Fixed) Ints() *signed.Int {
+	return fx.ints
+}
+
+// Constant returns the raw value of the constant num/den rounded using the
+// rounding mode. It panics if den is zero or the value does not fit into n
+// bits.
+func (fx *Fixed) Constant(num, den int64) frontend.Variable {
+	if den == 0 {
+		panic("zero denominator")
+	}
+	n := new(big.Int).Lsh(big.NewInt(num), uint(fx.nbFracBits))
+	d := big.NewInt(den)
+	if d.Sign() < 0 {
+		n.Neg(n)
+		d.Neg(d)
+	}
+	q, r := new(big.Int).DivMod(n, d, new(big.Int))
+	if roundUp(fx.rounding, q, r, d, n.Sign() < 0) {
+		q.Add(q, big.NewInt(1))
+	}
+	bound := pow2(fx.nbBits - 1)
+	if q.CmpAbs(bound) > 0 || q.Cmp(bound) == 0 {
+		panic("constant out of range")
+	}
+	return q.Mod(q, fx.api.Compiler().Field())
+}
+
+// roundUp returns true if the floored quotient q of the division with the
+// remainder 0 <= r < d has to be incremented.
+func roundUp(mode RoundingMode, q, r, d *big.Int, isNeg bool) bool {
+	if r.Sign() == 0 {
+		return false
+	}
+	twoR := new(big.Int).Lsh(r, 1)
+	switch mode {
+	case RoundUp:
+		return true
+	case RoundTowardZero:
+		return isNeg
+	case RoundHalfUp:
+		return twoR.Cmp(d) >= 0
+	case RoundHalfEven:
+		c := twoR.Cmp(d)
+		return c > 0 || (c == 0 && q.Bit(0) == 1)
+	}
+	return false
+}
+
+// AssertIsInRange asserts that the raw value a is an n-bit signed integer.
+func (fx *Fixed) AssertIsInRange(a frontend.Variable) {
+	fx.ints.AssertIsInRange(a)
+}
+
+// FromInt returns the fixed-point number representing the integer a. The
+// result is wrapped to n bits.
+func (fx *Fixed) FromInt(a frontend.Variable) frontend.Variable {
+	return fx.ints.Wrap(fx.api.Mul(a, pow2(fx.nbFracBits)), fx.nbBits+fx.nbFracBits)
+}
+
+// ToInt returns the integer part of a rounded using the rounding mode.
+func (fx *Fixed) ToInt(a frontend.Variable) frontend.Variable {
+	q := fx.divRound(a, pow2(fx.nbFracBits), fx.nbBits)
+	return fx.ints.Wrap(q, fx.nbBits+1)
+}
+
+// Add returns a+b.
+func (fx *Fixed) Add(a, b frontend.Variable) frontend.Variable {
+	return fx.ints.Add(a, b)
+}
+
+// Sub returns a-b.
+func (fx *Fixed) Sub(a, b frontend.Variable) frontend.Variable {
+	return fx.ints.Sub(a, b)
+}
+
+// Neg returns -a.
+func (fx *Fixed) Neg(a frontend.Variable) frontend.Variable {
+	return fx.ints.Neg(a)
+}
+
+// Abs returns the absolute value of a.
+func (fx *Fixed) Abs(a frontend.Variable) frontend.Variable {
+	return fx.ints.Wrap(fx.ints.Abs(a), fx.nbBits+1)
+}
+
+// Mul returns a*b rounded using the rounding mode.
+func (fx *Fixed) Mul(a, b frontend.Variable) frontend.Variable {
+	q := fx.divRound(fx.api.Mul(a, b), pow2(fx.nbFracBits), 2*fx.nbBits)
+	return fx.ints.Wrap(q, 2*fx.nbBits+1)
+}
+
+// Div returns a/b rounded using the rounding mode. The divisor must be
+// non-zero, otherwise the circuit is not satisfiable.
+func (fx *Fixed) Div(a, b frontend.Variable) frontend.Variable {
+	api := fx.api
+	// we divide by |b| and move the sign of b to the dividend.
+	sign := api.Sub(1, api.Mul(2, fx.ints.IsNegative(b)))
+	num := api.Mul(a, pow2(fx.nbFracBits), sign)
+	q := fx.divRound(num, fx.ints.Abs(b), fx.nbBits+fx.nbFracBits+1)
+	return fx.ints.Wrap(q, fx.nbBits+fx.nbFracBits+2)
+}
+
+// IsLess returns 1 if a < b and 0 otherwise.
+func (fx *Fixed) IsLess(a, b frontend.Variable) frontend.Variable {
+	return fx.ints.IsLess(a, b)
+}
+
+// IsLessOrEqual returns 1 if a <= b and 0 otherwise.
+func (fx *Fixed) IsLessOrEqual(a, b frontend.Variable) frontend.Variable {
+	return fx.ints.IsLessOrEqual(a, b)
+}
+
+// divRound returns v/d rounded using the rounding mode. The divisor d must be
+// in range [1, 2^n] and the dividend v must be in range [-2^(bound-1),
+// 2^(bound-1)), where bound >= n. Otherwise the circuit is not satisfiable.
+// The result is in range [-2^(bound-1), 2^(bound-1)].
+func (fx *Fixed) divRound(v, d frontend.Variable, bound int) frontend.Variable {
+	api := fx.api
+	res, err := api.Compiler().NewHint(floorDivHint, 2, v, d)
+	if err != nil {
+		panic(err)
+	}
+	q, r := res[0], res[1]
+	// 0 <= r < d <= 2^n
+	fx.rchecker.Check(r, fx.nbBits)
+	fx.rchecker.Check(api.Sub(d, r, 1), fx.nbBits)
+	// q is in range [-2^(bound-1), 2^(bound-1)) and the most significant bit
+	// of q+2^(bound-1) is set if and only if q is non-negative.
+	shifted := api.Add(q, pow2(bound-1))
+	lower, msb := bitslice.Partition(api, shifted, uint(bound-1), bitslice.WithNbDigits(bound))
+	api.AssertIsBoolean(msb)
+	// as |q*d| < 2^(bound+n-1) <= 2^(3n), there is no overflow in the
+	// native field.
+	api.AssertIsEqual(v, api.Add(api.Mul(q, d), r))
+
+	isExact := api.IsZero(r)
+	var inc frontend.Variable
+	switch fx.rounding {
+	case RoundDown:
+		return q
+	case RoundUp:
+		inc = api.Sub(1, isExact)
+	case RoundTowardZero:
+		// the floored quotient is negative if and only if v is negative.
+		inc = api.Mul(api.Sub(1, isExact), api.Sub(1, msb))
+	case RoundHalfUp:
+		inc = fx.cmp.IsLessEq(d, api.Mul(r, 2))
+	case RoundHalfEven:
+		isAbove := fx.cmp.IsLess(d, api.Mul(r, 2))
+		isTie := api.IsZero(api.Sub(d, api.Mul(r, 2)))
+		// q and q+2^(bound-1) have the same parity as bound >= 2.
+		isOdd, _ := bitslice.Partition(api, lower, 1, bitslice.WithNbDigits(bound-1))
+		inc = api.Add(isAbove, api.Mul(isTie, isOdd))
+	}
+	return api.Add(q, inc)
+}
@@ -0,0 +1,152 @@
+package fixedpoint
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+const (
+	nbBits     = 16
+	nbFracBits = 6
+)
+
+type fixedCircuit struct {
+	mode RoundingMode
+
+	A, B                          frontend.Variable
+	Sum, Diff, Prod, Quo, IntPart frontend.Variable
+}
+
+func (c *fixedCircuit) Define(api frontend.API) error {
+	fx, err := New(api, nbBits, nbFracBits, WithRoundingMode(c.mode))
+	if err != nil {
+		return err
+	}
+	fx.AssertIsInRange(c.A)
+	fx.AssertIsInRange(c.B)
+	api.AssertIsEqual(fx.Add(c.A, c.B), c.Sum)
+	api.AssertIsEqual(fx.Sub(c.A, c.B), c.Diff)
+	api.AssertIsEqual(fx.Mul(c.A, c.B), c.Prod)
+	api.AssertIsEqual(fx.Div(c.A, c.B), c.Quo)
+	api.AssertIsEqual(fx.ToInt(c.A), c.IntPart)
+	return nil
+}
+
+// round rounds x to an integer using mode.
+func round(x *big.Rat, mode RoundingMode) *big.Int {
+	floor := new(big.Int).Div(x.Num(), x.Denom())
+	frac := new(big.Rat).Sub(x, new(big.Rat).SetInt(floor))
+	if frac.Sign() == 0 {
+		return floor
+	}
+	up := new(big.Int).Add(floor, big.NewInt(1))
+	half := big.NewRat(1, 2)
+	switch mode {
+	case RoundDown:
+		return floor
+	case RoundUp:
+		return up
+	case RoundTowardZero:
+		if x.Sign() < 0 {
+			return up
+		}
+		return floor
+	case RoundHalfUp:
+		if frac.Cmp(half) >= 0 {
+			return up
+		}
+		return floor
+	case RoundHalfEven:
+		switch frac.Cmp(half) {
+		case 1:
+			return up
+		case 0:
+			if floor.Bit(0) == 1 {
+				return up
+			}
+		}
+		return floor
+	}
+	panic("unknown mode")
+}
+
+// wrap wraps x to a nbBits two's-complement integer.
+func wrap(x *big.Int) *big.Int {
+	mod := new(big.Int).Lsh(big.NewInt(1), nbBits)
+	half := new(big.Int).Lsh(big.NewInt(1), nbBits-1)
+	res := new(big.Int).Add(x, half)
+	res.Mod(res, mod)
+	res.Sub(res, half)
+	// the engine expects the values reduced modulo the field
+	return res.Mod(res, ecc.BN254.ScalarField())
+}
+
+func fixedAssignment(mode RoundingMode, a, b int64) *fixedCircuit {
+	scale := int64(1) << nbFracBits
+	ra, rb := big.NewRat(a, scale), big.NewRat(b, scale)
+	prod := new(big.Rat).Mul(ra, rb)
+	quo := new(big.Rat).Quo(ra, rb)
+	return &fixedCircuit{
+		mode:    mode,
+		A:       a,
+		B:       b,
+		Sum:     wrap(big.NewInt(a + b)),
+		Diff:    wrap(big.NewInt(a - b)),
+		Prod:    wrap(round(prod.Mul(prod, big.NewRat(scale, 1)), mode)),
+		Quo:     wrap(round(quo.Mul(quo, big.NewRat(scale, 1)), mode)),
+		IntPart: wrap(round(ra, mode)),
+	}
+}
+
+func TestFixedPoint(t *testing.T) {
+	assert := test.NewAssert(t)
+	const min, max = -(1 << (nbBits - 1)), 1<<(nbBits-1) - 1
+	pairs := [][2]int64{
+		{64, 64}, {-96, 64}, {96, 64}, {-160, 128}, {160, -128}, {1, 2}, {-1, 2},
+		{3, 2}, {-3, 2}, {min, -1}, {min, min}, {max, max}, {max, 1}, {5, -64},
+	}
+	rnd := rand.New(rand.NewSource(1)) //nolint:gosec // test
+	for i := 0; i < 10; i++ {
+		b := rnd.Int63n(1<<nbBits) + min
+		if b == 0 {
+			b = 1
+		}
+		pairs = append(pairs, [2]int64{rnd.Int63n(1<<nbBits) + min, b})
+	}
+	for mode := RoundDown; mode <= RoundHalfEven; mode++ {
+		for _, p := range pairs {
+			assert.NoError(test.IsSolved(&fixedCircuit{mode: mode}, fixedAssignment(mode, p[0], p[1]), ecc.BN254.ScalarField()), fmt.Sprint(mode, p))
+		}
+		// result rounded in the other direction
+		wrong := fixedAssignment(mode, 3, 96)
+		wrong.Prod = wrap(new(big.Int).Xor(wrong.Prod.(*big.Int), big.NewInt(1)))
+		assert.Error(test.IsSolved(&fixedCircuit{mode: mode}, wrong, ecc.BN254.ScalarField()), mode)
+	}
+	assert.CheckCircuit(&fixedCircuit{mode: RoundHalfEven}, test.WithValidAssignment(fixedAssignment(RoundHalfEven, -160, 128)), test.WithCurves(ecc.BN254))
+}
+
+type constantCircuit struct {
+	Expected frontend.Variable
+}
+
+func (c *constantCircuit) Define(api frontend.API) error {
+	fx, err := New(api, nbBits, nbFracBits)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(fx.Constant(-5, 128), c.Expected)
+	api.AssertIsEqual(fx.Constant(1, 3), 21)
+	return nil
+}
+
+func TestConstant(t *testing.T) {
+	assert := test.NewAssert(t)
+	// -5/128 = -2.5/64, ties to even
+	assert.NoError(test.IsSolved(&constantCircuit{}, &constantCircuit{Expected: -2}, ecc.BN254.ScalarField()))
+}
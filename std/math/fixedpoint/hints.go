@@ -0,0 +1,39 @@
+package fixedpoint
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/constraint/solver"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hint functions used in the package.
+func GetHints() []solver.Hint {
+	return []solver.Hint{
+		floorDivHint,
+	}
+}
+
+// floorDivHint computes the floored quotient and the non-negative remainder
+// of the signed dividend and the positive divisor.
+func floorDivHint(mod *big.Int, inputs, outputs []*big.Int) error {
+	if len(inputs) != 2 || len(outputs) != 2 {
+		return fmt.Errorf("expecting two inputs and outputs")
+	}
+	v := new(big.Int).Set(inputs[0])
+	if v.Cmp(new(big.Int).Rsh(mod, 1)) > 0 {
+		v.Sub(v, mod)
+	}
+	d := inputs[1]
+	if d.Sign() == 0 {
+		return fmt.Errorf("division by zero")
+	}
+	q, r := new(big.Int).DivMod(v, d, new(big.Int))
+	outputs[0].Mod(q, mod)
+	outputs[1].Set(r)
+	return nil
+}
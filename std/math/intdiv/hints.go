@@ -0,0 +1,39 @@
+package intdiv
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/constraint/solver"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hint functions used in the package.
+func GetHints() []solver.Hint {
+	return []solver.Hint{
+		divModHint,
+		bitLengthHint,
+	}
+}
+
+func divModHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	if len(inputs) != 2 || len(outputs) != 2 {
+		return fmt.Errorf("expecting two inputs and outputs")
+	}
+	if inputs[1].Sign() == 0 {
+		return fmt.Errorf("division by zero")
+	}
+	outputs[0].QuoRem(inputs[0], inputs[1], outputs[1])
+	return nil
+}
+
+func bitLengthHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	if len(inputs) != 1 || len(outputs) != 1 {
+		return fmt.Errorf("expecting one input and output")
+	}
+	outputs[0].SetUint64(uint64(inputs[0].BitLen()))
+	return nil
+}
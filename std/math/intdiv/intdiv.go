@@ -0,0 +1,173 @@
+// Package intdiv implements integer division and related operations on native
+// variables.
+//
+// The native [frontend.API.Div] method computes the field division, which for
+// integers a and b is a*b^-1 mod p and not the integer quotient. This package
+// computes the integer quotients and remainders, bit-lengths and logarithms
+// using hints and checks the results using range checks.
+//
+// The inputs are interpreted as non-negative integers less than 2^maxBits,
+// where maxBits is given as an argument. The bound must be such that the
+// products of such integers fit into the native field. If the inputs do not
+// fit into maxBits bits, then the circuit may not be satisfiable. The results
+// are always correct when the circuit is satisfied.
+package intdiv
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/internal/kvstore"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
+	"github.com/consensys/gnark/std/rangecheck"
+)
+
+// checkMaxBits panics if the products of maxBits-bit integers do not fit into
+// the native field.
+func checkMaxBits(api frontend.API, maxBits int) {
+	if maxBits <= 0 || 2*maxBits+1 >= api.Compiler().FieldBitLen() {
+		panic(fmt.Sprintf("invalid number of bits %d", maxBits))
+	}
+}
+
+// DivMod returns the quotient and remainder of the integer division of a by
+// b. The inputs must be less than 2^maxBits. The divisor b must be non-zero,
+// otherwise the circuit is not satisfiable. It panics if maxBits is not positive or too
+// large for the native field.
+func DivMod(api frontend.API, a, b frontend.Variable, maxBits int) (q, r frontend.Variable) {
+	checkMaxBits(api, maxBits)
+	res, err := api.Compiler().NewHint(divModHint, 2, a, b)
+	if err != nil {
+		panic(err)
+	}
+	q, r = res[0], res[1]
+	rchecker := rangecheck.New(api)
+	rchecker.Check(q, maxBits)
+	rchecker.Check(r, maxBits)
+	// r < b, which also ensures that b is non-zero and bounds b.
+	rchecker.Check(api.Sub(b, r, 1), maxBits)
+	// as q, b and r are less than 2^maxBits, there is no overflow.
+	api.AssertIsEqual(a, api.Add(api.Mul(q, b), r))
+	return q, r
+}
+
+// Div returns the quotient of the integer division of a by b. See [DivMod].
+func Div(api frontend.API, a, b frontend.Variable, maxBits int) frontend.Variable {
+	q, _ := DivMod(api, a, b, maxBits)
+	return q
+}
+
+// DivModConstant returns the quotient and remainder of the integer division of
+// a by the constant m. The input a must be less than 2^maxBits. It panics if
+// m is not positive or maxBits is not positive or too large for the native
+// field.
+func DivModConstant(api frontend.API, a frontend.Variable, m *big.Int, maxBits int) (q, r frontend.Variable) {
+	checkMaxBits(api, maxBits)
+	if m.Sign() <= 0 {
+		panic("modulus must be positive")
+	}
+	mc := new(big.Int).Set(m)
+	if ac, ok := api.Compiler().ConstantValue(a); ok {
+		if ac.BitLen() > maxBits {
+			panic("input larger than bound")
+		}
+		qc, rc := new(big.Int).QuoRem(ac, mc, new(big.Int))
+		return qc, rc
+	}
+	res, err := api.Compiler().NewHint(divModHint, 2, a, mc)
+	if err != nil {
+		panic(err)
+	}
+	q, r = res[0], res[1]
+	rchecker := rangecheck.New(api)
+	// r < m, so r and m-1-r are less than 2^len(m).
+	mBits := mc.BitLen()
+	rchecker.Check(r, mBits)
+	rchecker.Check(api.Sub(mc, r, 1), mBits)
+	// the quotient is less than 2^maxBits/m.
+	qBits := maxBits - mBits + 1
+	if qBits < 1 {
+		qBits = 1
+	}
+	rchecker.Check(q, qBits)
+	api.AssertIsEqual(a, api.Add(api.Mul(q, mc), r))
+	return q, r
+}
+
+// Mod returns a modulo the constant m. See [DivModConstant].
+func Mod(api frontend.API, a frontend.Variable, m *big.Int, maxBits int) frontend.Variable {
+	_, r := DivModConstant(api, a, m, maxBits)
+	return r
+}
+
+// BitLength returns the number of bits required to represent a, i.e. the
+// smallest l such that a < 2^l. The bit-length of zero is zero. The input must
+// be less than 2^maxBits, otherwise the circuit is not satisfiable. It panics
+// if maxBits is not positive or too large for the native field.
+func BitLength(api frontend.API, a frontend.Variable, maxBits int) frontend.Variable {
+	l, _, _ := bitLength(api, a, maxBits)
+	return l
+}
+
+// Log2 returns the floor of the binary logarithm of a. The input must be
+// non-zero and less than 2^maxBits, otherwise the circuit is not satisfiable.
+// It panics if maxBits is not positive or too large for the native field.
+func Log2(api frontend.API, a frontend.Variable, maxBits int) frontend.Variable {
+	api.AssertIsDifferent(a, 0)
+	l, _, _ := bitLength(api, a, maxBits)
+	return api.Sub(l, 1)
+}
+
+// IsPowerOfTwo returns 1 if a is a power of two and 0 otherwise. The input
+// must be less than 2^maxBits, otherwise the circuit is not satisfiable. It
+// panics if maxBits is not positive or too large for the native field.
+func IsPowerOfTwo(api frontend.API, a frontend.Variable, maxBits int) frontend.Variable {
+	_, lower, _ := bitLength(api, a, maxBits)
+	// a is a power of two if and only if it is non-zero and equal to the
+	// largest power of two not exceeding it. For zero the lower bound is zero.
+	isNonZero := api.Sub(1, api.IsZero(a))
+	return api.Mul(isNonZero, api.IsZero(api.Sub(a, lower)))
+}
+
+// bitLength returns the bit-length l of a and the bounds 2^(l-1) <= a < 2^l.
+// For l = 0 the lower bound is 0.
+func bitLength(api frontend.API, a frontend.Variable, maxBits int) (l, lower, upper frontend.Variable) {
+	checkMaxBits(api, maxBits)
+	res, err := api.Compiler().NewHint(bitLengthHint, 1, a)
+	if err != nil {
+		panic(err)
+	}
+	l = res[0]
+	// the lookup bounds l to [0, maxBits].
+	bounds := powersTable(api, maxBits).Lookup(l, api.Add(l, 1))
+	lower, upper = bounds[0], bounds[1]
+	rchecker := rangecheck.New(api)
+	rchecker.Check(api.Sub(a, lower), maxBits)
+	rchecker.Check(api.Sub(upper, a, 1), maxBits)
+	return l, lower, upper
+}
+
+type ctxPowersTableKey struct {
+	maxBits int
+}
+
+// powersTable returns the table [0, 1, 2, 4, ..., 2^maxBits], so that 2^(l-1)
+// is at index l and 2^l at index l+1. The tables are shared between the calls.
+func powersTable(api frontend.API, maxBits int) *logderivlookup.Table {
+	kv, ok := api.Compiler().(kvstore.Store)
+	if !ok {
+		panic("builder should implement key-value store")
+	}
+	key := ctxPowersTableKey{maxBits: maxBits}
+	if tbl, ok := kv.GetKeyValue(key).(*logderivlookup.Table); ok {
+		return tbl
+	}
+	tbl := logderivlookup.New(api)
+	tbl.Insert(0)
+	for i := 0; i <= maxBits; i++ {
+		tbl.Insert(new(big.Int).Lsh(big.NewInt(1), uint(i)))
+	}
+	kv.SetKeyValue(key, tbl)
+	return tbl
+}
@@ -0,0 +1,106 @@
+package intdiv
+
+import (
+	"math/big"
+	"math/bits"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+const maxBits = 32
+
+type divModCircuit struct {
+	A, B, Q, R frontend.Variable
+}
+
+func (c *divModCircuit) Define(api frontend.API) error {
+	q, r := DivMod(api, c.A, c.B, maxBits)
+	api.AssertIsEqual(q, c.Q)
+	api.AssertIsEqual(r, c.R)
+	api.AssertIsEqual(Div(api, c.A, c.B, maxBits), c.Q)
+	return nil
+}
+
+func TestDivMod(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.CheckCircuit(&divModCircuit{},
+		test.WithValidAssignment(&divModCircuit{A: 100, B: 7, Q: 14, R: 2}),
+		test.WithValidAssignment(&divModCircuit{A: 6, B: 7, Q: 0, R: 6}),
+		test.WithValidAssignment(&divModCircuit{A: 1<<32 - 1, B: 1<<32 - 1, Q: 1, R: 0}),
+		test.WithValidAssignment(&divModCircuit{A: 0, B: 1, Q: 0, R: 0}),
+		// not reduced remainder
+		test.WithInvalidAssignment(&divModCircuit{A: 100, B: 7, Q: 13, R: 9}),
+		// division by zero
+		test.WithInvalidAssignment(&divModCircuit{A: 100, B: 0, Q: 0, R: 100}),
+		test.WithCurves(ecc.BN254))
+}
+
+type modCircuit struct {
+	A, Q, R, R8 frontend.Variable
+}
+
+func (c *modCircuit) Define(api frontend.API) error {
+	q, r := DivModConstant(api, c.A, big.NewInt(1000), maxBits)
+	api.AssertIsEqual(q, c.Q)
+	api.AssertIsEqual(r, c.R)
+	api.AssertIsEqual(Mod(api, c.A, big.NewInt(8), maxBits), c.R8)
+	// constant input
+	api.AssertIsEqual(Mod(api, 12345, big.NewInt(1000), maxBits), 345)
+	return nil
+}
+
+func TestModConstant(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.CheckCircuit(&modCircuit{},
+		test.WithValidAssignment(&modCircuit{A: 123456, Q: 123, R: 456, R8: 0}),
+		test.WithValidAssignment(&modCircuit{A: 1<<32 - 1, Q: (1<<32 - 1) / 1000, R: (1<<32 - 1) % 1000, R8: 7}),
+		test.WithInvalidAssignment(&modCircuit{A: 123456, Q: 122, R: 1456, R8: 0}),
+		test.WithCurves(ecc.BN254))
+}
+
+type bitLengthCircuit struct {
+	A, BitLen, IsPow frontend.Variable
+}
+
+func (c *bitLengthCircuit) Define(api frontend.API) error {
+	api.AssertIsEqual(BitLength(api, c.A, maxBits), c.BitLen)
+	api.AssertIsEqual(IsPowerOfTwo(api, c.A, maxBits), c.IsPow)
+	return nil
+}
+
+type log2Circuit struct {
+	A, Log frontend.Variable
+}
+
+func (c *log2Circuit) Define(api frontend.API) error {
+	api.AssertIsEqual(Log2(api, c.A, maxBits), c.Log)
+	return nil
+}
+
+func TestBitLength(t *testing.T) {
+	assert := test.NewAssert(t)
+	var valid []test.TestingOption
+	for _, v := range []uint32{0, 1, 2, 3, 4, 5, 255, 256, 1 << 31, 1<<32 - 1} {
+		isPow := 0
+		if v != 0 && v&(v-1) == 0 {
+			isPow = 1
+		}
+		valid = append(valid, test.WithValidAssignment(&bitLengthCircuit{A: v, BitLen: bits.Len32(v), IsPow: isPow}))
+	}
+	opts := append(valid,
+		test.WithInvalidAssignment(&bitLengthCircuit{A: 5, BitLen: 4, IsPow: 0}),
+		test.WithInvalidAssignment(&bitLengthCircuit{A: 5, BitLen: 3, IsPow: 1}),
+		// out of range
+		test.WithInvalidAssignment(&bitLengthCircuit{A: 1 << 32, BitLen: 33, IsPow: 1}),
+		test.WithCurves(ecc.BN254))
+	assert.CheckCircuit(&bitLengthCircuit{}, opts...)
+
+	assert.CheckCircuit(&log2Circuit{},
+		test.WithValidAssignment(&log2Circuit{A: 1, Log: 0}),
+		test.WithValidAssignment(&log2Circuit{A: 1000, Log: 9}),
+		test.WithInvalidAssignment(&log2Circuit{A: 0, Log: 0}),
+		test.WithCurves(ecc.BN254))
+}
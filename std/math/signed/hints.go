@@ -0,0 +1,44 @@
+package signed
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/constraint/solver"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hint functions used in the package.
+func GetHints() []solver.Hint {
+	return []solver.Hint{
+		divRemHint,
+	}
+}
+
+// toSigned returns the signed integer represented by the field element v.
+func toSigned(mod, v *big.Int) *big.Int {
+	res := new(big.Int).Set(v)
+	if res.Cmp(new(big.Int).Rsh(mod, 1)) > 0 {
+		res.Sub(res, mod)
+	}
+	return res
+}
+
+// divRemHint computes the quotient and remainder of the division truncated
+// towards zero.
+func divRemHint(mod *big.Int, inputs, outputs []*big.Int) error {
+	if len(inputs) != 2 || len(outputs) != 2 {
+		return fmt.Errorf("expecting two inputs and outputs")
+	}
+	a, b := toSigned(mod, inputs[0]), toSigned(mod, inputs[1])
+	if b.Sign() == 0 {
+		return fmt.Errorf("division by zero")
+	}
+	q, r := new(big.Int).QuoRem(a, b, new(big.Int))
+	outputs[0].Mod(q, mod)
+	outputs[1].Mod(r, mod)
+	return nil
+}
@@ -0,0 +1,220 @@
+// Package signed implements arithmetic on two's-complement signed integers.
+//
+// The n-bit signed integers are stored in the native field as their values,
+// i.e. the negative integer -x is stored as p-x, where p is the modulus of the
+// native field. This allows performing additions and multiplications using
+// native operations. The results are wrapped around to n bits as in
+// two's-complement arithmetic, which corresponds to the behaviour of fixed-size
+// integer types in Go.
+//
+// The inputs to the methods must be in range [-2^(n-1), 2^(n-1)), otherwise
+// the results are undefined. Use [Int.AssertIsInRange] for checking the
+// witness values or [Int.FromTwosComplement] for converting n-bit unsigned
+// values.
+//
+// The number of bits n must be such that the products of two integers fit into
+// the native field without overflow.
+package signed
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/bitslice"
+	"github.com/consensys/gnark/std/math/cmp"
+	"github.com/consensys/gnark/std/rangecheck"
+)
+
+// Int implements arithmetic on n-bit signed integers.
+type Int struct {
+	api      frontend.API
+	nbBits   int
+	rchecker frontend.Rangechecker
+	cmp      *cmp.BoundedComparator
+}
+
+// New returns a new [*Int] for nbBits-bit signed integers. It returns an error
+// if nbBits is less than 2 or the products of the integers do not fit into the
+// native field.
+func New(api frontend.API, nbBits int) (*Int, error) {
+	if nbBits < 2 {
+		return nil, fmt.Errorf("number of bits must be at least 2")
+	}
+	if 2*nbBits+2 >= api.Compiler().FieldBitLen() {
+		return nil, fmt.Errorf("number of bits %d too large for the native field", nbBits)
+	}
+	return &Int{
+		api:      api,
+		nbBits:   nbBits,
+		rchecker: rangecheck.New(api),
+		// the difference of two n-bit integers is less than 2^n in absolute
+		// value.
+		cmp: cmp.NewBoundedComparator(api, new(big.Int).Lsh(big.NewInt(1), uint(nbBits)), false),
+	}, nil
+}
+
+// NbBits returns the bit-length of the integers.
+func (in *Int) NbBits() int {
+	return in.nbBits
+}
+
+// pow2 returns 2^k as a constant.
+func pow2(k int) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(k))
+}
+
+// AssertIsInRange asserts that a is in range [-2^(n-1), 2^(n-1)).
+func (in *Int) AssertIsInRange(a frontend.Variable) {
+	in.rchecker.Check(in.api.Add(a, pow2(in.nbBits-1)), in.nbBits)
+}
+
+// FromTwosComplement returns the signed integer represented by the n-bit
+// two's-complement value a. The value a is range checked to be n bits.
+func (in *Int) FromTwosComplement(a frontend.Variable) frontend.Variable {
+	_, msb := in.partitionBit(a, in.nbBits-1)
+	return in.api.Sub(a, in.api.Mul(msb, pow2(in.nbBits)))
+}
+
+// ToTwosComplement returns the n-bit two's-complement representation of a.
+func (in *Int) ToTwosComplement(a frontend.Variable) frontend.Variable {
+	return in.api.Add(a, in.api.Mul(in.IsNegative(a), pow2(in.nbBits)))
+}
+
+// IsNegative returns 1 if a < 0 and 0 otherwise. It also asserts that a is in
+// range.
+func (in *Int) IsNegative(a frontend.Variable) frontend.Variable {
+	// a+2^(n-1) is in range [0, 2^n) and the most significant bit is set if
+	// and only if a is non-negative.
+	_, msb := in.partitionBit(in.api.Add(a, pow2(in.nbBits-1)), in.nbBits-1)
+	return in.api.Sub(1, msb)
+}
+
+// Sign returns -1 if a < 0, 0 if a == 0 and 1 if a > 0.
+func (in *Int) Sign(a frontend.Variable) frontend.Variable {
+	api := in.api
+	isNonZero := api.Sub(1, api.IsZero(a))
+	return api.Sub(isNonZero, api.Mul(2, in.IsNegative(a)))
+}
+
+// Abs returns the absolute value of a. The result for -2^(n-1) is 2^(n-1),
+// which is not an n-bit signed integer.
+func (in *Int) Abs(a frontend.Variable) frontend.Variable {
+	api := in.api
+	return api.Sub(a, api.Mul(2, in.IsNegative(a), a))
+}
+
+// Neg returns -a. The result is wrapped to n bits.
+func (in *Int) Neg(a frontend.Variable) frontend.Variable {
+	return in.Wrap(in.api.Neg(a), in.nbBits+1)
+}
+
+// Add returns a+b. The result is wrapped to n bits.
+func (in *Int) Add(a, b frontend.Variable) frontend.Variable {
+	return in.Wrap(in.api.Add(a, b), in.nbBits+1)
+}
+
+// Sub returns a-b. The result is wrapped to n bits.
+func (in *Int) Sub(a, b frontend.Variable) frontend.Variable {
+	return in.Wrap(in.api.Sub(a, b), in.nbBits+1)
+}
+
+// Mul returns a*b. The result is wrapped to n bits.
+func (in *Int) Mul(a, b frontend.Variable) frontend.Variable {
+	return in.Wrap(in.api.Mul(a, b), 2*in.nbBits)
+}
+
+// Div returns a/b truncated towards zero. The result is wrapped to n bits,
+// i.e. -2^(n-1)/-1 = -2^(n-1). The divisor must be non-zero, otherwise the
+// circuit is not satisfiable.
+func (in *Int) Div(a, b frontend.Variable) frontend.Variable {
+	q, _ := in.divRem(a, b)
+	return in.Wrap(q, in.nbBits+1)
+}
+
+// Rem returns the remainder of a/b truncated towards zero. The sign of the
+// remainder is the sign of a. The divisor must be non-zero, otherwise the
+// circuit is not satisfiable.
+func (in *Int) Rem(a, b frontend.Variable) frontend.Variable {
+	_, r := in.divRem(a, b)
+	return r
+}
+
+// divRem returns the quotient and remainder of the truncated division. The
+// quotient is in range [-2^(n-1), 2^(n-1)].
+func (in *Int) divRem(a, b frontend.Variable) (q, r frontend.Variable) {
+	api := in.api
+	res, err := api.Compiler().NewHint(divRemHint, 2, a, b)
+	if err != nil {
+		panic(err)
+	}
+	q, r = res[0], res[1]
+	// the quotient is in range [-2^(n-1), 2^(n-1)], so there is no overflow
+	// in the native field.
+	in.rchecker.Check(api.Add(q, pow2(in.nbBits-1)), in.nbBits+1)
+	api.AssertIsEqual(a, api.Add(api.Mul(q, b), r))
+	// |r| < |b|. This also ensures that b is non-zero.
+	in.rchecker.Check(api.Sub(in.Abs(b), in.Abs(r), 1), in.nbBits)
+	// the remainder is zero or has the sign of a.
+	api.AssertIsEqual(api.Mul(r, api.Sub(in.IsNegative(r), in.IsNegative(a))), 0)
+	return q, r
+}
+
+// IsLess returns 1 if a < b and 0 otherwise.
+func (in *Int) IsLess(a, b frontend.Variable) frontend.Variable {
+	return in.cmp.IsLess(a, b)
+}
+
+// IsLessOrEqual returns 1 if a <= b and 0 otherwise.
+func (in *Int) IsLessOrEqual(a, b frontend.Variable) frontend.Variable {
+	return in.cmp.IsLessEq(a, b)
+}
+
+// AssertIsLess asserts that a < b.
+func (in *Int) AssertIsLess(a, b frontend.Variable) {
+	in.cmp.AssertIsLess(a, b)
+}
+
+// AssertIsLessOrEqual asserts that a <= b.
+func (in *Int) AssertIsLessOrEqual(a, b frontend.Variable) {
+	in.cmp.AssertIsLessEq(a, b)
+}
+
+// Min returns the minimum of a and b.
+func (in *Int) Min(a, b frontend.Variable) frontend.Variable {
+	return in.cmp.Min(a, b)
+}
+
+// Max returns the maximum of a and b.
+func (in *Int) Max(a, b frontend.Variable) frontend.Variable {
+	// a + b = min(a, b) + max(a, b)
+	return in.api.Sub(in.api.Add(a, b), in.cmp.Min(a, b))
+}
+
+// Wrap returns v wrapped to n bits as in two's-complement arithmetic. The
+// value v must be in range [-2^(bound-1), 2^(bound-1)), otherwise the result
+// is not defined.
+func (in *Int) Wrap(v frontend.Variable, bound int) frontend.Variable {
+	// the offset 2^(bound-1) must be a multiple of 2^n. Values in range for a
+	// smaller bound are also in range for bound n+1.
+	if bound <= in.nbBits {
+		bound = in.nbBits + 1
+	}
+	// shift v to be non-negative by a multiple of 2^n. The lower n bits of
+	// v+2^(n-1) are then the shifted result.
+	offset := new(big.Int).Add(pow2(bound-1), pow2(in.nbBits-1))
+	lower, upper := bitslice.Partition(in.api, in.api.Add(v, offset), uint(in.nbBits), bitslice.WithNbDigits(bound+1))
+	// the partitioning bounds the upper part only by the number of digits. We
+	// bound it tighter to ensure that the decomposition does not overflow.
+	in.rchecker.Check(upper, bound+1-in.nbBits)
+	return in.api.Sub(lower, pow2(in.nbBits-1))
+}
+
+// partitionBit returns the lower bits of v and the bit at position pos. The
+// value v must be less than 2^(pos+1), otherwise the circuit is not
+// satisfiable.
+func (in *Int) partitionBit(v frontend.Variable, pos int) (lower, bit frontend.Variable) {
+	lower, bit = bitslice.Partition(in.api, v, uint(pos), bitslice.WithNbDigits(pos+1))
+	in.api.AssertIsBoolean(bit)
+	return lower, bit
+}
@@ -0,0 +1,162 @@
+package signed
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+type int8Circuit struct {
+	A, B, TwosA                frontend.Variable
+	Sum, Diff, Prod, Quo, Rem  frontend.Variable
+	IsNeg, Sign, Abs, Neg      frontend.Variable
+	IsLess, IsLessEq, Min, Max frontend.Variable
+}
+
+func (c *int8Circuit) Define(api frontend.API) error {
+	in, err := New(api, 8)
+	if err != nil {
+		return err
+	}
+	in.AssertIsInRange(c.A)
+	in.AssertIsInRange(c.B)
+	api.AssertIsEqual(in.FromTwosComplement(c.TwosA), c.A)
+	api.AssertIsEqual(in.ToTwosComplement(c.A), c.TwosA)
+	api.AssertIsEqual(in.Add(c.A, c.B), c.Sum)
+	api.AssertIsEqual(in.Sub(c.A, c.B), c.Diff)
+	api.AssertIsEqual(in.Mul(c.A, c.B), c.Prod)
+	api.AssertIsEqual(in.Div(c.A, c.B), c.Quo)
+	api.AssertIsEqual(in.Rem(c.A, c.B), c.Rem)
+	api.AssertIsEqual(in.IsNegative(c.A), c.IsNeg)
+	api.AssertIsEqual(in.Sign(c.A), c.Sign)
+	api.AssertIsEqual(in.Abs(c.A), c.Abs)
+	api.AssertIsEqual(in.Neg(c.A), c.Neg)
+	api.AssertIsEqual(in.IsLess(c.A, c.B), c.IsLess)
+	api.AssertIsEqual(in.IsLessOrEqual(c.A, c.B), c.IsLessEq)
+	api.AssertIsEqual(in.Min(c.A, c.B), c.Min)
+	api.AssertIsEqual(in.Max(c.A, c.B), c.Max)
+	return nil
+}
+
+func int8Assignment(a, b int8) *int8Circuit {
+	boolToInt := func(v bool) int {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	sign, abs := 0, int(a)
+	switch {
+	case a < 0:
+		sign, abs = -1, -int(a)
+	case a > 0:
+		sign = 1
+	}
+	min, max := a, b
+	if b < a {
+		min, max = b, a
+	}
+	return &int8Circuit{
+		A:        a,
+		B:        b,
+		TwosA:    uint8(a),
+		Sum:      a + b,
+		Diff:     a - b,
+		Prod:     a * b,
+		Quo:      a / b,
+		Rem:      a % b,
+		IsNeg:    boolToInt(a < 0),
+		Sign:     sign,
+		Abs:      abs,
+		Neg:      -a,
+		IsLess:   boolToInt(a < b),
+		IsLessEq: boolToInt(a <= b),
+		Min:      min,
+		Max:      max,
+	}
+}
+
+func TestInt8(t *testing.T) {
+	assert := test.NewAssert(t)
+	pairs := [][2]int8{
+		{math.MinInt8, -1}, {math.MinInt8, 1}, {math.MaxInt8, math.MaxInt8},
+		{math.MinInt8, math.MinInt8}, {0, -5}, {-7, 2}, {7, -2}, {-7, -2}, {100, 3},
+	}
+	rnd := rand.New(rand.NewSource(1)) //nolint:gosec // test
+	for i := 0; i < 20; i++ {
+		b := int8(rnd.Intn(256) - 128)
+		if b == 0 {
+			b = 1
+		}
+		pairs = append(pairs, [2]int8{int8(rnd.Intn(256) - 128), b})
+	}
+	for _, p := range pairs {
+		assert.NoError(test.IsSolved(&int8Circuit{}, int8Assignment(p[0], p[1]), ecc.BN254.ScalarField()), p)
+	}
+	assert.CheckCircuit(&int8Circuit{},
+		test.WithValidAssignment(int8Assignment(-7, 2)),
+		test.WithInvalidAssignment(func() *int8Circuit {
+			w := int8Assignment(-7, 2)
+			// floor division instead of truncation
+			w.Quo, w.Rem = -4, 1
+			return w
+		}()),
+		test.WithInvalidAssignment(func() *int8Circuit {
+			w := int8Assignment(-7, 2)
+			// A out of range
+			w.A = 128
+			return w
+		}()),
+		test.WithCurves(ecc.BN254))
+}
+
+type divCircuit struct {
+	A, B frontend.Variable
+}
+
+func (c *divCircuit) Define(api frontend.API) error {
+	in, err := New(api, 16)
+	if err != nil {
+		return err
+	}
+	in.Div(c.A, c.B)
+	return nil
+}
+
+func TestDivisionByZero(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.NoError(test.IsSolved(&divCircuit{}, &divCircuit{A: 10, B: -3}, ecc.BN254.ScalarField()))
+	assert.Error(test.IsSolved(&divCircuit{}, &divCircuit{A: 10, B: 0}, ecc.BN254.ScalarField()))
+}
+
+type wrapCircuit struct {
+	V, Expected frontend.Variable
+	bound       int
+}
+
+func (c *wrapCircuit) Define(api frontend.API) error {
+	in, err := New(api, 8)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(in.Wrap(c.V, c.bound), c.Expected)
+	return nil
+}
+
+func TestWrap(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, bound := range []int{1, 4, 7, 8, 9, 16} {
+		for _, v := range []int{0, 5, -1, -5, 100, math.MinInt8, math.MaxInt8, 200, -200, 1000, -1000} {
+			if lim := 1 << (bound - 1); v < -lim || v >= lim {
+				continue
+			}
+			err := test.IsSolved(&wrapCircuit{bound: bound}, &wrapCircuit{V: v, Expected: int8(v)}, ecc.BN254.ScalarField())
+			assert.NoError(err, "bound", bound, "value", v)
+		}
+	}
+	assert.Error(test.IsSolved(&wrapCircuit{bound: 8}, &wrapCircuit{V: 0, Expected: math.MinInt8}, ecc.BN254.ScalarField()))
+}
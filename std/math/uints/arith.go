@@ -0,0 +1,289 @@
+package uints
+
+import (
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/bitslice"
+)
+
+// The arithmetic operations are performed on limbs of several bytes. For every
+// limb we compute the sum of the input limbs (or their products) and the carry
+// from the previous limb. We then decompose the result into bytes and the carry
+// to the next limb using a hint. The bytes are range checked to be 8 bits and
+// the carry to fit the bound of the result. The size of the limbs is chosen
+// so that the limb products fit into the native field.
+
+// limbSize returns the number of bytes in a limb for a type with n bytes.
+func (bf *BinaryField[T]) limbSize(n int) int {
+	for _, w := range []int{8, 4, 2, 1} {
+		if n%w != 0 {
+			continue
+		}
+		if 16*w+bits.Len(uint(n/w))+2 < bf.api.Compiler().FieldBitLen() {
+			return w
+		}
+	}
+	panic("field too small")
+}
+
+// toLimbs returns the limbs of a, where every limb is w bytes.
+func (bf *BinaryField[T]) toLimbs(a []U8, w int) []frontend.Variable {
+	ret := make([]frontend.Variable, len(a)/w)
+	for i := range ret {
+		var limb frontend.Variable = 0
+		for j := 0; j < w; j++ {
+			limb = bf.api.Add(limb, bf.api.Mul(a[i*w+j].Val, 1<<(8*j)))
+		}
+		ret[i] = limb
+	}
+	return ret
+}
+
+// fromColumns carries the column values cols of w bytes to bytes. The values
+// of the columns must be less than 2^colBits. It returns the bytes and the
+// carry from the last column, which is less than 2^(colBits-8w+1).
+func (bf *BinaryField[T]) fromColumns(cols []frontend.Variable, w, colBits int) ([]U8, frontend.Variable) {
+	api := bf.api
+	ret := make([]U8, 0, len(cols)*w)
+	// the carry is less than 2^(colBits-8w+1) as both the column value and the
+	// incoming carry are less than 2^colBits.
+	carryBits := colBits - 8*w + 1
+	var carry frontend.Variable = 0
+	for i := range cols {
+		v := api.Add(cols[i], carry)
+		res, err := api.Compiler().NewHint(toBytesCarry, w+1, w, v)
+		if err != nil {
+			panic(err)
+		}
+		var recomposed frontend.Variable = 0
+		for j := 0; j < w; j++ {
+			ret = append(ret, bf.ByteValueOf(res[j]))
+			recomposed = api.Add(recomposed, api.Mul(res[j], 1<<(8*j)))
+		}
+		carry = res[w]
+		bf.rchecker.Check(carry, carryBits)
+		api.AssertIsEqual(v, api.Add(recomposed, api.Mul(carry, limbBase(w))))
+	}
+	return ret, carry
+}
+
+// limbBase returns 2^(8w) as a variable.
+func limbBase(w int) frontend.Variable {
+	if w == 8 {
+		// does not fit into uint64
+		return new(big.Int).Lsh(big.NewInt(1), 64)
+	}
+	return 1 << (8 * w)
+}
+
+// limbBaseMinusOne returns 2^(8w)-1.
+func limbBaseMinusOne(w int) frontend.Variable {
+	if w == 8 {
+		return uint64(0xffffffffffffffff)
+	}
+	return 1<<(8*w) - 1
+}
+
+// Add returns the sum of a modulo 2^n, where n is the bit-length of T.
+func (bf *BinaryField[T]) Add(a ...T) T {
+	res, _ := bf.addWithCarry(a...)
+	return res
+}
+
+// AddWithCarry returns a+b modulo 2^n and the carry (0 or 1), where n is the
+// bit-length of T.
+func (bf *BinaryField[T]) AddWithCarry(a, b T) (T, frontend.Variable) {
+	return bf.addWithCarry(a, b)
+}
+
+func (bf *BinaryField[T]) addWithCarry(a ...T) (T, frontend.Variable) {
+	var r T
+	if len(a) == 0 {
+		return r, 0
+	}
+	w := bf.limbSize(len(r))
+	cols := make([]frontend.Variable, len(r)/w)
+	for i := range cols {
+		cols[i] = 0
+	}
+	for i := range a {
+		limbs := bf.toLimbs(toSlice(a[i]), w)
+		for j := range limbs {
+			cols[j] = bf.api.Add(cols[j], limbs[j])
+		}
+	}
+	// the carry is at most len(a)-1, so the columns are less than
+	// len(a)*2^(8w).
+	bts, carry := bf.fromColumns(cols, w, 8*w+bits.Len(uint(len(a)-1)))
+	r = fromSlice[T](bts)
+	return r, carry
+}
+
+// Sub returns a-b modulo 2^n, where n is the bit-length of T.
+func (bf *BinaryField[T]) Sub(a, b T) T {
+	res, _ := bf.SubWithBorrow(a, b)
+	return res
+}
+
+// SubWithBorrow returns a-b modulo 2^n and the borrow, where n is the
+// bit-length of T. The borrow is 1 if a < b and 0 otherwise.
+func (bf *BinaryField[T]) SubWithBorrow(a, b T) (T, frontend.Variable) {
+	api := bf.api
+	var r T
+	w := bf.limbSize(len(r))
+	// we compute a + (2^n - 1 - b) + 1, which overflows if and only if
+	// a >= b.
+	la, lb := bf.toLimbs(toSlice(a), w), bf.toLimbs(toSlice(b), w)
+	cols := make([]frontend.Variable, len(la))
+	for i := range cols {
+		cols[i] = api.Add(la[i], api.Sub(limbBaseMinusOne(w), lb[i]))
+	}
+	cols[0] = api.Add(cols[0], 1)
+	bts, carry := bf.fromColumns(cols, w, 8*w+1)
+	r = fromSlice[T](bts)
+	return r, api.Sub(1, carry)
+}
+
+// IsLess returns 1 if a < b and 0 otherwise.
+func (bf *BinaryField[T]) IsLess(a, b T) frontend.Variable {
+	_, borrow := bf.SubWithBorrow(a, b)
+	return borrow
+}
+
+// Mul returns a*b modulo 2^n, where n is the bit-length of T.
+func (bf *BinaryField[T]) Mul(a, b T) T {
+	var r T
+	bts, _ := bf.mul(a, b, len(r))
+	r = fromSlice[T](bts)
+	return r
+}
+
+// MulWithOverflow returns a*b modulo 2^n and the overflow flag, where n is the
+// bit-length of T. The overflow flag is 1 if a*b >= 2^n and 0 otherwise.
+func (bf *BinaryField[T]) MulWithOverflow(a, b T) (T, frontend.Variable) {
+	lo, hi := bf.MulFull(a, b)
+	// the bytes are small, so the sum is zero if and only if all bytes are
+	// zero.
+	var sum frontend.Variable = 0
+	for i := 0; i < len(hi); i++ {
+		sum = bf.api.Add(sum, hi[i].Val)
+	}
+	return lo, bf.api.Sub(1, bf.api.IsZero(sum))
+}
+
+// MulFull returns the full 2n-bit product of a and b as lower and upper
+// halves, where n is the bit-length of T.
+func (bf *BinaryField[T]) MulFull(a, b T) (lo, hi T) {
+	bts, carry := bf.mul(a, b, 2*len(lo))
+	// the product fits into 2n bits.
+	bf.api.AssertIsEqual(carry, 0)
+	lo, hi = fromSlice[T](bts[:len(lo)]), fromSlice[T](bts[len(lo):])
+	return lo, hi
+}
+
+// mul returns the first nbBytes bytes of a*b and the carry from the last
+// limb.
+func (bf *BinaryField[T]) mul(a, b T, nbBytes int) ([]U8, frontend.Variable) {
+	api := bf.api
+	w := bf.limbSize(len(a))
+	la, lb := bf.toLimbs(toSlice(a), w), bf.toLimbs(toSlice(b), w)
+	cols := make([]frontend.Variable, nbBytes/w)
+	for i := range cols {
+		cols[i] = 0
+	}
+	for i := range la {
+		for j := range lb {
+			if i+j < len(cols) {
+				cols[i+j] = api.Add(cols[i+j], api.Mul(la[i], lb[j]))
+			}
+		}
+	}
+	// every column is a sum of at most len(la) products of two limbs.
+	return bf.fromColumns(cols, w, 16*w+bits.Len(uint(len(la))))
+}
+
+// DivMod returns the quotient and remainder of a divided by b. The divisor b
+// must be non-zero, otherwise the circuit is not satisfiable.
+func (bf *BinaryField[T]) DivMod(a, b T) (q, r T) {
+	api := bf.api
+	inputs := make([]frontend.Variable, 0, 2*len(a)+1)
+	inputs = append(inputs, len(a))
+	for i := 0; i < len(a); i++ {
+		inputs = append(inputs, a[i].Val)
+	}
+	for i := 0; i < len(b); i++ {
+		inputs = append(inputs, b[i].Val)
+	}
+	res, err := api.Compiler().NewHint(divModHint, 2*len(a), inputs...)
+	if err != nil {
+		panic(err)
+	}
+	for i := 0; i < len(q); i++ {
+		q[i] = bf.ByteValueOf(res[i])
+		r[i] = bf.ByteValueOf(res[len(q)+i])
+	}
+	// a = q*b + r without overflow and r < b
+	lo, hi := bf.MulFull(q, b)
+	for i := 0; i < len(hi); i++ {
+		api.AssertIsEqual(hi[i].Val, 0)
+	}
+	sum, carry := bf.AddWithCarry(lo, r)
+	api.AssertIsEqual(carry, 0)
+	bf.AssertEq(sum, a)
+	api.AssertIsEqual(bf.IsLess(r, b), 1)
+	return q, r
+}
+
+// Lshift returns a shifted left by c bits. The bits shifted out are dropped.
+func (bf *BinaryField[T]) Lshift(a T, c int) T {
+	shiftBl := c / 8
+	shiftBt := c % 8
+	var ret T
+	for i := 0; i < shiftBl && i < len(ret); i++ {
+		ret[i] = NewU8(0)
+	}
+	if shiftBl >= len(a) {
+		return ret
+	}
+	if shiftBt == 0 {
+		for i := 0; i < len(a)-shiftBl; i++ {
+			ret[i+shiftBl] = a[i]
+		}
+		return ret
+	}
+	partitioned := make([][2]frontend.Variable, len(a)-shiftBl)
+	for i := range partitioned {
+		lower, upper := bitslice.Partition(bf.api, a[i].Val, uint(8-shiftBt), bitslice.WithNbDigits(8))
+		partitioned[i] = [2]frontend.Variable{lower, upper}
+	}
+	ret[shiftBl].Val = bf.api.Mul(partitioned[0][0], 1<<shiftBt)
+	for i := 1; i < len(partitioned); i++ {
+		ret[i+shiftBl].Val = bf.api.Add(bf.api.Mul(partitioned[i][0], 1<<shiftBt), partitioned[i-1][1])
+	}
+	return ret
+}
+
+// Rrot returns a rotated right by c bits.
+func (bf *BinaryField[T]) Rrot(a T, c int) T {
+	return bf.Lrot(a, -c)
+}
+
+// toSlice returns the bytes of a as a slice.
+func toSlice[T Long](a T) []U8 {
+	ret := make([]U8, len(a))
+	for i := 0; i < len(a); i++ {
+		ret[i] = a[i]
+	}
+	return ret
+}
+
+// fromSlice returns the bytes a as T.
+func fromSlice[T Long](a []U8) T {
+	var ret T
+	for i := 0; i < len(ret); i++ {
+		ret[i] = a[i]
+	}
+	return ret
+}
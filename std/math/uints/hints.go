@@ -16,6 +16,8 @@ func GetHints() []solver.Hint {
 		andHint,
 		xorHint,
 		toBytes,
+		toBytesCarry,
+		divModHint,
 	}
 }
 
@@ -38,10 +40,7 @@ func toBytes(m *big.Int, inputs []*big.Int, outputs []*big.Int) error {
 	}
 	nbLimbs := int(inputs[0].Uint64())
 	if len(outputs) != nbLimbs {
-		return fmt.Errorf("output must be 8 elements")
-	}
-	if !inputs[1].IsUint64() {
-		return fmt.Errorf("input must be 64 bits")
+		return fmt.Errorf("output must be %d elements", nbLimbs)
 	}
 	base := new(big.Int).Lsh(big.NewInt(1), uint(8))
 	tmp := new(big.Int).Set(inputs[1])
@@ -51,3 +50,64 @@ func toBytes(m *big.Int, inputs []*big.Int, outputs []*big.Int) error {
 	}
 	return nil
 }
+
+// toBytesCarry decomposes the input into nbBytes bytes and the remaining
+// carry. The inputs are the number of bytes and the value.
+func toBytesCarry(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) != 2 {
+		return fmt.Errorf("input must be 2 elements")
+	}
+	if !inputs[0].IsUint64() {
+		return fmt.Errorf("first input must be uint64")
+	}
+	nbBytes := int(inputs[0].Uint64())
+	if len(outputs) != nbBytes+1 {
+		return fmt.Errorf("output must be %d elements", nbBytes+1)
+	}
+	tmp := new(big.Int).Set(inputs[1])
+	for i := 0; i < nbBytes; i++ {
+		outputs[i].And(tmp, big.NewInt(0xff))
+		tmp.Rsh(tmp, 8)
+	}
+	outputs[nbBytes].Set(tmp)
+	return nil
+}
+
+// divModHint computes the quotient and remainder of the integers given as
+// little-endian bytes. The inputs are the number of bytes n, n bytes of the
+// dividend and n bytes of the divisor. The outputs are n bytes of the
+// quotient and n bytes of the remainder. If the divisor is zero, then the
+// quotient is zero and remainder is the dividend.
+func divModHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) == 0 || !inputs[0].IsUint64() {
+		return fmt.Errorf("first input must be uint64")
+	}
+	nbBytes := int(inputs[0].Uint64())
+	if len(inputs) != 2*nbBytes+1 {
+		return fmt.Errorf("input must be %d elements", 2*nbBytes+1)
+	}
+	if len(outputs) != 2*nbBytes {
+		return fmt.Errorf("output must be %d elements", 2*nbBytes)
+	}
+	fromBytes := func(bts []*big.Int) *big.Int {
+		res := new(big.Int)
+		for i := len(bts) - 1; i >= 0; i-- {
+			res.Lsh(res, 8)
+			res.Add(res, bts[i])
+		}
+		return res
+	}
+	a := fromBytes(inputs[1 : nbBytes+1])
+	b := fromBytes(inputs[nbBytes+1:])
+	q, r := new(big.Int), new(big.Int).Set(a)
+	if b.Sign() != 0 {
+		q.QuoRem(a, b, r)
+	}
+	for i := 0; i < nbBytes; i++ {
+		outputs[i].And(q, big.NewInt(0xff))
+		q.Rsh(q, 8)
+		outputs[nbBytes+i].And(r, big.NewInt(0xff))
+		r.Rsh(r, 8)
+	}
+	return nil
+}
@@ -2,7 +2,9 @@
 //
 // Usually arithmetic in a circuit is performed in the native field, which is of
 // prime order. However, for compatibility with native operations we rely on
-// operating on smaller primitive types as 8-bit, 32-bit and 64-bit integer.
+// operating on smaller primitive types as 8-bit, 16-bit, 32-bit and 64-bit
+// integers. Additionally, we provide wide 128-bit and 256-bit integers, for
+// example for implementing EVM word arithmetic.
 // Naively, these operations have to be implemented bitwise as there are no
 // closed equations for boolean operations (XOR, AND, OR).
 //
@@ -24,6 +26,7 @@ package uints
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/std/internal/logderivprecomp"
@@ -77,12 +80,17 @@ func (e *U8) GnarkInitHook() {
 	}
 }
 
+type U256 [32]U8
+type U128 [16]U8
 type U64 [8]U8
 type U32 [4]U8
+type U16 [2]U8
 
-type Long interface{ U32 | U64 }
+type Long interface {
+	U16 | U32 | U64 | U128 | U256
+}
 
-type BinaryField[T U32 | U64] struct {
+type BinaryField[T Long] struct {
 	api        frontend.API
 	xorT, andT *logderivprecomp.Precomputed
 	rchecker   frontend.Rangechecker
@@ -116,6 +124,13 @@ func NewU8(v uint8) U8 {
 	return U8{Val: v, internal: true}
 }
 
+func NewU16(v uint16) U16 {
+	return [2]U8{
+		NewU8(uint8((v >> (0 * 8)) & 0xff)),
+		NewU8(uint8((v >> (1 * 8)) & 0xff)),
+	}
+}
+
 func NewU32(v uint32) U32 {
 	return [4]U8{
 		NewU8(uint8((v >> (0 * 8)) & 0xff)),
@@ -138,6 +153,33 @@ func NewU64(v uint64) U64 {
 	}
 }
 
+// NewU128 returns the constant v as U128. It panics if v is negative or does
+// not fit into 128 bits.
+func NewU128(v *big.Int) U128 {
+	var ret U128
+	newWide(ret[:], v)
+	return ret
+}
+
+// NewU256 returns the constant v as U256. It panics if v is negative or does
+// not fit into 256 bits.
+func NewU256(v *big.Int) U256 {
+	var ret U256
+	newWide(ret[:], v)
+	return ret
+}
+
+func newWide(ret []U8, v *big.Int) {
+	if v.Sign() < 0 {
+		panic("negative value")
+	}
+	bts := make([]byte, len(ret))
+	v.FillBytes(bts)
+	for i := range ret {
+		ret[i] = NewU8(bts[len(bts)-i-1])
+	}
+}
+
 func NewU8Array(v []uint8) []U8 {
 	ret := make([]U8, len(v))
 	for i := range v {
@@ -146,6 +188,14 @@ func NewU8Array(v []uint8) []U8 {
 	return ret
 }
 
+func NewU16Array(v []uint16) []U16 {
+	ret := make([]U16, len(v))
+	for i := range v {
+		ret[i] = NewU16(v[i])
+	}
+	return ret
+}
+
 func NewU32Array(v []uint32) []U32 {
 	ret := make([]U32, len(v))
 	for i := range v {
@@ -167,8 +217,14 @@ func (bf *BinaryField[T]) ByteValueOf(a frontend.Variable) U8 {
 	return U8{Val: a, internal: true}
 }
 
+// ValueOf returns the decomposition of a into bytes. The decomposition is
+// computed out-of-circuit and only the bytes are range checked, so it is
+// provided only for the types up to 64 bits. It panics for the wider types.
 func (bf *BinaryField[T]) ValueOf(a frontend.Variable) T {
 	var r T
+	if len(r) > 8 {
+		panic(fmt.Sprintf("ValueOf not supported for %d-bit types", 8*len(r)))
+	}
 	bts, err := bf.api.Compiler().NewHint(toBytes, len(r), len(r), a)
 	if err != nil {
 		panic(err)
@@ -180,10 +236,15 @@ func (bf *BinaryField[T]) ValueOf(a frontend.Variable) T {
 	return r
 }
 
+// ToValue returns the value of a as a native field element. It panics if the
+// values of T do not fit into the native field.
 func (bf *BinaryField[T]) ToValue(a T) frontend.Variable {
+	if 8*len(a) >= bf.api.Compiler().FieldBitLen() {
+		panic(fmt.Sprintf("%d-bit values do not fit into the native field", 8*len(a)))
+	}
 	v := make([]frontend.Variable, len(a))
 	for i := range v {
-		v[i] = bf.api.Mul(a[i].Val, 1<<(i*8))
+		v[i] = bf.api.Mul(a[i].Val, new(big.Int).Lsh(big.NewInt(1), uint(8*i)))
 	}
 	vv := bf.api.Add(v[0], v[1], v[2:]...)
 	return vv
@@ -254,17 +315,6 @@ func (bf *BinaryField[T]) Not(a T) T {
 	return r
 }
 
-func (bf *BinaryField[T]) Add(a ...T) T {
-	va := make([]frontend.Variable, len(a))
-	for i := range a {
-		va[i] = bf.ToValue(a[i])
-	}
-	vres := bf.api.Add(va[0], va[1], va[2:]...)
-	res := bf.ValueOf(vres)
-	// TODO: should also check the that carry we omitted is correct.
-	return res
-}
-
 func (bf *BinaryField[T]) Lrot(a T, c int) T {
 	l := len(a)
 	if c < 0 {
@@ -325,7 +375,7 @@ func (bf *BinaryField[T]) AssertEq(a, b T) {
 	}
 }
 
-func reslice[T U32 | U64](in []T) [][]U8 {
+func reslice[T Long](in []T) [][]U8 {
 	if len(in) == 0 {
 		panic("zero-length input")
 	}
@@ -1,11 +1,15 @@
 package uints
 
 import (
+	"crypto/rand"
+	"math/big"
 	"math/bits"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/constraint/solver"
 	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
 	"github.com/consensys/gnark/test"
 )
 
@@ -79,3 +83,204 @@ func TestRshift(t *testing.T) {
 	err = test.IsSolved(&rshiftCircuit{Shift: 11}, &rshiftCircuit{Shift: 11, In: NewU32(0x12345678), Expected: NewU32(0x12345678 >> 11)}, ecc.BN254.ScalarField())
 	assert.NoError(err)
 }
+
+type u256ArithCircuit struct {
+	A, B                  U256
+	Sum, Diff, Prod       U256
+	ProdHi, Quo, Rem      U256
+	Carry, Borrow, IsLess frontend.Variable
+	Overflow              frontend.Variable
+}
+
+func (c *u256ArithCircuit) Define(api frontend.API) error {
+	uapi, err := New[U256](api)
+	if err != nil {
+		return err
+	}
+	sum, carry := uapi.AddWithCarry(c.A, c.B)
+	uapi.AssertEq(sum, c.Sum)
+	api.AssertIsEqual(carry, c.Carry)
+	uapi.AssertEq(uapi.Add(c.A, c.B), c.Sum)
+	diff, borrow := uapi.SubWithBorrow(c.A, c.B)
+	uapi.AssertEq(diff, c.Diff)
+	api.AssertIsEqual(borrow, c.Borrow)
+	api.AssertIsEqual(uapi.IsLess(c.A, c.B), c.IsLess)
+	prod, overflow := uapi.MulWithOverflow(c.A, c.B)
+	uapi.AssertEq(prod, c.Prod)
+	uapi.AssertEq(uapi.Mul(c.A, c.B), c.Prod)
+	api.AssertIsEqual(overflow, c.Overflow)
+	_, hi := uapi.MulFull(c.A, c.B)
+	uapi.AssertEq(hi, c.ProdHi)
+	q, r := uapi.DivMod(c.A, c.B)
+	uapi.AssertEq(q, c.Quo)
+	uapi.AssertEq(r, c.Rem)
+	return nil
+}
+
+func u256ArithAssignment(a, b *big.Int) *u256ArithCircuit {
+	mod := new(big.Int).Lsh(big.NewInt(1), 256)
+	boolToInt := func(v bool) int {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	sum := new(big.Int).Add(a, b)
+	diff := new(big.Int).Sub(a, b)
+	prod := new(big.Int).Mul(a, b)
+	quo, rem := new(big.Int).QuoRem(a, b, new(big.Int))
+	return &u256ArithCircuit{
+		A:        NewU256(a),
+		B:        NewU256(b),
+		Sum:      NewU256(new(big.Int).Mod(sum, mod)),
+		Carry:    boolToInt(sum.Cmp(mod) >= 0),
+		Diff:     NewU256(new(big.Int).Mod(diff, mod)),
+		Borrow:   boolToInt(diff.Sign() < 0),
+		IsLess:   boolToInt(diff.Sign() < 0),
+		Prod:     NewU256(new(big.Int).Mod(prod, mod)),
+		ProdHi:   NewU256(new(big.Int).Rsh(prod, 256)),
+		Overflow: boolToInt(prod.Cmp(mod) >= 0),
+		Quo:      NewU256(quo),
+		Rem:      NewU256(rem),
+	}
+}
+
+func TestU256Arithmetic(t *testing.T) {
+	assert := test.NewAssert(t)
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	cases := [][2]*big.Int{
+		{big.NewInt(1), big.NewInt(1)},
+		{big.NewInt(5), big.NewInt(7)},
+		{max, big.NewInt(1)},
+		{max, max},
+		{big.NewInt(0), max},
+	}
+	for i := 0; i < 3; i++ {
+		a, _ := rand.Int(rand.Reader, max)
+		b, _ := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), uint(64*(i+1))))
+		b.Add(b, big.NewInt(1))
+		cases = append(cases, [2]*big.Int{a, b})
+	}
+	for _, c := range cases {
+		assert.NoError(test.IsSolved(&u256ArithCircuit{}, u256ArithAssignment(c[0], c[1]), ecc.BN254.ScalarField()))
+	}
+	assert.CheckCircuit(&u256ArithCircuit{}, test.WithValidAssignment(u256ArithAssignment(cases[5][0], cases[5][1])), test.WithCurves(ecc.BN254), test.NoFuzzing())
+
+	// wrong remainder
+	wrong := u256ArithAssignment(big.NewInt(100), big.NewInt(7))
+	wrong.Quo, wrong.Rem = NewU256(big.NewInt(13)), NewU256(big.NewInt(9))
+	assert.Error(test.IsSolved(&u256ArithCircuit{}, wrong, ecc.BN254.ScalarField()))
+	// wrong carry
+	wrong = u256ArithAssignment(max, big.NewInt(1))
+	wrong.Carry = 0
+	assert.Error(test.IsSolved(&u256ArithCircuit{}, wrong, ecc.BN254.ScalarField()))
+	// division by zero
+	assert.Panics(func() { u256ArithAssignment(big.NewInt(1), big.NewInt(0)) })
+}
+
+type u16Circuit struct {
+	A, B            U16
+	Sum, Prod, Diff U16
+	Lshift, Rrot    U16
+	Quo, Rem        U16
+	Shift           int
+}
+
+func (c *u16Circuit) Define(api frontend.API) error {
+	uapi, err := New[U16](api)
+	if err != nil {
+		return err
+	}
+	uapi.AssertEq(uapi.Add(c.A, c.B, c.A), c.Sum)
+	uapi.AssertEq(uapi.Mul(c.A, c.B), c.Prod)
+	uapi.AssertEq(uapi.Sub(c.A, c.B), c.Diff)
+	uapi.AssertEq(uapi.Lshift(c.A, c.Shift), c.Lshift)
+	uapi.AssertEq(uapi.Rrot(c.A, c.Shift), c.Rrot)
+	q, r := uapi.DivMod(c.A, c.B)
+	uapi.AssertEq(q, c.Quo)
+	uapi.AssertEq(r, c.Rem)
+	return nil
+}
+
+func TestU16(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, shift := range []int{0, 3, 8, 11, 16} {
+		for _, v := range [][2]uint16{{0x1234, 0xabcd}, {0xffff, 0xffff}, {7, 3}} {
+			a, b := v[0], v[1]
+			assignment := &u16Circuit{
+				A: NewU16(a), B: NewU16(b),
+				Sum:    NewU16(a + b + a),
+				Prod:   NewU16(a * b),
+				Diff:   NewU16(a - b),
+				Lshift: NewU16(a << shift),
+				Rrot:   NewU16(bits.RotateLeft16(a, -shift)),
+				Quo:    NewU16(a / b),
+				Rem:    NewU16(a % b),
+				Shift:  shift,
+			}
+			assert.NoError(test.IsSolved(&u16Circuit{Shift: shift}, assignment, ecc.BN254.ScalarField()))
+		}
+	}
+}
+
+type toValueCircuit struct {
+	In       U128
+	Expected frontend.Variable
+}
+
+func (c *toValueCircuit) Define(api frontend.API) error {
+	uapi, err := New[U128](api)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(uapi.ToValue(c.In), c.Expected)
+	return nil
+}
+
+func TestToValueU128(t *testing.T) {
+	assert := test.NewAssert(t)
+	v := new(big.Int).Lsh(big.NewInt(1), 70)
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	for _, c := range []*big.Int{v, max, big.NewInt(0)} {
+		assert.NoError(test.IsSolved(&toValueCircuit{}, &toValueCircuit{In: NewU128(c), Expected: c}, ecc.BN254.ScalarField()))
+	}
+	// the bytes above the 8th must not be dropped
+	assert.Error(test.IsSolved(&toValueCircuit{}, &toValueCircuit{In: NewU128(v), Expected: 0}, ecc.BN254.ScalarField()))
+}
+
+type addU32Circuit struct {
+	A, B, C U32
+}
+
+func (c *addU32Circuit) Define(api frontend.API) error {
+	uapi, err := New[U32](api)
+	if err != nil {
+		return err
+	}
+	uapi.AssertEq(uapi.Add(c.A, c.B), c.C)
+	return nil
+}
+
+func TestAddU32(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, c := range [][2]uint32{{1, 2}, {0xffffffff, 2}, {0xffffffff, 0xffffffff}} {
+		assert.NoError(test.IsSolved(&addU32Circuit{}, &addU32Circuit{A: NewU32(c[0]), B: NewU32(c[1]), C: NewU32(c[0] + c[1])}, ecc.BN254.ScalarField()))
+	}
+
+	// the sum must be constrained also when the prover does not decompose it
+	// honestly. Previously, the sum was decomposed into bytes with the toBytes
+	// hint, without checking the recomposition.
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &addU32Circuit{})
+	assert.NoError(err)
+	w, err := frontend.NewWitness(&addU32Circuit{A: NewU32(1), B: NewU32(2), C: NewU32(7)}, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	malicious := func(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+		for i := range outputs {
+			outputs[i].SetUint64(0)
+		}
+		outputs[0].SetUint64(7)
+		return nil
+	}
+	err = ccs.IsSolved(w, solver.OverrideHint(solver.GetHintID(toBytes), malicious))
+	assert.Error(err)
+}
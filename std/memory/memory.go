@@ -0,0 +1,194 @@
+// Package memory implements a read-write memory using offline memory checking.
+//
+// The memory is a vector of n cells initialised with given values. Every
+// access (read or write) increments a global timestamp t. When accessing the
+// address a, the prover returns the stored value v and the timestamp t' of the
+// last access to the cell. We then record the tuple (a, t', v) in the read set
+// and the tuple (a, t, v') in the write set, where v' is the written value for
+// writes and v for reads. Additionally, we check that t' < t.
+//
+// The write set is initialised with the tuples (i, 0, init_i) for every cell
+// and when committing the memory, the prover returns the final state (i, t_i,
+// v_i) of all cells which is added to the read set. The memory is consistent if
+// and only if the read set is a permutation of the write set. This is checked
+// using the log-derivative argument on the random linear combination of the
+// tuples:
+//
+//	∑ 1/(X - (a_i + c_t t_i + c_v v_i)) == ∑ 1/(X - (a'_i + c_t t'_i + c_v v'_i)),
+//
+// where X, c_t and c_v are derived from the commitment to all the tuples.
+//
+// Reading from an address which is not in range [0, n) makes the read and write
+// sets inconsistent and the argument fails.
+//
+// The complexity of the memory is linear in the number of cells and the number
+// of accesses (O(n+m)). Every access costs two inversions, a range check of a
+// timestamp difference and a few linear combinations, independently of the
+// size of the memory.
+//
+// See "Checking the correctness of memories" by Blum et al. for the offline
+// memory checking technique.
+package memory
+
+import (
+	"math/bits"
+
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/internal/logderivarg"
+	"github.com/consensys/gnark/std/rangecheck"
+)
+
+// Memory holds the initial content and the performed accesses.
+type Memory struct {
+	api      frontend.API
+	rchecker frontend.Rangechecker
+
+	entries []frontend.Variable
+	reads   [][]frontend.Variable
+	writes  [][]frontend.Variable
+	nbOps   int
+	closed  bool
+
+	// each memory has a unique blueprint. The blueprint stores the initial
+	// content and the accesses such that the solver can replay them.
+	bID       constraint.BlueprintID
+	blueprint constraint.BlueprintMemoryHint
+}
+
+// New returns a new [*Memory] with the cells initialised to init. It
+// additionally defers building the memory consistency argument. It panics if
+// init is empty.
+func New(api frontend.API, init []frontend.Variable) *Memory {
+	if len(init) == 0 {
+		panic("empty memory")
+	}
+	m := &Memory{
+		api:      api,
+		rchecker: rangecheck.New(api),
+		entries:  make([]frontend.Variable, len(init)),
+	}
+	copy(m.entries, init)
+	for i := range init {
+		v := api.Compiler().ToCanonicalVariable(init[i])
+		v.Compress(&m.blueprint.EntriesCalldata)
+	}
+	api.Compiler().Defer(m.commit)
+	m.bID = api.Compiler().AddBlueprint(&m.blueprint)
+	return m
+}
+
+// Size returns the number of cells in the memory.
+func (m *Memory) Size() int {
+	return len(m.entries)
+}
+
+// Read returns the value stored at address addr. It panics during compile time
+// when reading from a committed memory. It panics during solving time when the
+// address is out of bounds.
+func (m *Memory) Read(addr frontend.Variable) frontend.Variable {
+	return m.access(addr, nil)
+}
+
+// Write stores val at address addr. It panics during compile time when writing
+// to a committed memory. It panics during solving time when the address is out
+// of bounds.
+func (m *Memory) Write(addr, val frontend.Variable) {
+	m.access(addr, val)
+}
+
+// access performs a read if val is nil and a write otherwise. It returns the
+// value stored at addr before the access.
+func (m *Memory) access(addr, val frontend.Variable) frontend.Variable {
+	if m.closed {
+		panic("accessing committed memory")
+	}
+	prevVals, prevTimestamps := m.query(m.nbOps, []frontend.Variable{addr})
+	prevVal, prevTimestamp := prevVals[0], prevTimestamps[0]
+
+	m.nbOps++
+	timestamp := m.nbOps
+	// the previous access must have happened before. We check that t-1-t' fits
+	// into the bit-length of t. As t is small, the difference wraps around the
+	// modulus if t' > t-1 and the check fails.
+	m.rchecker.Check(m.api.Sub(timestamp-1, prevTimestamp), bits.Len(uint(timestamp)))
+
+	if val == nil {
+		val = prevVal
+	}
+	m.reads = append(m.reads, []frontend.Variable{addr, prevTimestamp, prevVal})
+	m.writes = append(m.writes, []frontend.Variable{addr, timestamp, val})
+
+	// record the access for the solver. We also store the timestamp output
+	// for ordering the instructions.
+	compiler := m.api.Compiler()
+	for _, v := range []frontend.Variable{addr, val, prevTimestamp} {
+		cv := compiler.ToCanonicalVariable(v)
+		cv.Compress(&m.blueprint.OpsCalldata)
+	}
+	return prevVal
+}
+
+// query returns the values and timestamps of the last accesses at addresses
+// addrs after the first nbOps accesses.
+func (m *Memory) query(nbOps int, addrs []frontend.Variable) (vals, timestamps []frontend.Variable) {
+	// to build the instruction, we need to first encode its dependency as a calldata []uint32 slice.
+	// * calldata[0] is the length of the calldata,
+	// * calldata[1] is the number of accesses we consider.
+	// * calldata[2] is the number of queried addresses (the number of outputs is twice that)
+	compiler := m.api.Compiler()
+
+	calldata := make([]uint32, 3, 3+len(addrs)*2+2)
+	calldata[1] = uint32(nbOps)
+	calldata[2] = uint32(len(addrs))
+
+	// encode inputs
+	for _, in := range addrs {
+		v := compiler.ToCanonicalVariable(in)
+		v.Compress(&calldata)
+	}
+
+	// by convention, first calldata is len of inputs
+	calldata[0] = uint32(len(calldata))
+
+	outputs := compiler.AddInstruction(m.bID, calldata)
+
+	// sanity check
+	if len(outputs) != 2*len(addrs) {
+		panic("sanity check")
+	}
+
+	vals = make([]frontend.Variable, len(addrs))
+	timestamps = make([]frontend.Variable, len(addrs))
+	for i := range addrs {
+		vals[i] = compiler.InternalVariable(outputs[2*i])
+		timestamps[i] = compiler.InternalVariable(outputs[2*i+1])
+	}
+	return vals, timestamps
+}
+
+func (m *Memory) commit(api frontend.API) error {
+	if m.closed {
+		return nil
+	}
+	m.closed = true
+
+	// the final state of the memory is added to the read set and the initial
+	// state to the write set. We do not need to check the final timestamps as
+	// they are after any access.
+	addrs := make([]frontend.Variable, len(m.entries))
+	for i := range addrs {
+		addrs[i] = i
+	}
+	finalVals, finalTimestamps := m.query(m.nbOps, addrs)
+	reads := make([][]frontend.Variable, 0, len(m.reads)+len(m.entries))
+	writes := make([][]frontend.Variable, 0, len(m.writes)+len(m.entries))
+	for i := range m.entries {
+		writes = append(writes, []frontend.Variable{i, 0, m.entries[i]})
+		reads = append(reads, []frontend.Variable{i, finalTimestamps[i], finalVals[i]})
+	}
+	reads = append(reads, m.reads...)
+	writes = append(writes, m.writes...)
+
+	return logderivarg.BuildPermutation(api, writes, reads)
+}
@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"crypto/rand"
+	"math/big"
+	mrand "math/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+const (
+	memSize = 16
+	nbOps   = 64
+)
+
+type memoryCircuit struct {
+	isWrite  [nbOps]bool
+	Init     [memSize]frontend.Variable
+	Addrs    [nbOps]frontend.Variable
+	Vals     [nbOps]frontend.Variable
+	Expected [nbOps]frontend.Variable
+}
+
+func (c *memoryCircuit) Define(api frontend.API) error {
+	m := New(api, c.Init[:])
+	for i := range c.Addrs {
+		if c.isWrite[i] {
+			m.Write(c.Addrs[i], c.Vals[i])
+		} else {
+			api.AssertIsEqual(m.Read(c.Addrs[i]), c.Expected[i])
+		}
+	}
+	return nil
+}
+
+// opTypes returns the operation types for the test circuit. The operation
+// types are fixed at compile time.
+func opTypes() (isWrite [nbOps]bool) {
+	rnd := mrand.New(mrand.NewSource(42)) //nolint:gosec // test
+	for i := range isWrite {
+		isWrite[i] = rnd.Intn(2) == 0
+	}
+	return
+}
+
+func memoryAssignment() (circuit, assignment *memoryCircuit) {
+	isWrite := opTypes()
+	circuit = &memoryCircuit{isWrite: isWrite}
+	assignment = &memoryCircuit{isWrite: isWrite}
+	field := ecc.BN254.ScalarField()
+	var mem [memSize]*big.Int
+	for i := range mem {
+		mem[i], _ = rand.Int(rand.Reader, field)
+		assignment.Init[i] = mem[i]
+	}
+	for i := range isWrite {
+		addr, _ := rand.Int(rand.Reader, big.NewInt(memSize))
+		val, _ := rand.Int(rand.Reader, field)
+		assignment.Addrs[i] = addr
+		assignment.Vals[i] = val
+		assignment.Expected[i] = 0
+		if isWrite[i] {
+			mem[addr.Int64()] = val
+		} else {
+			assignment.Expected[i] = mem[addr.Int64()]
+		}
+	}
+	return circuit, assignment
+}
+
+func TestMemory(t *testing.T) {
+	assert := test.NewAssert(t)
+	circuit, assignment := memoryAssignment()
+	assert.CheckCircuit(circuit, test.WithValidAssignment(assignment), test.WithCurves(ecc.BN254), test.WithBackends(backend.GROTH16, backend.PLONK))
+}
+
+func TestMemoryInvalidRead(t *testing.T) {
+	assert := test.NewAssert(t)
+	circuit, assignment := memoryAssignment()
+	for i := range circuit.isWrite {
+		if !circuit.isWrite[i] {
+			assignment.Expected[i] = new(big.Int).Add(assignment.Expected[i].(*big.Int), big.NewInt(1))
+			break
+		}
+	}
+	err := test.IsSolved(circuit, assignment, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+type outOfBoundsCircuit struct {
+	Init [memSize]frontend.Variable
+	Addr frontend.Variable
+}
+
+func (c *outOfBoundsCircuit) Define(api frontend.API) error {
+	m := New(api, c.Init[:])
+	m.Write(c.Addr, 1)
+	return nil
+}
+
+func TestMemoryOutOfBounds(t *testing.T) {
+	assert := test.NewAssert(t)
+	var assignment outOfBoundsCircuit
+	for i := range assignment.Init {
+		assignment.Init[i] = i
+	}
+	assignment.Addr = memSize - 1
+	assert.NoError(test.IsSolved(&outOfBoundsCircuit{}, &assignment, ecc.BN254.ScalarField()))
+	assignment.Addr = memSize
+	assert.Error(test.IsSolved(&outOfBoundsCircuit{}, &assignment, ecc.BN254.ScalarField()))
+}
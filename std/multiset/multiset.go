@@ -0,0 +1,64 @@
+// Package multiset implements multiset equality (permutation) checks.
+//
+// Two vectors a and b are permutations of each other if and only if the
+// rational functions ∑ 1/(X - a_i) and ∑ 1/(X - b_i) are equal. We check the
+// equality using the log-derivative argument at a random point X derived from
+// the commitment to the vectors.
+//
+// For multi-column tables we first compress the rows using a random linear
+// combination with coefficients derived from the commitment.
+//
+// The builder must implement [frontend.Committer] interface.
+package multiset
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/internal/logderivarg"
+	"github.com/consensys/gnark/std/rangecheck"
+)
+
+// AssertIsPermutation asserts that the vector b is a permutation of the vector
+// a. It returns an error if the lengths of the vectors do not match.
+func AssertIsPermutation(api frontend.API, a, b []frontend.Variable) error {
+	return AssertIsTuplePermutation(api, logderivarg.AsTable(a), logderivarg.AsTable(b))
+}
+
+// AssertIsTuplePermutation asserts that the rows of table b are a permutation
+// of the rows of table a. All rows must have the same length. It returns an
+// error if the number of rows or the row lengths do not match.
+func AssertIsTuplePermutation(api frontend.API, a, b [][]frontend.Variable) error {
+	if len(a) != len(b) {
+		return fmt.Errorf("length mismatch: %d != %d", len(a), len(b))
+	}
+	if len(a) == 0 {
+		return nil
+	}
+	return logderivarg.BuildPermutation(api, a, b)
+}
+
+// AssertIsSortedPermutation asserts that the vector b is a permutation of the
+// vector a and that b is sorted in non-decreasing order. The elements of b
+// (and thus a) are range checked to be less than 2^nbBits. It returns an
+// error if the lengths of the vectors do not match.
+func AssertIsSortedPermutation(api frontend.API, a, b []frontend.Variable, nbBits int) error {
+	if nbBits <= 0 || nbBits >= api.Compiler().FieldBitLen()-1 {
+		return fmt.Errorf("invalid number of bits %d", nbBits)
+	}
+	if len(a) != len(b) {
+		return fmt.Errorf("length mismatch: %d != %d", len(a), len(b))
+	}
+	// the range checker defers its commitment. We have to initialize it
+	// before scheduling the permutation check.
+	rchecker := rangecheck.New(api)
+	for i := range b {
+		rchecker.Check(b[i], nbBits)
+		// as both elements are small, the difference wraps around the modulus
+		// if b[i] < b[i-1] and the check fails.
+		if i > 0 {
+			rchecker.Check(api.Sub(b[i], b[i-1]), nbBits)
+		}
+	}
+	return AssertIsPermutation(api, a, b)
+}
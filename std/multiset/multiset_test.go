@@ -0,0 +1,98 @@
+package multiset
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+type permutationCircuit struct {
+	A, B [6]frontend.Variable
+}
+
+func (c *permutationCircuit) Define(api frontend.API) error {
+	return AssertIsPermutation(api, c.A[:], c.B[:])
+}
+
+func TestPermutation(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.CheckCircuit(&permutationCircuit{},
+		test.WithValidAssignment(&permutationCircuit{
+			A: [6]frontend.Variable{1, 2, 3, 3, 5, 100},
+			B: [6]frontend.Variable{3, 100, 2, 5, 3, 1},
+		}),
+		test.WithInvalidAssignment(&permutationCircuit{
+			A: [6]frontend.Variable{1, 2, 3, 3, 5, 100},
+			B: [6]frontend.Variable{3, 100, 2, 5, 5, 1},
+		}),
+		test.WithInvalidAssignment(&permutationCircuit{
+			A: [6]frontend.Variable{1, 2, 3, 4, 5, 6},
+			B: [6]frontend.Variable{1, 2, 3, 4, 5, 7},
+		}),
+		test.WithCurves(ecc.BN254))
+}
+
+type tuplePermutationCircuit struct {
+	A, B [4][3]frontend.Variable
+}
+
+func (c *tuplePermutationCircuit) Define(api frontend.API) error {
+	a := make([][]frontend.Variable, len(c.A))
+	b := make([][]frontend.Variable, len(c.B))
+	for i := range c.A {
+		a[i] = c.A[i][:]
+		b[i] = c.B[i][:]
+	}
+	return AssertIsTuplePermutation(api, a, b)
+}
+
+func TestTuplePermutation(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.CheckCircuit(&tuplePermutationCircuit{},
+		test.WithValidAssignment(&tuplePermutationCircuit{
+			A: [4][3]frontend.Variable{{1, 2, 3}, {4, 5, 6}, {1, 2, 3}, {7, 8, 9}},
+			B: [4][3]frontend.Variable{{7, 8, 9}, {1, 2, 3}, {4, 5, 6}, {1, 2, 3}},
+		}),
+		// same elements in columns, but different rows
+		test.WithInvalidAssignment(&tuplePermutationCircuit{
+			A: [4][3]frontend.Variable{{1, 2, 3}, {4, 5, 6}, {1, 2, 3}, {7, 8, 9}},
+			B: [4][3]frontend.Variable{{7, 2, 9}, {1, 8, 3}, {4, 5, 6}, {1, 2, 3}},
+		}),
+		test.WithCurves(ecc.BN254))
+}
+
+type sortedPermutationCircuit struct {
+	A, B [5]frontend.Variable
+}
+
+func (c *sortedPermutationCircuit) Define(api frontend.API) error {
+	return AssertIsSortedPermutation(api, c.A[:], c.B[:], 16)
+}
+
+func TestSortedPermutation(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.CheckCircuit(&sortedPermutationCircuit{},
+		test.WithValidAssignment(&sortedPermutationCircuit{
+			A: [5]frontend.Variable{40, 3, 65535, 3, 0},
+			B: [5]frontend.Variable{0, 3, 3, 40, 65535},
+		}),
+		// permutation, but not sorted
+		test.WithInvalidAssignment(&sortedPermutationCircuit{
+			A: [5]frontend.Variable{40, 3, 65535, 3, 0},
+			B: [5]frontend.Variable{0, 3, 40, 3, 65535},
+		}),
+		// sorted, but elements out of range
+		test.WithInvalidAssignment(&sortedPermutationCircuit{
+			A: [5]frontend.Variable{40, 3, 65536, 3, 0},
+			B: [5]frontend.Variable{0, 3, 3, 40, 65536},
+		}),
+		test.WithCurves(ecc.BN254))
+}
+
+func TestLengthMismatch(t *testing.T) {
+	assert := test.NewAssert(t)
+	err := AssertIsPermutation(nil, []frontend.Variable{1, 2}, []frontend.Variable{1})
+	assert.Error(err)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/consensys/gnark/backend/witness"
 	"github.com/consensys/gnark/constraint"
 	"github.com/consensys/gnark/std/algebra"
+	emulated_bls12377 "github.com/consensys/gnark/std/algebra/emulated/sw_bls12377"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bw6761"
@@ -60,6 +61,14 @@ func ValueOfProof[G1El algebra.G1ElementT, G2El algebra.G2ElementT](proof groth1
 		ar.Ar = sw_bls12377.NewG1Affine(tProof.Ar)
 		ar.Krs = sw_bls12377.NewG1Affine(tProof.Krs)
 		ar.Bs = sw_bls12377.NewG2Affine(tProof.Bs)
+	case *Proof[emulated_bls12377.G1Affine, emulated_bls12377.G2Affine]:
+		tProof, ok := proof.(*groth16backend_bls12377.Proof)
+		if !ok {
+			return ret, fmt.Errorf("expected bls12377.Proof, got %T", proof)
+		}
+		ar.Ar = emulated_bls12377.NewG1Affine(tProof.Ar)
+		ar.Krs = emulated_bls12377.NewG1Affine(tProof.Krs)
+		ar.Bs = emulated_bls12377.NewG2Affine(tProof.Bs)
 	case *Proof[sw_bls12381.G1Affine, sw_bls12381.G2Affine]:
 		tProof, ok := proof.(*groth16backend_bls12381.Proof)
 		if !ok {
@@ -118,6 +127,30 @@ func PlaceholderVerifyingKeyFixed[G1El algebra.G1ElementT, G2El algebra.G2Elemen
 		},
 	}
 	switch s := any(&vk).(type) {
+	case *VerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]:
+		s.G2 = struct {
+			GammaNeg sw_bn254.G2Affine
+			DeltaNeg sw_bn254.G2Affine
+		}{
+			GammaNeg: sw_bn254.NewG2AffineFixedPlaceholder(),
+			DeltaNeg: sw_bn254.NewG2AffineFixedPlaceholder(),
+		}
+	case *VerifyingKey[emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]:
+		s.G2 = struct {
+			GammaNeg emulated_bls12377.G2Affine
+			DeltaNeg emulated_bls12377.G2Affine
+		}{
+			GammaNeg: emulated_bls12377.NewG2AffineFixedPlaceholder(),
+			DeltaNeg: emulated_bls12377.NewG2AffineFixedPlaceholder(),
+		}
+	case *VerifyingKey[sw_bls12381.G1Affine, sw_bls12381.G2Affine, sw_bls12381.GTEl]:
+		s.G2 = struct {
+			GammaNeg sw_bls12381.G2Affine
+			DeltaNeg sw_bls12381.G2Affine
+		}{
+			GammaNeg: sw_bls12381.NewG2AffineFixedPlaceholder(),
+			DeltaNeg: sw_bls12381.NewG2AffineFixedPlaceholder(),
+		}
 	case *VerifyingKey[sw_bw6761.G1Affine, sw_bw6761.G2Affine, sw_bw6761.GTEl]:
 		s.G2 = struct {
 			GammaNeg sw_bw6761.G2Affine
@@ -178,6 +211,26 @@ func ValueOfVerifyingKey[G1El algebra.G1ElementT, G2El algebra.G2ElementT, GtEl
 		gammaNeg.Neg(&tVk.G2.Gamma)
 		s.G2.DeltaNeg = sw_bls12377.NewG2Affine(deltaNeg)
 		s.G2.GammaNeg = sw_bls12377.NewG2Affine(gammaNeg)
+	case *VerifyingKey[emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]:
+		tVk, ok := vk.(*groth16backend_bls12377.VerifyingKey)
+		if !ok {
+			return ret, fmt.Errorf("expected bls12377.VerifyingKey, got %T", vk)
+		}
+		// compute E
+		e, err := bls12377.Pair([]bls12377.G1Affine{tVk.G1.Alpha}, []bls12377.G2Affine{tVk.G2.Beta})
+		if err != nil {
+			return ret, fmt.Errorf("precompute pairing: %w", err)
+		}
+		s.E = emulated_bls12377.NewGTEl(e)
+		s.G1.K = make([]emulated_bls12377.G1Affine, len(tVk.G1.K))
+		for i := range s.G1.K {
+			s.G1.K[i] = emulated_bls12377.NewG1Affine(tVk.G1.K[i])
+		}
+		var deltaNeg, gammaNeg bls12377.G2Affine
+		deltaNeg.Neg(&tVk.G2.Delta)
+		gammaNeg.Neg(&tVk.G2.Gamma)
+		s.G2.DeltaNeg = emulated_bls12377.NewG2Affine(deltaNeg)
+		s.G2.GammaNeg = emulated_bls12377.NewG2Affine(gammaNeg)
 	case *VerifyingKey[sw_bls12381.G1Affine, sw_bls12381.G2Affine, sw_bls12381.GTEl]:
 		tVk, ok := vk.(*groth16backend_bls12381.VerifyingKey)
 		if !ok {
@@ -250,26 +303,46 @@ func ValueOfVerifyingKey[G1El algebra.G1ElementT, G2El algebra.G2ElementT, GtEl
 func ValueOfVerifyingKeyFixed[G1El algebra.G1ElementT, G2El algebra.G2ElementT, GtEl algebra.GtElementT](vk groth16.VerifyingKey) (VerifyingKey[G1El, G2El, GtEl], error) {
 	var ret VerifyingKey[G1El, G2El, GtEl]
 	switch s := any(&ret).(type) {
-	// case *VerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]:
-	// 	tVk, ok := vk.(*groth16backend_bn254.VerifyingKey)
-	// 	if !ok {
-	// 		return ret, fmt.Errorf("expected bn254.VerifyingKey, got %T", vk)
-	// 	}
-	// 	// compute E
-	// 	e, err := bn254.Pair([]bn254.G1Affine{tVk.G1.Alpha}, []bn254.G2Affine{tVk.G2.Beta})
-	// 	if err != nil {
-	// 		return ret, fmt.Errorf("precompute pairing: %w", err)
-	// 	}
-	// 	s.E = sw_bn254.NewGTEl(e)
-	// 	s.G1.K = make([]sw_bn254.G1Affine, len(tVk.G1.K))
-	// 	for i := range s.G1.K {
-	// 		s.G1.K[i] = sw_bn254.NewG1Affine(tVk.G1.K[i])
-	// 	}
-	// 	var deltaNeg, gammaNeg bn254.G2Affine
-	// 	deltaNeg.Neg(&tVk.G2.Delta)
-	// 	gammaNeg.Neg(&tVk.G2.Gamma)
-	// 	s.G2.DeltaNeg = sw_bn254.NewG2Affine(deltaNeg)
-	// 	s.G2.GammaNeg = sw_bn254.NewG2Affine(gammaNeg)
+	case *VerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]:
+		tVk, ok := vk.(*groth16backend_bn254.VerifyingKey)
+		if !ok {
+			return ret, fmt.Errorf("expected bn254.VerifyingKey, got %T", vk)
+		}
+		// compute E
+		e, err := bn254.Pair([]bn254.G1Affine{tVk.G1.Alpha}, []bn254.G2Affine{tVk.G2.Beta})
+		if err != nil {
+			return ret, fmt.Errorf("precompute pairing: %w", err)
+		}
+		s.E = sw_bn254.NewGTEl(e)
+		s.G1.K = make([]sw_bn254.G1Affine, len(tVk.G1.K))
+		for i := range s.G1.K {
+			s.G1.K[i] = sw_bn254.NewG1Affine(tVk.G1.K[i])
+		}
+		var deltaNeg, gammaNeg bn254.G2Affine
+		deltaNeg.Neg(&tVk.G2.Delta)
+		gammaNeg.Neg(&tVk.G2.Gamma)
+		s.G2.DeltaNeg = sw_bn254.NewG2AffineFixed(deltaNeg)
+		s.G2.GammaNeg = sw_bn254.NewG2AffineFixed(gammaNeg)
+	case *VerifyingKey[emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]:
+		tVk, ok := vk.(*groth16backend_bls12377.VerifyingKey)
+		if !ok {
+			return ret, fmt.Errorf("expected bls12377.VerifyingKey, got %T", vk)
+		}
+		// compute E
+		e, err := bls12377.Pair([]bls12377.G1Affine{tVk.G1.Alpha}, []bls12377.G2Affine{tVk.G2.Beta})
+		if err != nil {
+			return ret, fmt.Errorf("precompute pairing: %w", err)
+		}
+		s.E = emulated_bls12377.NewGTEl(e)
+		s.G1.K = make([]emulated_bls12377.G1Affine, len(tVk.G1.K))
+		for i := range s.G1.K {
+			s.G1.K[i] = emulated_bls12377.NewG1Affine(tVk.G1.K[i])
+		}
+		var deltaNeg, gammaNeg bls12377.G2Affine
+		deltaNeg.Neg(&tVk.G2.Delta)
+		gammaNeg.Neg(&tVk.G2.Gamma)
+		s.G2.DeltaNeg = emulated_bls12377.NewG2AffineFixed(deltaNeg)
+		s.G2.GammaNeg = emulated_bls12377.NewG2AffineFixed(gammaNeg)
 	// case *VerifyingKey[sw_bls12377.G1Affine, sw_bls12377.G2Affine, sw_bls12377.GT]:
 	// 	tVk, ok := vk.(*groth16backend_bls12377.VerifyingKey)
 	// 	if !ok {
@@ -290,26 +363,26 @@ func ValueOfVerifyingKeyFixed[G1El algebra.G1ElementT, G2El algebra.G2ElementT,
 	// 	gammaNeg.Neg(&tVk.G2.Gamma)
 	// 	s.G2.DeltaNeg = sw_bls12377.NewG2Affine(deltaNeg)
 	// 	s.G2.GammaNeg = sw_bls12377.NewG2Affine(gammaNeg)
-	// case *VerifyingKey[sw_bls12381.G1Affine, sw_bls12381.G2Affine, sw_bls12381.GTEl]:
-	// 	tVk, ok := vk.(*groth16backend_bls12381.VerifyingKey)
-	// 	if !ok {
-	// 		return ret, fmt.Errorf("expected bls12381.VerifyingKey, got %T", vk)
-	// 	}
-	// 	// compute E
-	// 	e, err := bls12381.Pair([]bls12381.G1Affine{tVk.G1.Alpha}, []bls12381.G2Affine{tVk.G2.Beta})
-	// 	if err != nil {
-	// 		return ret, fmt.Errorf("precompute pairing: %w", err)
-	// 	}
-	// 	s.E = sw_bls12381.NewGTEl(e)
-	// 	s.G1.K = make([]sw_bls12381.G1Affine, len(tVk.G1.K))
-	// 	for i := range s.G1.K {
-	// 		s.G1.K[i] = sw_bls12381.NewG1Affine(tVk.G1.K[i])
-	// 	}
-	// 	var deltaNeg, gammaNeg bls12381.G2Affine
-	// 	deltaNeg.Neg(&tVk.G2.Delta)
-	// 	gammaNeg.Neg(&tVk.G2.Gamma)
-	// 	s.G2.DeltaNeg = sw_bls12381.NewG2Affine(deltaNeg)
-	// 	s.G2.GammaNeg = sw_bls12381.NewG2Affine(gammaNeg)
+	case *VerifyingKey[sw_bls12381.G1Affine, sw_bls12381.G2Affine, sw_bls12381.GTEl]:
+		tVk, ok := vk.(*groth16backend_bls12381.VerifyingKey)
+		if !ok {
+			return ret, fmt.Errorf("expected bls12381.VerifyingKey, got %T", vk)
+		}
+		// compute E
+		e, err := bls12381.Pair([]bls12381.G1Affine{tVk.G1.Alpha}, []bls12381.G2Affine{tVk.G2.Beta})
+		if err != nil {
+			return ret, fmt.Errorf("precompute pairing: %w", err)
+		}
+		s.E = sw_bls12381.NewGTEl(e)
+		s.G1.K = make([]sw_bls12381.G1Affine, len(tVk.G1.K))
+		for i := range s.G1.K {
+			s.G1.K[i] = sw_bls12381.NewG1Affine(tVk.G1.K[i])
+		}
+		var deltaNeg, gammaNeg bls12381.G2Affine
+		deltaNeg.Neg(&tVk.G2.Delta)
+		gammaNeg.Neg(&tVk.G2.Gamma)
+		s.G2.DeltaNeg = sw_bls12381.NewG2AffineFixed(deltaNeg)
+		s.G2.GammaNeg = sw_bls12381.NewG2AffineFixed(gammaNeg)
 	// case *VerifyingKey[sw_bls24315.G1Affine, sw_bls24315.G2Affine, sw_bls24315.GT]:
 	// 	tVk, ok := vk.(*groth16backend_bls24315.VerifyingKey)
 	// 	if !ok {
@@ -20,6 +20,7 @@ import (
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/frontend/cs/r1cs"
 	"github.com/consensys/gnark/std/algebra"
+	emulated_bls12377 "github.com/consensys/gnark/std/algebra/emulated/sw_bls12377"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bw6761"
@@ -151,6 +152,30 @@ func TestBN254InBN254(t *testing.T) {
 	assert.CheckCircuit(outerCircuit, test.WithValidAssignment(outerAssignment), test.WithCurves(ecc.BN254))
 }
 
+func TestBN254InBN254Precomputed(t *testing.T) {
+	assert := test.NewAssert(t)
+	innerCcs, innerVK, innerWitness, innerProof := getInner(assert, ecc.BN254.ScalarField())
+
+	// outer proof
+	circuitVk, err := ValueOfVerifyingKeyFixed[sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](innerVK)
+	assert.NoError(err)
+	circuitWitness, err := ValueOfWitness[sw_bn254.ScalarField](innerWitness)
+	assert.NoError(err)
+	circuitProof, err := ValueOfProof[sw_bn254.G1Affine, sw_bn254.G2Affine](innerProof)
+	assert.NoError(err)
+
+	outerCircuit := &OuterCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		InnerWitness: PlaceholderWitness[sw_bn254.ScalarField](innerCcs),
+		VerifyingKey: PlaceholderVerifyingKeyFixed[sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](innerCcs),
+	}
+	outerAssignment := &OuterCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		InnerWitness: circuitWitness,
+		Proof:        circuitProof,
+		VerifyingKey: circuitVk,
+	}
+	assert.CheckCircuit(outerCircuit, test.WithValidAssignment(outerAssignment), test.WithCurves(ecc.BN254))
+}
+
 func TestBLS12InBW6(t *testing.T) {
 	assert := test.NewAssert(t)
 	innerCcs, innerVK, innerWitness, innerProof := getInner(assert, ecc.BLS12_377.ScalarField())
@@ -175,6 +200,54 @@ func TestBLS12InBW6(t *testing.T) {
 	assert.CheckCircuit(outerCircuit, test.WithValidAssignment(outerAssignment), test.WithCurves(ecc.BW6_761))
 }
 
+func TestBLS12InBN254(t *testing.T) {
+	assert := test.NewAssert(t)
+	innerCcs, innerVK, innerWitness, innerProof := getInner(assert, ecc.BLS12_377.ScalarField())
+
+	// outer proof
+	circuitVk, err := ValueOfVerifyingKey[emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl](innerVK)
+	assert.NoError(err)
+	circuitWitness, err := ValueOfWitness[emulated_bls12377.ScalarField](innerWitness)
+	assert.NoError(err)
+	circuitProof, err := ValueOfProof[emulated_bls12377.G1Affine, emulated_bls12377.G2Affine](innerProof)
+	assert.NoError(err)
+
+	outerCircuit := &OuterCircuit[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]{
+		InnerWitness: PlaceholderWitness[emulated_bls12377.ScalarField](innerCcs),
+		VerifyingKey: PlaceholderVerifyingKey[emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl](innerCcs),
+	}
+	outerAssignment := &OuterCircuit[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]{
+		InnerWitness: circuitWitness,
+		Proof:        circuitProof,
+		VerifyingKey: circuitVk,
+	}
+	assert.CheckCircuit(outerCircuit, test.WithValidAssignment(outerAssignment), test.WithCurves(ecc.BN254))
+}
+
+func TestBLS12InBN254Precomputed(t *testing.T) {
+	assert := test.NewAssert(t)
+	innerCcs, innerVK, innerWitness, innerProof := getInner(assert, ecc.BLS12_377.ScalarField())
+
+	// outer proof
+	circuitVk, err := ValueOfVerifyingKeyFixed[emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl](innerVK)
+	assert.NoError(err)
+	circuitWitness, err := ValueOfWitness[emulated_bls12377.ScalarField](innerWitness)
+	assert.NoError(err)
+	circuitProof, err := ValueOfProof[emulated_bls12377.G1Affine, emulated_bls12377.G2Affine](innerProof)
+	assert.NoError(err)
+
+	outerCircuit := &OuterCircuit[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]{
+		InnerWitness: PlaceholderWitness[emulated_bls12377.ScalarField](innerCcs),
+		VerifyingKey: PlaceholderVerifyingKeyFixed[emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl](innerCcs),
+	}
+	outerAssignment := &OuterCircuit[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]{
+		InnerWitness: circuitWitness,
+		Proof:        circuitProof,
+		VerifyingKey: circuitVk,
+	}
+	assert.CheckCircuit(outerCircuit, test.WithValidAssignment(outerAssignment), test.WithCurves(ecc.BN254))
+}
+
 type WitnessCircut struct {
 	A emulated.Element[emparams.Secp256k1Fr] `gnark:",public"`
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/frontend/cs/scs"
 	"github.com/consensys/gnark/std/algebra"
+	emulated_bls12377 "github.com/consensys/gnark/std/algebra/emulated/sw_bls12377"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bw6761"
 	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
 	"github.com/consensys/gnark/std/math/emulated"
@@ -126,6 +127,33 @@ func TestBW6InBN254WoCommit(t *testing.T) {
 	assert.NoError(err)
 }
 
+func TestBLS12InBN254WoCommit(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	innerCcs, innerVK, innerWitness, innerProof := getInnerWoCommit(assert, ecc.BLS12_377.ScalarField(), ecc.BN254.ScalarField())
+
+	// outer proof
+	circuitVk, err := ValueOfVerifyingKey[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine](innerVK)
+	assert.NoError(err)
+	circuitWitness, err := ValueOfWitness[emulated_bls12377.ScalarField](innerWitness)
+	assert.NoError(err)
+	circuitProof, err := ValueOfProof[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine](innerProof)
+	assert.NoError(err)
+
+	outerCircuit := &OuterCircuit[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]{
+		InnerWitness: PlaceholderWitness[emulated_bls12377.ScalarField](innerCcs),
+		Proof:        PlaceholderProof[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine](innerCcs),
+		VerifyingKey: PlaceholderVerifyingKey[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine](innerCcs),
+	}
+	outerAssignment := &OuterCircuit[emulated_bls12377.ScalarField, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]{
+		InnerWitness: circuitWitness,
+		Proof:        circuitProof,
+		VerifyingKey: circuitVk,
+	}
+	err = test.IsSolved(outerCircuit, outerAssignment, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
 //-----------------------------------------------------------------
 // With api.Commit
 
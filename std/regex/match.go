@@ -0,0 +1,116 @@
+package regex
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/std/rangecheck"
+	"github.com/consensys/gnark/std/selector"
+)
+
+// Result is the result of matching the input in-circuit.
+type Result struct {
+	// IsMatch is 1 if the input matches the regular expression and 0
+	// otherwise.
+	IsMatch frontend.Variable
+	// Masks are the reveal masks of the capture groups. Masks[k][i] is 1 if
+	// the byte at position i is consumed inside the capture group k and 0
+	// otherwise. Same as in the standard library, the group 0 is the whole
+	// match. The masks are zero at the positions not less than the length of
+	// the input.
+	Masks [][]frontend.Variable
+	// Reveal are the inputs masked with the reveal masks, i.e. Reveal[k][i]
+	// is the byte at position i if Masks[k][i] is 1 and 0 otherwise.
+	Reveal [][]uints.U8
+}
+
+// Match matches the first length bytes of the input against the regular
+// expression. The remaining bytes are ignored, which allows matching inputs of
+// variable length. The length must be in range [0, len(in)], otherwise the
+// circuit is not satisfiable. The bytes of the input are range checked.
+//
+// The reveal masks are only meaningful if the input matches.
+func (re *Regex) Match(api frontend.API, in []uints.U8, length frontend.Variable) (*Result, error) {
+	if len(in) == 0 {
+		return nil, fmt.Errorf("empty input")
+	}
+	rchecker := rangecheck.New(api)
+	transitions := logderivlookup.New(api)
+	for _, next := range re.transitions {
+		transitions.Insert(next)
+	}
+	accepting := logderivlookup.New(api)
+	for _, acc := range re.accepting {
+		if acc {
+			accepting.Insert(1)
+		} else {
+			accepting.Insert(0)
+		}
+	}
+	reveals := make([]*logderivlookup.Table, len(re.reveals))
+	for k := range re.reveals {
+		reveals[k] = logderivlookup.New(api)
+		for _, r := range re.reveals[k] {
+			if r {
+				reveals[k].Insert(1)
+			} else {
+				reveals[k].Insert(0)
+			}
+		}
+	}
+
+	// we only need the transition indices for computing the reveal masks
+	inds := make([]frontend.Variable, len(in))
+	states := make([]frontend.Variable, len(in)+1)
+	states[0] = 0
+	for i := range in {
+		rchecker.Check(in[i].Val, 8)
+		inds[i] = api.Add(api.Mul(states[i], 256), in[i].Val)
+		states[i+1] = transitions.Lookup(inds[i])[0]
+	}
+	isMatch := selector.Mux(api, length, accepting.Lookup(states...)...)
+
+	// the mask for the positions less than length
+	ones := make([]frontend.Variable, len(in)+1)
+	for i := range ones {
+		ones[i] = 1
+	}
+	// we need at least two elements for partitioning, so we add one
+	// element and ignore it.
+	inRange := selector.Partition(api, length, false, ones)[:len(in)]
+
+	res := &Result{
+		IsMatch: isMatch,
+		Masks:   make([][]frontend.Variable, len(re.reveals)+1),
+		Reveal:  make([][]uints.U8, len(re.reveals)+1),
+	}
+	res.Masks[0] = inRange
+	for k := range reveals {
+		masks := reveals[k].Lookup(inds...)
+		for i := range masks {
+			masks[i] = api.Mul(masks[i], inRange[i])
+		}
+		res.Masks[k+1] = masks
+	}
+	for k := range res.Masks {
+		res.Reveal[k] = make([]uints.U8, len(in))
+		for i := range in {
+			res.Reveal[k][i] = uints.U8{Val: api.Mul(res.Masks[k][i], in[i].Val)}
+		}
+	}
+	return res, nil
+}
+
+// AssertMatch asserts that the first length bytes of the input match the
+// regular expression and returns the inputs masked with the reveal masks of the
+// capture groups. See [Regex.Match] for details.
+func (re *Regex) AssertMatch(api frontend.API, in []uints.U8, length frontend.Variable) ([][]uints.U8, error) {
+	res, err := re.Match(api, in, length)
+	if err != nil {
+		return nil, err
+	}
+	api.AssertIsEqual(res.IsMatch, 1)
+	return res.Reveal, nil
+}
@@ -0,0 +1,260 @@
+// Package regex implements regular expression matching over byte strings.
+//
+// The regular expression is parsed using the syntax of the standard library
+// package [regexp] and compiled into a deterministic finite automaton (DFA) at
+// circuit compile time. In-circuit, the input is processed byte by byte and
+// every transition (state, byte) → state is checked using a lookup table from
+// [logderivlookup]. The cost of matching is constant per byte and linear in
+// the number of transitions of the DFA (256 per state).
+//
+// The regular expression must match the whole input, i.e. it is implicitly
+// anchored at both ends. The anchors ^ and $ at the beginning and end of the
+// input are allowed, but multi-line mode and word boundaries are not
+// supported. The input is not decoded as UTF-8, every byte is interpreted as a
+// code point in range [0, 256). Thus, only ASCII characters should be used in
+// the regular expression.
+//
+// For capture groups the package provides reveal masks which indicate the
+// positions of the input bytes consumed inside the group. The mask of a byte
+// is determined by the DFA transition consuming it. Thus, the expression is
+// rejected if a byte may be consumed both inside and outside a group from the
+// same DFA state, as then the membership depends on the remaining input. For
+// example, `(ab)|ac` is rejected, but `a(b|c)` is accepted. If a group is
+// repeated, then the masks cover all the iterations and not only the last one
+// as in the standard library.
+package regex
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Regex is a regular expression compiled into a DFA.
+type Regex struct {
+	expr string
+	// number of states in the DFA. The state 0 is the initial state.
+	nbStates int
+	// transitions[s*256+c] is the next state when consuming byte c in state s.
+	transitions []int
+	// accepting[s] indicates if the state s is accepting.
+	accepting []bool
+	// reveals[k][s*256+c] indicates if the byte c consumed in state s is
+	// inside the capture group k+1.
+	reveals [][]bool
+}
+
+// Compile parses the regular expression and compiles it into a DFA. It returns
+// an error if the expression is invalid or uses unsupported features.
+func Compile(expr string) (*Regex, error) {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return nil, fmt.Errorf("parse: %w", err)
+	}
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return nil, fmt.Errorf("compile: %w", err)
+	}
+	for i := range prog.Inst {
+		if prog.Inst[i].Op == syntax.InstEmptyWidth &&
+			syntax.EmptyOp(prog.Inst[i].Arg)&^(syntax.EmptyBeginText|syntax.EmptyEndText) != 0 {
+			return nil, fmt.Errorf("unsupported empty-width assertion")
+		}
+	}
+	c := &dfaCompiler{prog: prog, states: make(map[string]int)}
+	return c.compile(expr, re.MaxCap())
+}
+
+// MustCompile is like [Compile] but panics if the expression cannot be
+// compiled.
+func MustCompile(expr string) *Regex {
+	re, err := Compile(expr)
+	if err != nil {
+		panic(`regex: Compile(` + strconv.Quote(expr) + `): ` + err.Error())
+	}
+	return re
+}
+
+// String returns the source text used to compile the regular expression.
+func (re *Regex) String() string {
+	return re.expr
+}
+
+// NumSubexp returns the number of capture groups in the regular expression.
+func (re *Regex) NumSubexp() int {
+	return len(re.reveals)
+}
+
+// NbStates returns the number of states of the DFA, including the dead state.
+func (re *Regex) NbStates() int {
+	return re.nbStates
+}
+
+// MatchString reports whether the whole string s matches the regular
+// expression. It is the out-of-circuit counterpart of [Regex.Match].
+func (re *Regex) MatchString(s string) bool {
+	state := 0
+	for i := 0; i < len(s); i++ {
+		state = re.transitions[state*256+int(s[i])]
+	}
+	return re.accepting[state]
+}
+
+// dfaCompiler builds the DFA using the subset construction over the program
+// of the regular expression.
+type dfaCompiler struct {
+	prog   *syntax.Prog
+	states map[string]int
+	// kernels are the program counters of the NFA threads of the states after
+	// consuming a byte.
+	kernels [][]int
+	starts  []bool
+}
+
+// addState returns the index of the DFA state with the given kernel, creating
+// it if it does not exist.
+func (c *dfaCompiler) addState(kernel []int, atStart bool) int {
+	sort.Ints(kernel)
+	var sb strings.Builder
+	fmt.Fprint(&sb, atStart, kernel)
+	key := sb.String()
+	if s, ok := c.states[key]; ok {
+		return s
+	}
+	s := len(c.kernels)
+	c.states[key] = s
+	c.kernels = append(c.kernels, kernel)
+	c.starts = append(c.starts, atStart)
+	return s
+}
+
+// closure returns the program counters of the instructions consuming a byte or
+// matching which are reachable from the kernel without consuming a byte.
+func (c *dfaCompiler) closure(kernel []int, atStart, atEnd bool) []int {
+	visited := make(map[int]bool)
+	var res []int
+	var visit func(pc int)
+	visit = func(pc int) {
+		if visited[pc] {
+			return
+		}
+		visited[pc] = true
+		inst := &c.prog.Inst[pc]
+		switch inst.Op {
+		case syntax.InstAlt, syntax.InstAltMatch:
+			visit(int(inst.Out))
+			visit(int(inst.Arg))
+		case syntax.InstCapture, syntax.InstNop:
+			visit(int(inst.Out))
+		case syntax.InstEmptyWidth:
+			op := syntax.EmptyOp(inst.Arg)
+			if (op&syntax.EmptyBeginText == 0 || atStart) && (op&syntax.EmptyEndText == 0 || atEnd) {
+				visit(int(inst.Out))
+			}
+		case syntax.InstMatch, syntax.InstRune, syntax.InstRune1, syntax.InstRuneAny, syntax.InstRuneAnyNotNL:
+			res = append(res, pc)
+		}
+	}
+	for _, pc := range kernel {
+		visit(pc)
+	}
+	return res
+}
+
+// groupInstructions returns for every capture group the set of program
+// counters of the instructions consuming a byte inside the group.
+func (c *dfaCompiler) groupInstructions(nbGroups int) []map[int]bool {
+	res := make([]map[int]bool, nbGroups)
+	for k := range res {
+		res[k] = make(map[int]bool)
+		visited := make(map[int]bool)
+		var visit func(pc int)
+		visit = func(pc int) {
+			if visited[pc] {
+				return
+			}
+			visited[pc] = true
+			inst := &c.prog.Inst[pc]
+			switch inst.Op {
+			case syntax.InstAlt, syntax.InstAltMatch:
+				visit(int(inst.Out))
+				visit(int(inst.Arg))
+			case syntax.InstCapture:
+				if int(inst.Arg) != 2*(k+1)+1 {
+					visit(int(inst.Out))
+				}
+			case syntax.InstNop, syntax.InstEmptyWidth:
+				visit(int(inst.Out))
+			case syntax.InstRune, syntax.InstRune1, syntax.InstRuneAny, syntax.InstRuneAnyNotNL:
+				res[k][pc] = true
+				visit(int(inst.Out))
+			}
+		}
+		for pc := range c.prog.Inst {
+			if c.prog.Inst[pc].Op == syntax.InstCapture && int(c.prog.Inst[pc].Arg) == 2*(k+1) {
+				visit(int(c.prog.Inst[pc].Out))
+			}
+		}
+	}
+	return res
+}
+
+func (c *dfaCompiler) compile(expr string, nbGroups int) (*Regex, error) {
+	groups := c.groupInstructions(nbGroups)
+	re := &Regex{expr: expr, reveals: make([][]bool, nbGroups)}
+	c.addState([]int{c.prog.Start}, true)
+	for s := 0; s < len(c.kernels); s++ {
+		cl := c.closure(c.kernels[s], c.starts[s], false)
+		accepting := false
+		for _, pc := range c.closure(c.kernels[s], c.starts[s], true) {
+			if c.prog.Inst[pc].Op == syntax.InstMatch {
+				accepting = true
+			}
+		}
+		re.accepting = append(re.accepting, accepting)
+		for b := 0; b < 256; b++ {
+			var next []int
+			inGroup := make([]bool, nbGroups)
+			outGroup := make([]bool, nbGroups)
+			for _, pc := range cl {
+				inst := &c.prog.Inst[pc]
+				if inst.Op == syntax.InstMatch || !inst.MatchRune(rune(b)) {
+					continue
+				}
+				next = append(next, int(inst.Out))
+				for k := range groups {
+					if groups[k][pc] {
+						inGroup[k] = true
+					} else {
+						outGroup[k] = true
+					}
+				}
+			}
+			for k := range inGroup {
+				if inGroup[k] && outGroup[k] {
+					return nil, fmt.Errorf("ambiguous capture group %d: byte %q may be consumed inside and outside the group", k+1, rune(b))
+				}
+			}
+			re.transitions = append(re.transitions, c.addState(dedup(next), false))
+			for k := range inGroup {
+				re.reveals[k] = append(re.reveals[k], inGroup[k])
+			}
+		}
+	}
+	re.nbStates = len(c.kernels)
+	return re, nil
+}
+
+func dedup(pcs []int) []int {
+	seen := make(map[int]bool)
+	res := make([]int, 0, len(pcs))
+	for _, pc := range pcs {
+		if !seen[pc] {
+			seen[pc] = true
+			res = append(res, pc)
+		}
+	}
+	return res
+}
@@ -0,0 +1,112 @@
+package regex
+
+import (
+	"math/rand"
+	"regexp"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+func TestCompile(t *testing.T) {
+	assert := test.NewAssert(t)
+	exprs := []string{
+		`abc`,
+		`a*b+c?`,
+		`(ab|ba)*`,
+		`[a-c]{2,4}d`,
+		`^x.y$`,
+		`(?i)hello`,
+		`[^ab]*`,
+		`(?:a|b)*abb`,
+		`a(b|c)`,
+		``,
+	}
+	rnd := rand.New(rand.NewSource(1)) //nolint:gosec // test
+	alphabet := []byte("abcdxyHELOhelo\n")
+	for _, expr := range exprs {
+		re, err := Compile(expr)
+		assert.NoError(err, expr)
+		std := regexp.MustCompile(`^(?:` + expr + `)$`)
+		for i := 0; i < 2000; i++ {
+			b := make([]byte, rnd.Intn(8))
+			for j := range b {
+				b[j] = alphabet[rnd.Intn(len(alphabet))]
+			}
+			assert.Equal(std.Match(b), re.MatchString(string(b)), "%s: %q", expr, b)
+		}
+	}
+	_, err := Compile(`\bword`)
+	assert.Error(err)
+	_, err = Compile(`(?m)^a`)
+	assert.Error(err)
+	_, err = Compile(`a(`)
+	assert.Error(err)
+	// the group membership of 'a' depends on the next byte
+	_, err = Compile(`(ab)|ac`)
+	assert.Error(err)
+	_, err = Compile(`(a|b)*abb`)
+	assert.Error(err)
+}
+
+type matchCircuit struct {
+	re       *Regex
+	In       [32]uints.U8
+	Len      frontend.Variable
+	IsMatch  frontend.Variable
+	Expected [32]uints.U8
+}
+
+func (c *matchCircuit) Define(api frontend.API) error {
+	res, err := c.re.Match(api, c.In[:], c.Len)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(res.IsMatch, c.IsMatch)
+	for i := range c.Expected {
+		api.AssertIsEqual(res.Reveal[1][i].Val, c.Expected[i].Val)
+	}
+	return nil
+}
+
+func matchAssignment(re *Regex, in string) *matchCircuit {
+	var w matchCircuit
+	std := regexp.MustCompile(`^(?:` + re.String() + `)$`)
+	loc := std.FindStringSubmatchIndex(in)
+	w.IsMatch = 0
+	if loc != nil {
+		w.IsMatch = 1
+	}
+	for i := range w.In {
+		w.In[i] = uints.NewU8(0)
+		if i < len(in) {
+			w.In[i] = uints.NewU8(in[i])
+		}
+		w.Expected[i] = uints.NewU8(0)
+		if loc != nil && i >= loc[2] && i < loc[3] {
+			w.Expected[i] = uints.NewU8(in[i])
+		}
+	}
+	w.Len = len(in)
+	return &w
+}
+
+func TestMatch(t *testing.T) {
+	assert := test.NewAssert(t)
+	re := MustCompile(`from:([a-z]+)@example\.com\r\n`)
+	invalid := matchAssignment(re, "from:alice@example.com\r\n")
+	invalid.Expected[6] = uints.NewU8('x')
+	notMatching := matchAssignment(re, "from:Alice@example.com\r\n")
+	notMatching.IsMatch = 1
+	assert.CheckCircuit(&matchCircuit{re: re},
+		test.WithValidAssignment(matchAssignment(re, "from:alice@example.com\r\n")),
+		test.WithValidAssignment(matchAssignment(re, "from:bob@example.com\r\n")),
+		test.WithValidAssignment(matchAssignment(re, "from:Alice@example.com\r\n")),
+		test.WithValidAssignment(matchAssignment(re, "")),
+		test.WithInvalidAssignment(invalid),
+		test.WithInvalidAssignment(notMatching),
+		test.WithCurves(ecc.BN254))
+}
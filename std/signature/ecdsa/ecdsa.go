@@ -14,7 +14,10 @@ https://en.wikipedia.org/wiki/Elliptic_Curve_Digital_Signature_Algorithm
 package ecdsa
 
 import (
+	"fmt"
+
 	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/algopts"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
 	"github.com/consensys/gnark/std/math/emulated"
 )
@@ -27,10 +30,33 @@ type Signature[Scalar emulated.FieldParams] struct {
 // PublicKey represents the public key to verify the signature for.
 type PublicKey[Base, Scalar emulated.FieldParams] sw_emulated.AffinePoint[Base]
 
+// UnmarshalCompressedPublicKey returns the public key given by its compressed
+// SEC1 encoding in bits, most significant bit first. The curve parameters
+// params define the elliptic curve. See
+// [sw_emulated.Curve.UnmarshalSEC1Compressed] for the checks performed on the
+// encoding.
+func UnmarshalCompressedPublicKey[T, S emulated.FieldParams](api frontend.API, params sw_emulated.CurveParams, bits []frontend.Variable) (*PublicKey[T, S], error) {
+	cr, err := sw_emulated.New[T, S](api, params)
+	if err != nil {
+		return nil, fmt.Errorf("new curve: %w", err)
+	}
+	pt, err := cr.UnmarshalSEC1Compressed(bits)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+	pk := PublicKey[T, S](*pt)
+	return &pk, nil
+}
+
 // Verify asserts that the signature sig verifies for the message msg and public
 // key pk. The curve parameters params define the elliptic curve.
 //
 // We assume that the message msg is already hashed to the scalar field.
+//
+// If the curve has an efficient endomorphism (e.g. secp256k1 and BN254), then
+// the verification uses the GLV joint scalar multiplication, see
+// [sw_emulated.Curve.JointScalarMulBase]. In this case the public key must not
+// be a small multiple of the generator.
 func (pk PublicKey[T, S]) Verify(api frontend.API, params sw_emulated.CurveParams, msg *emulated.Element[S], sig *Signature[S]) {
 	cr, err := sw_emulated.New[T, S](api, params)
 	if err != nil {
@@ -51,7 +77,7 @@ func (pk PublicKey[T, S]) Verify(api frontend.API, params sw_emulated.CurveParam
 	rsInv := scalarApi.MulMod(&sig.R, sInv)
 
 	// q = [rsInv]pkpt + [msInv]g
-	q := cr.JointScalarMulBase(&pkpt, rsInv, msInv)
+	q := cr.JointScalarMulBase(&pkpt, rsInv, msInv, algopts.WithGLV())
 	qx := baseApi.Reduce(&q.X)
 	qxBits := baseApi.ToBits(qx)
 	rbits := scalarApi.ToBits(&sig.R)
@@ -116,6 +116,77 @@ func TestEcdsaSHA256(t *testing.T) {
 	assert.NoError(err)
 }
 
+type EcdsaCompressedKeyCircuit[T, S emulated.FieldParams] struct {
+	Sig Signature[S]
+	Msg emulated.Element[S]
+	Pub []frontend.Variable
+}
+
+func (c *EcdsaCompressedKeyCircuit[T, S]) Define(api frontend.API) error {
+	pub, err := UnmarshalCompressedPublicKey[T, S](api, sw_emulated.GetCurveParams[T](), c.Pub)
+	if err != nil {
+		return err
+	}
+	pub.Verify(api, sw_emulated.GetCurveParams[T](), &c.Msg, &c.Sig)
+	return nil
+}
+
+func TestEcdsaCompressedPublicKey(t *testing.T) {
+
+	// generate parameters
+	privKey, _ := ecdsa.GenerateKey(rand.Reader)
+	publicKey := privKey.PublicKey
+
+	// sign
+	msg := []byte("testing ECDSA (compressed public key)")
+	sigBin, _ := privKey.Sign(msg, nil)
+
+	// check that the signature is correct
+	flag, _ := publicKey.Verify(sigBin, msg, nil)
+	if !flag {
+		t.Errorf("can't verify signature")
+	}
+
+	// unmarshal signature
+	var sig ecdsa.Signature
+	sig.SetBytes(sigBin)
+	r, s := new(big.Int), new(big.Int)
+	r.SetBytes(sig.R[:32])
+	s.SetBytes(sig.S[:32])
+
+	hash := ecdsa.HashToInt(msg)
+
+	// compress the public key as in SEC1
+	pkBytes := make([]byte, 33)
+	pkBytes[0] = 0x02
+	if publicKey.A.Y.BigInt(new(big.Int)).Bit(0) == 1 {
+		pkBytes[0] = 0x03
+	}
+	xBytes := publicKey.A.X.Bytes()
+	copy(pkBytes[1:], xBytes[:])
+	pkBits := make([]frontend.Variable, 8*len(pkBytes))
+	for i := range pkBytes {
+		for j := 0; j < 8; j++ {
+			pkBits[i*8+j] = (pkBytes[i] >> (7 - j)) & 1
+		}
+	}
+
+	circuit := EcdsaCompressedKeyCircuit[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{
+		Pub: make([]frontend.Variable, len(pkBits)),
+	}
+	witness := EcdsaCompressedKeyCircuit[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{
+		Sig: Signature[emulated.Secp256k1Fr]{
+			R: emulated.ValueOf[emulated.Secp256k1Fr](r),
+			S: emulated.ValueOf[emulated.Secp256k1Fr](s),
+		},
+		Msg: emulated.ValueOf[emulated.Secp256k1Fr](hash),
+		Pub: pkBits,
+	}
+	assert := test.NewAssert(t)
+	err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
 // Example how to verify the signature inside the circuit.
 func ExamplePublicKey_Verify() {
 	api := frontend.API(nil) // provider by the builder
@@ -0,0 +1,52 @@
+package sort
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/consensys/gnark/constraint/solver"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hint functions used in the package.
+func GetHints() []solver.Hint {
+	return []solver.Hint{sortHint}
+}
+
+// sortHint sorts the rows of a table by a key column. The inputs are the number
+// of columns, the index of the key column, the direction (0 for ascending) and
+// then the rows of the table. The sort is stable.
+func sortHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) < 3 {
+		return fmt.Errorf("expecting at least three inputs")
+	}
+	nbCols, keyCol := int(inputs[0].Int64()), int(inputs[1].Int64())
+	descending := inputs[2].Sign() != 0
+	inputs = inputs[3:]
+	if nbCols <= 0 || keyCol < 0 || keyCol >= nbCols || len(inputs)%nbCols != 0 {
+		return fmt.Errorf("invalid table dimensions")
+	}
+	if len(outputs) != len(inputs) {
+		return fmt.Errorf("expecting as many outputs as table elements")
+	}
+	rows := make([][]*big.Int, len(inputs)/nbCols)
+	for i := range rows {
+		rows[i] = inputs[i*nbCols : (i+1)*nbCols]
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if descending {
+			return rows[i][keyCol].Cmp(rows[j][keyCol]) > 0
+		}
+		return rows[i][keyCol].Cmp(rows[j][keyCol]) < 0
+	})
+	for i := range rows {
+		for j := range rows[i] {
+			outputs[i*nbCols+j].Set(rows[i][j])
+		}
+	}
+	return nil
+}
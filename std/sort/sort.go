@@ -0,0 +1,123 @@
+// Package sort implements sorting of variables and tables in-circuit.
+//
+// The sorted output is computed out-of-circuit using a hint. We then prove
+// that the output is a permutation of the input using [multiset] package and
+// that the adjacent elements of the output are ordered. For proving the
+// ordering we range check the elements and the differences of the adjacent
+// elements, so the elements must be bounded by 2^nbBits.
+//
+// The complexity of sorting n elements is O(n) instead of O(n log² n)
+// comparators of a sorting network.
+package sort
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/multiset"
+	"github.com/consensys/gnark/std/rangecheck"
+)
+
+type sortConfig struct {
+	descending bool
+}
+
+// Option allows to configure the sorting.
+type Option func(*sortConfig) error
+
+// WithDescending sorts the elements in descending order. By default, the
+// elements are sorted in ascending order.
+func WithDescending() Option {
+	return func(c *sortConfig) error {
+		c.descending = true
+		return nil
+	}
+}
+
+// Sort returns the elements of in in sorted order. The elements must be less
+// than 2^nbBits, otherwise the circuit is not satisfiable.
+func Sort(api frontend.API, in []frontend.Variable, nbBits int, opts ...Option) ([]frontend.Variable, error) {
+	rows := make([][]frontend.Variable, len(in))
+	for i := range in {
+		rows[i] = []frontend.Variable{in[i]}
+	}
+	sorted, err := SortByKey(api, rows, 0, nbBits, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]frontend.Variable, len(sorted))
+	for i := range sorted {
+		res[i] = sorted[i][0]
+	}
+	return res, nil
+}
+
+// SortByKey returns the rows of the table sorted by the column keyCol. All rows
+// must have the same length. The keys must be less than 2^nbBits, otherwise the
+// circuit is not satisfiable.
+//
+// The method asserts that the returned rows are a permutation of the input
+// rows, where every row is compared as a whole tuple, and that the keys of the
+// returned rows are ordered. Only the key column is range checked and only the
+// keys are compared for the order, the values in the other columns may be
+// arbitrary. The hint computes a stable sort, but the relative order of the
+// rows with equal keys is not enforced in-circuit.
+func SortByKey(api frontend.API, rows [][]frontend.Variable, keyCol, nbBits int, opts ...Option) ([][]frontend.Variable, error) {
+	cfg := new(sortConfig)
+	for _, o := range opts {
+		if err := o(cfg); err != nil {
+			return nil, fmt.Errorf("apply option: %w", err)
+		}
+	}
+	if nbBits <= 0 || nbBits >= api.Compiler().FieldBitLen()-1 {
+		return nil, fmt.Errorf("invalid number of bits %d", nbBits)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	nbCols := len(rows[0])
+	if keyCol < 0 || keyCol >= nbCols {
+		return nil, fmt.Errorf("key column %d out of range", keyCol)
+	}
+	desc := 0
+	if cfg.descending {
+		desc = 1
+	}
+	hintInputs := make([]frontend.Variable, 0, 3+len(rows)*nbCols)
+	hintInputs = append(hintInputs, nbCols, keyCol, desc)
+	for i := range rows {
+		if len(rows[i]) != nbCols {
+			return nil, fmt.Errorf("row length mismatch")
+		}
+		hintInputs = append(hintInputs, rows[i]...)
+	}
+	res, err := api.Compiler().NewHint(sortHint, len(rows)*nbCols, hintInputs...)
+	if err != nil {
+		return nil, fmt.Errorf("hint: %w", err)
+	}
+	sorted := make([][]frontend.Variable, len(rows))
+	for i := range sorted {
+		sorted[i] = res[i*nbCols : (i+1)*nbCols]
+	}
+
+	// the range checker defers its commitment. We have to initialize it
+	// before scheduling the permutation check.
+	rchecker := rangecheck.New(api)
+	for i := range sorted {
+		rchecker.Check(sorted[i][keyCol], nbBits)
+		if i == 0 {
+			continue
+		}
+		// as both keys are small, the difference wraps around the modulus if
+		// they are not ordered and the check fails.
+		if cfg.descending {
+			rchecker.Check(api.Sub(sorted[i-1][keyCol], sorted[i][keyCol]), nbBits)
+		} else {
+			rchecker.Check(api.Sub(sorted[i][keyCol], sorted[i-1][keyCol]), nbBits)
+		}
+	}
+	if err := multiset.AssertIsTuplePermutation(api, rows, sorted); err != nil {
+		return nil, fmt.Errorf("permutation: %w", err)
+	}
+	return sorted, nil
+}
@@ -0,0 +1,104 @@
+package sort
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+type sortCircuit struct {
+	descending bool
+	In         [8]frontend.Variable
+	Expected   [8]frontend.Variable
+}
+
+func (c *sortCircuit) Define(api frontend.API) error {
+	var opts []Option
+	if c.descending {
+		opts = append(opts, WithDescending())
+	}
+	res, err := Sort(api, c.In[:], 16, opts...)
+	if err != nil {
+		return err
+	}
+	if len(res) != len(c.Expected) {
+		return fmt.Errorf("length mismatch")
+	}
+	for i := range res {
+		api.AssertIsEqual(res[i], c.Expected[i])
+	}
+	return nil
+}
+
+func TestSort(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.CheckCircuit(&sortCircuit{},
+		test.WithValidAssignment(&sortCircuit{
+			In:       [8]frontend.Variable{5, 1, 65535, 0, 5, 42, 7, 3},
+			Expected: [8]frontend.Variable{0, 1, 3, 5, 5, 7, 42, 65535},
+		}),
+		test.WithInvalidAssignment(&sortCircuit{
+			In:       [8]frontend.Variable{5, 1, 65535, 0, 5, 42, 7, 3},
+			Expected: [8]frontend.Variable{0, 1, 3, 5, 7, 5, 42, 65535},
+		}),
+		// element does not fit into 16 bits
+		test.WithInvalidAssignment(&sortCircuit{
+			In:       [8]frontend.Variable{5, 1, 65536, 0, 5, 42, 7, 3},
+			Expected: [8]frontend.Variable{0, 1, 3, 5, 5, 7, 42, 65536},
+		}),
+		test.WithCurves(ecc.BN254))
+}
+
+func TestSortDescending(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.CheckCircuit(&sortCircuit{descending: true},
+		test.WithValidAssignment(&sortCircuit{
+			In:       [8]frontend.Variable{5, 1, 65535, 0, 5, 42, 7, 3},
+			Expected: [8]frontend.Variable{65535, 42, 7, 5, 5, 3, 1, 0},
+		}),
+		test.WithInvalidAssignment(&sortCircuit{
+			In:       [8]frontend.Variable{5, 1, 65535, 0, 5, 42, 7, 3},
+			Expected: [8]frontend.Variable{0, 1, 3, 5, 5, 7, 42, 65535},
+		}),
+		test.WithCurves(ecc.BN254))
+}
+
+type sortByKeyCircuit struct {
+	Rows     [5][3]frontend.Variable
+	Expected [5][3]frontend.Variable
+}
+
+func (c *sortByKeyCircuit) Define(api frontend.API) error {
+	rows := make([][]frontend.Variable, len(c.Rows))
+	for i := range c.Rows {
+		rows[i] = c.Rows[i][:]
+	}
+	res, err := SortByKey(api, rows, 1, 32)
+	if err != nil {
+		return err
+	}
+	for i := range res {
+		for j := range res[i] {
+			api.AssertIsEqual(res[i][j], c.Expected[i][j])
+		}
+	}
+	return nil
+}
+
+func TestSortByKey(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.CheckCircuit(&sortByKeyCircuit{},
+		test.WithValidAssignment(&sortByKeyCircuit{
+			Rows:     [5][3]frontend.Variable{{1, 300, 7}, {2, 100, 8}, {3, 200, 9}, {4, 100, 10}, {5, 0, 11}},
+			Expected: [5][3]frontend.Variable{{5, 0, 11}, {2, 100, 8}, {4, 100, 10}, {3, 200, 9}, {1, 300, 7}},
+		}),
+		// keys are sorted, but rows are mixed
+		test.WithInvalidAssignment(&sortByKeyCircuit{
+			Rows:     [5][3]frontend.Variable{{1, 300, 7}, {2, 100, 8}, {3, 200, 9}, {4, 100, 10}, {5, 0, 11}},
+			Expected: [5][3]frontend.Variable{{5, 0, 11}, {2, 100, 10}, {4, 100, 8}, {3, 200, 9}, {1, 300, 7}},
+		}),
+		test.WithCurves(ecc.BN254))
+}